@@ -77,6 +77,11 @@ func stateForJSONOutput(s *resource.State, opts Options) *resource.State {
 		// For now, replace any secret properties as the string [secret] and then serialize what we have.
 		inputs = MassageSecrets(s.Inputs, false)
 		outputs = MassageSecrets(s.Outputs, false)
+
+		// Don't show the types recorded for the stack's typed outputs.
+		if isRootURN(s.URN) {
+			delete(outputs, resource.StackOutputTypesKey)
+		}
 	} else {
 		// If we're suppressing outputs, don't show the root stack properties.
 		inputs = resource.PropertyMap{}
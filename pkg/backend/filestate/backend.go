@@ -47,7 +47,6 @@ import (
 	"github.com/pulumi/pulumi/sdk/v2/go/common/apitype"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/diag"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/diag/colors"
-	"github.com/pulumi/pulumi/sdk/v2/go/common/encoding"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/config"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/tokens"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/cmdutil"
@@ -61,6 +60,10 @@ import (
 type Backend interface {
 	backend.Backend
 	local() // at the moment, no local specific info, so just use a marker function.
+
+	// PruneHistory removes the stack's update history entries and checkpoint backups that fall outside the given
+	// retention policy.
+	PruneHistory(ctx context.Context, stackRef backend.StackReference, policy RetentionPolicy) (PruneResult, error)
 }
 
 type localBackend struct {
@@ -74,6 +77,12 @@ type localBackend struct {
 
 	bucket Bucket
 	mutex  sync.Mutex
+
+	// format is the format in which checkpoints are written, as configured by the URL's query parameters.
+	format checkpointFormat
+	// retention is the retention policy applied to a stack's history after each update, as configured by the URL's
+	// query parameters.
+	retention RetentionPolicy
 }
 
 type localBackendReference struct {
@@ -105,7 +114,18 @@ func New(d diag.Sink, originalURL string) (Backend, error) {
 			originalURL, strings.Join(blob.DefaultURLMux().BucketSchemes(), ", "))
 	}
 
-	u, err := massageBlobPath(originalURL)
+	// Remove the parameters that configure the checkpoint format and retention policy before handing the URL to
+	// go-cloud.
+	u, format, err := parseCheckpointFormat(originalURL)
+	if err != nil {
+		return nil, err
+	}
+	u, retention, err := parseRetentionPolicy(u)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err = massageBlobPath(u)
 	if err != nil {
 		return nil, err
 	}
@@ -147,6 +167,8 @@ func New(d diag.Sink, originalURL string) (Backend, error) {
 		originalURL: originalURL,
 		url:         u,
 		bucket:      &wrappedBucket{bucket: bucket},
+		format:      format,
+		retention:   retention,
 	}, nil
 }
 
@@ -365,7 +387,11 @@ func (b *localBackend) RenameStack(ctx context.Context, stack backend.Stack,
 	}
 
 	// Ensure the destination stack does not already exist.
-	hasExisting, err := b.bucket.Exists(ctx, b.stackPath(newName))
+	newFile, err := b.existingStackPath(newName)
+	if err != nil {
+		return nil, err
+	}
+	hasExisting, err := b.bucket.Exists(ctx, newFile)
 	if err != nil {
 		return nil, err
 	}
@@ -386,7 +412,10 @@ func (b *localBackend) RenameStack(ctx context.Context, stack backend.Stack,
 	}
 
 	// To remove the old stack, just make a backup of the file and don't write out anything new.
-	file := b.stackPath(stackName)
+	file, err := b.existingStackPath(stackName)
+	if err != nil {
+		return nil, err
+	}
 	backupTarget(b.bucket, file)
 
 	// And rename the histoy folder as well.
@@ -481,72 +510,12 @@ func (b *localBackend) apply(
 		return nil, result.FromError(err)
 	}
 
-	// Spawn a display loop to show events on the CLI.
-	displayEvents := make(chan engine.Event)
-	displayDone := make(chan bool)
-	go display.ShowEvents(
-		strings.ToLower(actionLabel), kind, stackName, op.Proj.Name,
-		displayEvents, displayDone, op.Opts.Display, opts.DryRun)
-
-	// Create a separate event channel for engine events that we'll pipe to both listening streams.
-	engineEvents := make(chan engine.Event)
-
-	scope := op.Scopes.NewScope(engineEvents, opts.DryRun)
-	eventsDone := make(chan bool)
-	go func() {
-		// Pull in all events from the engine and send them to the two listeners.
-		for e := range engineEvents {
-			displayEvents <- e
-
-			// If the caller also wants to see the events, stream them there also.
-			if events != nil {
-				events <- e
-			}
-		}
-
-		close(eventsDone)
-	}()
-
-	// Create the management machinery.
-	persister := b.newSnapshotPersister(stackName, op.SecretsManager)
-	manager := backend.NewSnapshotManager(persister, update.GetTarget().Snapshot)
-	engineCtx := &engine.Context{
-		Cancel:          scope.Context(),
-		Events:          engineEvents,
-		SnapshotManager: manager,
-		BackendClient:   backend.NewBackendClient(b),
-	}
-
-	// Perform the update
+	// Perform the update.
+	persister := b.newSnapshotPersister(stackName, op.SecretsManager, update.GetTarget().Snapshot)
 	start := time.Now().Unix()
-	var changes engine.ResourceChanges
-	var updateRes result.Result
-	switch kind {
-	case apitype.PreviewUpdate:
-		changes, updateRes = engine.Update(update, engineCtx, op.Opts.Engine, true)
-	case apitype.UpdateUpdate:
-		changes, updateRes = engine.Update(update, engineCtx, op.Opts.Engine, opts.DryRun)
-	case apitype.ResourceImportUpdate:
-		changes, updateRes = engine.Import(update, engineCtx, op.Opts.Engine, op.Imports, opts.DryRun)
-	case apitype.RefreshUpdate:
-		changes, updateRes = engine.Refresh(update, engineCtx, op.Opts.Engine, opts.DryRun)
-	case apitype.DestroyUpdate:
-		changes, updateRes = engine.Destroy(update, engineCtx, op.Opts.Engine, opts.DryRun)
-	default:
-		contract.Failf("Unrecognized update kind: %s", kind)
-	}
+	changes, updateRes := backend.RunLocalUpdate(ctx, b, kind, stackRef, update, op, opts, events, persister, nil)
 	end := time.Now().Unix()
 
-	// Wait for the display to finish showing all the events.
-	<-displayDone
-	scope.Close() // Don't take any cancellations anymore, we're shutting down.
-	close(engineEvents)
-	contract.IgnoreClose(manager)
-
-	// Make sure the goroutine writing to displayEvents and events has exited before proceeding.
-	<-eventsDone
-	close(displayEvents)
-
 	// Save update results.
 	backendUpdateResult := backend.SucceededResult
 	if updateRes != nil {
@@ -569,8 +538,16 @@ func (b *localBackend) apply(
 	var saveErr error
 	var backupErr error
 	if !opts.DryRun {
-		saveErr = b.addToHistory(stackName, info)
+		saveErr = b.addToHistory(stackName, info, op.SecretsManager)
 		backupErr = b.backupStack(stackName)
+
+		// Apply the backend's retention policy, if any. Failing to prune old history is not worth failing the update
+		// over, so we only warn about it.
+		if saveErr == nil && backupErr == nil {
+			if _, err := b.pruneHistory(stackName, b.retention, time.Now()); err != nil {
+				cmdutil.Diag().Warningf(diag.Message("", "Could not prune stack history: %v"), err)
+			}
+		}
 	}
 
 	if updateRes != nil {
@@ -592,12 +569,14 @@ func (b *localBackend) apply(
 		// Note we get a real signed link for aws/azure/gcp links.  But no such option exists for
 		// file:// links so we manually create the link ourselves.
 		var link string
+		file, err := b.existingStackPath(stackName)
+		contract.IgnoreError(err)
 		if strings.HasPrefix(b.url, FilePathPrefix) {
 			u, _ := url.Parse(b.url)
-			u.Path = filepath.ToSlash(path.Join(u.Path, b.stackPath(stackName)))
+			u.Path = filepath.ToSlash(path.Join(u.Path, file))
 			link = u.String()
 		} else {
-			link, err = b.bucket.SignedURL(context.TODO(), b.stackPath(stackName), nil)
+			link, err = b.bucket.SignedURL(context.TODO(), file, nil)
 			if err != nil {
 				// we log a warning here rather then returning an error to avoid exiting
 				// pulumi with an error code.
@@ -737,6 +716,7 @@ func (b *localBackend) getLocalStacks() ([]tokens.QName, error) {
 		return nil, errors.Wrap(err, "error listing stacks")
 	}
 
+	seen := make(map[tokens.QName]bool)
 	for _, file := range files {
 		// Ignore directories.
 		if file.IsDir {
@@ -744,14 +724,20 @@ func (b *localBackend) getLocalStacks() ([]tokens.QName, error) {
 		}
 
 		// Skip files without valid extensions (e.g., *.bak files).
-		stackfn := objectName(file)
-		ext := filepath.Ext(stackfn)
-		if _, has := encoding.Marshalers[ext]; !has {
+		stackfn, _, ok := splitCheckpointExt(objectName(file))
+		if !ok {
+			continue
+		}
+
+		// A stack may have checkpoints in more than one format if the backend's format was changed and an older
+		// checkpoint could not be removed; only list it once.
+		name := tokens.QName(stackfn)
+		if seen[name] {
 			continue
 		}
+		seen[name] = true
 
 		// Read in this stack's information.
-		name := tokens.QName(stackfn[:len(stackfn)-len(ext)])
 		_, _, err := b.getStack(name)
 		if err != nil {
 			logging.V(5).Infof("error reading stack: %v (%v) skipping", name, err)
@@ -1,16 +1,26 @@
 package filestate
 
 import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	user "github.com/tweekmonster/luser"
 
+	"github.com/pulumi/pulumi/pkg/v2/backend"
 	"github.com/pulumi/pulumi/pkg/v2/operations"
 	"github.com/pulumi/pulumi/pkg/v2/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/v2/secrets/b64"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/config"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/tokens"
 )
 
 func TestMassageBlobPath(t *testing.T) {
@@ -80,3 +90,302 @@ func TestGetLogsForTargetWithNoSnapshot(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Nil(t, res)
 }
+
+type mockRegisterResourceEvent struct {
+	deploy.SourceEvent
+}
+
+func (m mockRegisterResourceEvent) Goal() *resource.Goal               { return nil }
+func (m mockRegisterResourceEvent) Done(result *deploy.RegisterResult) {}
+
+func TestJournalReplayAfterInterruptedUpdate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "filestate")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	b, err := New(nil, FilePathPrefix+dir)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	lb := b.(*localBackend)
+
+	newState := func(name string) *resource.State {
+		return &resource.State{
+			Type:    "test:index:Resource",
+			URN:     resource.NewURN("dev", "proj", "", "test:index:Resource", tokens.QName(name)),
+			Inputs:  resource.PropertyMap{},
+			Outputs: resource.PropertyMap{"secret": resource.MakeSecret(resource.NewStringProperty(name))},
+		}
+	}
+
+	sm := b64.NewBase64SecretsManager()
+	_, err = lb.saveStack("dev", deploy.NewSnapshot(deploy.Manifest{}, sm, []*resource.State{newState("a")}, nil), sm)
+	assert.NoError(t, err)
+	base, _, err := lb.getStack("dev")
+	assert.NoError(t, err)
+
+	// Start an update that creates a resource and then begins creating another one, but never closes its snapshot
+	// manager, as if the process had crashed.
+	manager := backend.NewSnapshotManager(lb.newSnapshotPersister("dev", sm, base), base)
+	applyStep := func(step deploy.Step) {
+		mutation, err := manager.BeginMutation(step)
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+		assert.NoError(t, mutation.End(step, true))
+	}
+	applyStep(deploy.NewSameStep(nil, mockRegisterResourceEvent{}, base.Resources[0], newState("a")))
+	applyStep(deploy.NewCreateStep(nil, mockRegisterResourceEvent{}, newState("b")))
+	_, err = manager.BeginMutation(deploy.NewCreateStep(nil, mockRegisterResourceEvent{}, newState("c")))
+	assert.NoError(t, err)
+
+	// Loading the stack replays the journal.
+	snap, _, err := lb.getStack("dev")
+	assert.NoError(t, err)
+	if assert.Len(t, snap.Resources, 2) {
+		assert.Equal(t, "a", string(snap.Resources[0].URN.Name()))
+		assert.Equal(t, "b", string(snap.Resources[1].URN.Name()))
+		assert.True(t, snap.Resources[1].Outputs["secret"].IsSecret())
+	}
+	assert.Len(t, snap.PendingOperations, 1)
+
+	// Closing the manager compacts the journal into the checkpoint and removes it.
+	assert.NoError(t, manager.Close())
+	files, err := listBucket(lb.bucket, lb.journalDirectory("dev"))
+	assert.NoError(t, err)
+	assert.Empty(t, files)
+	snap, _, err = lb.getStack("dev")
+	assert.NoError(t, err)
+	assert.Len(t, snap.Resources, 2)
+	assert.Len(t, snap.PendingOperations, 1)
+
+	// A journal that does not belong to the current checkpoint is ignored.
+	assert.NoError(t, lb.bucket.WriteAll(context.TODO(), filepath.Join(lb.journalDirectory("dev"), journalHeaderName+".json"),
+		[]byte(`{"checkpoint":"stale"}`), nil))
+	assert.NoError(t, lb.bucket.WriteAll(context.TODO(), filepath.Join(lb.journalDirectory("dev"), "0000000000.json"),
+		[]byte(`{"kind":2,"op":"create","new":{"ref":5}}`), nil))
+	snap, _, err = lb.getStack("dev")
+	assert.NoError(t, err)
+	assert.Len(t, snap.Resources, 2)
+}
+
+func TestParseCheckpointFormat(t *testing.T) {
+	u, format, err := parseCheckpointFormat("s3://bucket?region=us-west-2&gzip=true&encrypt=1")
+	assert.NoError(t, err)
+	assert.Equal(t, "s3://bucket?region=us-west-2", u)
+	assert.Equal(t, checkpointFormat{gzip: true, encrypt: true}, format)
+	assert.Equal(t, ".json.gz.enc", format.ext())
+
+	u, format, err = parseCheckpointFormat("file://~?gzip=false")
+	assert.NoError(t, err)
+	assert.Equal(t, "file://~", u)
+	assert.Equal(t, ".json", format.ext())
+
+	_, _, err = parseCheckpointFormat("file://~?encrypt=maybe")
+	assert.Error(t, err)
+}
+
+func TestCheckpointFormats(t *testing.T) {
+	dir, err := ioutil.TempDir("", "filestate")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	sm := b64.NewBase64SecretsManager()
+	snap := deploy.NewSnapshot(deploy.Manifest{}, sm, []*resource.State{{
+		Type:   "test:index:Resource",
+		URN:    resource.NewURN("dev", "proj", "", "test:index:Resource", "a"),
+		Inputs: resource.PropertyMap{"password": resource.NewStringProperty("hunter2")},
+	}}, nil)
+
+	// Write the stack's checkpoint in each format in turn, reading it back with a backend that uses the previous
+	// format each time.
+	var previous *localBackend
+	for _, query := range []string{"", "?gzip=true", "?encrypt=true", "?gzip=true&encrypt=true", ""} {
+		b, err := New(nil, FilePathPrefix+dir+query)
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+		lb := b.(*localBackend)
+
+		file, err := lb.saveStack("dev", snap, sm)
+		assert.NoError(t, err)
+		_, ext, _ := splitCheckpointExt(file)
+		assert.Equal(t, lb.format.ext(), ext)
+		info := backend.UpdateInfo{Kind: "update", StartTime: int64(len(query)), Message: "hunter2"}
+		assert.NoError(t, lb.addToHistory("dev", info, sm))
+
+		// The checkpoint's contents are only readable when it is neither compressed nor encrypted.
+		byts, err := ioutil.ReadFile(filepath.Join(dir, filepath.FromSlash(file)))
+		assert.NoError(t, err)
+		assert.Equal(t, query == "", strings.Contains(string(byts), "hunter2"))
+
+		// Checkpoints in other formats are removed.
+		stacks, err := lb.getLocalStacks()
+		assert.NoError(t, err)
+		assert.Equal(t, []tokens.QName{"dev"}, stacks)
+		for _, other := range otherCheckpointPaths(file) {
+			exists, err := lb.bucket.Exists(context.TODO(), other)
+			assert.NoError(t, err)
+			assert.False(t, exists)
+		}
+
+		for _, reader := range []*localBackend{lb, previous} {
+			if reader == nil {
+				continue
+			}
+			loaded, _, err := reader.getStack("dev")
+			if assert.NoError(t, err) && assert.Len(t, loaded.Resources, 1) {
+				assert.Equal(t, snap.Resources[0].Inputs, loaded.Resources[0].Inputs)
+			}
+		}
+		previous = lb
+	}
+
+	// History written in any format is listed.
+	history, err := previous.getHistory("dev")
+	assert.NoError(t, err)
+	assert.Len(t, history, 5)
+
+	// Like checkpoints, history files are only readable when they are neither compressed nor encrypted.
+	files, err := listBucket(previous.bucket, previous.historyDirectory("dev"))
+	assert.NoError(t, err)
+	readable := 0
+	for _, file := range files {
+		byts, err := previous.bucket.ReadAll(context.TODO(), file.Key)
+		assert.NoError(t, err)
+		if strings.Contains(objectName(file), ".history.") && strings.Contains(string(byts), "hunter2") {
+			readable++
+		}
+	}
+	assert.Equal(t, 2, readable)
+}
+
+func TestEncryptedJournal(t *testing.T) {
+	dir, err := ioutil.TempDir("", "filestate")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	b, err := New(nil, FilePathPrefix+dir+"?encrypt=true")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	lb := b.(*localBackend)
+
+	newState := func(name string) *resource.State {
+		return &resource.State{
+			Type:   "test:index:Resource",
+			URN:    resource.NewURN("dev", "proj", "", "test:index:Resource", tokens.QName(name)),
+			Inputs: resource.PropertyMap{"password": resource.NewStringProperty("hunter2")},
+		}
+	}
+
+	// Start an update that creates a resource but never closes its snapshot manager, leaving the journal behind.
+	sm := b64.NewBase64SecretsManager()
+	manager := backend.NewSnapshotManager(lb.newSnapshotPersister("dev", sm, nil), nil)
+	step := deploy.NewCreateStep(nil, mockRegisterResourceEvent{}, newState("a"))
+	mutation, err := manager.BeginMutation(step)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	assert.NoError(t, mutation.End(step, true))
+
+	// The journal's header and entries are written in the checkpoint's format, so none of them are readable.
+	files, err := listBucket(lb.bucket, lb.journalDirectory("dev"))
+	assert.NoError(t, err)
+	assert.NotEmpty(t, files)
+	for _, file := range files {
+		_, ext, _ := splitCheckpointExt(objectName(file))
+		assert.Equal(t, lb.format.ext(), ext)
+		byts, err := lb.bucket.ReadAll(context.TODO(), file.Key)
+		assert.NoError(t, err)
+		assert.NotContains(t, string(byts), "hunter2")
+	}
+
+	// Loading the stack still replays the journal.
+	snap, _, err := lb.getStack("dev")
+	assert.NoError(t, err)
+	if assert.Len(t, snap.Resources, 1) {
+		assert.Equal(t, "hunter2", snap.Resources[0].Inputs["password"].StringValue())
+	}
+}
+
+func TestPruneHistory(t *testing.T) {
+	dir, err := ioutil.TempDir("", "filestate")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// Retention settings in the URL are removed before the URL is handed to go-cloud.
+	b, err := New(nil, FilePathPrefix+dir+"?history_keep=3&history_older_than=2d")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	lb := b.(*localBackend)
+	assert.Equal(t, RetentionPolicy{Keep: 3, OlderThan: 48 * time.Hour}, lb.retention)
+
+	// Write ten days of history for a stack with a dash in its name, one update and one backup per day.
+	now := time.Now()
+	name := tokens.QName("my-stack")
+	write := func(file string) {
+		assert.NoError(t, lb.bucket.WriteAll(context.TODO(), file, []byte("{}"), nil))
+	}
+	for day := 0; day < 10; day++ {
+		nanos := now.Add(-time.Duration(day) * 24 * time.Hour).UnixNano()
+		prefix := filepath.Join(lb.historyDirectory(name), fmt.Sprintf("%s-%d", name, nanos))
+		write(prefix + ".history.json")
+		write(prefix + ".checkpoint.json")
+		write(filepath.Join(lb.backupDirectory(name), fmt.Sprintf("%s.%d.json", name, nanos)))
+	}
+	write(filepath.Join(lb.backupDirectory(name), "unrelated.txt"))
+
+	count := func(dir string) int {
+		files, err := listBucket(lb.bucket, dir)
+		assert.NoError(t, err)
+		return len(files)
+	}
+
+	// Nothing is removed by an empty policy.
+	pruned, err := lb.pruneHistory(name, RetentionPolicy{}, now)
+	assert.NoError(t, err)
+	assert.Equal(t, PruneResult{}, pruned)
+
+	// Only entries that are older than the age are removed...
+	pruned, err = lb.pruneHistory(name, RetentionPolicy{OlderThan: 7*24*time.Hour + time.Minute}, now)
+	assert.NoError(t, err)
+	assert.Equal(t, PruneResult{Updates: 2, Backups: 2}, pruned)
+	assert.Equal(t, 16, count(lb.historyDirectory(name)))
+	assert.Equal(t, 9, count(lb.backupDirectory(name)))
+
+	// ...and the most recent entries are kept, regardless of their age.
+	pruned, err = lb.pruneHistory(name, RetentionPolicy{Keep: 5, OlderThan: time.Hour}, now)
+	assert.NoError(t, err)
+	assert.Equal(t, PruneResult{Updates: 3, Backups: 3}, pruned)
+	pruned, err = lb.pruneHistory(name, RetentionPolicy{Keep: 2}, now)
+	assert.NoError(t, err)
+	assert.Equal(t, PruneResult{Updates: 3, Backups: 3}, pruned)
+	assert.Equal(t, 4, count(lb.historyDirectory(name)))
+	assert.Equal(t, 3, count(lb.backupDirectory(name)))
+
+	history, err := lb.getHistory(name)
+	assert.NoError(t, err)
+	assert.Len(t, history, 2)
+
+	// Stacks without any history can be pruned as well.
+	pruned, err = lb.pruneHistory("other", RetentionPolicy{Keep: 1}, now)
+	assert.NoError(t, err)
+	assert.Equal(t, PruneResult{}, pruned)
+}
+
+func TestParseRetentionAge(t *testing.T) {
+	age, err := ParseRetentionAge("90d")
+	assert.NoError(t, err)
+	assert.Equal(t, 90*24*time.Hour, age)
+
+	age, err = ParseRetentionAge("1h30m")
+	assert.NoError(t, err)
+	assert.Equal(t, 90*time.Minute, age)
+
+	for _, invalid := range []string{"", "d", "-1d", "-1h", "ninety days"} {
+		_, err = ParseRetentionAge(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
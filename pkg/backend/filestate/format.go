@@ -0,0 +1,272 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestate
+
+import (
+	"bytes"
+	"context"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/pkg/v2/resource/stack"
+	"github.com/pulumi/pulumi/pkg/v2/secrets"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/encoding"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
+)
+
+// Checkpoints are written as JSON, which may then be compressed with gzip and, finally, encrypted with the stack's
+// secrets manager. Each of these stages adds an extension to the checkpoint's file name, so a checkpoint that is both
+// compressed and encrypted is stored in a file named `<stack>.json.gz.enc`. The stages are undone in reverse order
+// when a checkpoint is read, which allows checkpoints in any of these formats to be read regardless of how the backend
+// is currently configured.
+const (
+	// gzipExt is the extension added to the names of compressed checkpoints.
+	gzipExt = ".gz"
+	// encryptedExt is the extension added to the names of encrypted checkpoints.
+	encryptedExt = ".enc"
+)
+
+// checkpointExts are the extensions of all of the checkpoint formats we know how to read.
+var checkpointExts = []string{
+	encoding.JSONExt,
+	encoding.JSONExt + gzipExt,
+	encoding.JSONExt + encryptedExt,
+	encoding.JSONExt + gzipExt + encryptedExt,
+}
+
+// Query parameters on the backend URL that control the format of the checkpoints written by the backend. These are
+// removed from the URL before it is passed to go-cloud, which rejects parameters it does not recognize.
+const (
+	// gzipParam enables gzip compression of checkpoints, e.g. `file://~?gzip=true`.
+	gzipParam = "gzip"
+	// encryptParam enables encryption of whole checkpoints using each stack's secrets manager, e.g.
+	// `s3://bucket?encrypt=true`.
+	encryptParam = "encrypt"
+)
+
+// checkpointFormat describes the format of the checkpoints written by the backend.
+type checkpointFormat struct {
+	gzip    bool // true if checkpoints are compressed with gzip.
+	encrypt bool // true if checkpoints are encrypted with the stack's secrets manager.
+}
+
+// ext returns the file extension for checkpoints written in this format.
+func (f checkpointFormat) ext() string {
+	ext := encoding.JSONExt
+	if f.gzip {
+		ext += gzipExt
+	}
+	if f.encrypt {
+		ext += encryptedExt
+	}
+	return ext
+}
+
+// parseCheckpointFormat extracts the checkpoint format options from the query parameters of the given backend URL.
+// It returns the URL with those parameters removed.
+func parseCheckpointFormat(backendURL string) (string, checkpointFormat, error) {
+	var format checkpointFormat
+	u, values, err := extractQueryParams(backendURL, gzipParam, encryptParam)
+	if err != nil {
+		return "", format, err
+	}
+
+	parseBool := func(param string) (bool, error) {
+		v := values[param]
+		if v == "" {
+			return false, nil
+		}
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return false, errors.Errorf("invalid value %q for the %q query parameter; expected true or false", v, param)
+		}
+		return b, nil
+	}
+
+	if format.gzip, err = parseBool(gzipParam); err != nil {
+		return "", format, err
+	}
+	if format.encrypt, err = parseBool(encryptParam); err != nil {
+		return "", format, err
+	}
+	return u, format, nil
+}
+
+// extractQueryParams removes the given query parameters from the given backend URL, returning the URL without them
+// along with their values.
+func extractQueryParams(backendURL string, params ...string) (string, map[string]string, error) {
+	i := strings.Index(backendURL, "?")
+	if i == -1 {
+		return backendURL, nil, nil
+	}
+
+	base, query := backendURL[:i], backendURL[i+1:]
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "parsing query parameters of %s", backendURL)
+	}
+
+	extracted := make(map[string]string)
+	for _, param := range params {
+		extracted[param] = values.Get(param)
+		values.Del(param)
+	}
+
+	if len(values) != 0 {
+		base += "?" + values.Encode()
+	}
+	return base, extracted, nil
+}
+
+// splitCheckpointExt splits the name of a checkpoint file into its base name and its checkpoint extension. It returns
+// false if the name does not have a checkpoint extension.
+func splitCheckpointExt(name string) (string, string, bool) {
+	// Check the longest extensions first, as they end in the same suffixes as the shorter ones.
+	for i := len(checkpointExts) - 1; i >= 0; i-- {
+		if ext := checkpointExts[i]; strings.HasSuffix(name, ext) && len(name) > len(ext) {
+			return strings.TrimSuffix(name, ext), ext, true
+		}
+	}
+	return name, "", false
+}
+
+// encryptedCheckpoint is the envelope that holds an encrypted checkpoint. The secrets provider is stored in plaintext
+// so that the checkpoint can be decrypted without any additional configuration.
+type encryptedCheckpoint struct {
+	// SecretsProviders is the secrets provider that encrypted the checkpoint.
+	SecretsProviders apitype.SecretsProvidersV1 `json:"secrets_providers"`
+	// Ciphertext is the encrypted, base64-encoded checkpoint.
+	Ciphertext string `json:"ciphertext"`
+}
+
+// encodeCheckpoint encodes the given marshaled checkpoint according to the extension of the file it will be written
+// to, compressing and encrypting it as necessary. The secrets manager is only required for encrypted checkpoints.
+func encodeCheckpoint(file string, byts []byte, sm secrets.Manager) ([]byte, error) {
+	if strings.HasSuffix(file, encryptedExt) {
+		inner, err := encodeCheckpoint(strings.TrimSuffix(file, encryptedExt), byts, sm)
+		if err != nil {
+			return nil, err
+		}
+
+		if sm == nil {
+			return nil, errors.New("encrypting the checkpoint requires a secrets manager")
+		}
+		enc, err := sm.Encrypter()
+		if err != nil {
+			return nil, errors.Wrap(err, "getting encrypter for checkpoint")
+		}
+		ciphertext, err := enc.EncryptValue(base64.StdEncoding.EncodeToString(inner))
+		if err != nil {
+			return nil, errors.Wrap(err, "encrypting checkpoint")
+		}
+		envelope := encryptedCheckpoint{
+			SecretsProviders: apitype.SecretsProvidersV1{Type: sm.Type()},
+			Ciphertext:       ciphertext,
+		}
+		if state := sm.State(); state != nil {
+			rm, err := json.Marshal(state)
+			if err != nil {
+				return nil, err
+			}
+			envelope.SecretsProviders.State = rm
+		}
+		return json.MarshalIndent(envelope, "", "    ")
+	}
+
+	if strings.HasSuffix(file, gzipExt) {
+		var buf bytes.Buffer
+		w := gzip.NewWriter(&buf)
+		if _, err := w.Write(byts); err != nil {
+			return nil, errors.Wrap(err, "compressing checkpoint")
+		}
+		if err := w.Close(); err != nil {
+			return nil, errors.Wrap(err, "compressing checkpoint")
+		}
+		return buf.Bytes(), nil
+	}
+
+	return byts, nil
+}
+
+// decodeCheckpoint decodes the contents of the given checkpoint file, decrypting and decompressing it as necessary.
+// Encrypted checkpoints are decrypted using the secrets provider recorded alongside them.
+func decodeCheckpoint(file string, byts []byte) ([]byte, error) {
+	if strings.HasSuffix(file, encryptedExt) {
+		var envelope encryptedCheckpoint
+		if err := json.Unmarshal(byts, &envelope); err != nil {
+			return nil, errors.Wrap(err, "unmarshalling encrypted checkpoint")
+		}
+		sm, err := stack.DefaultSecretsProvider.OfType(envelope.SecretsProviders.Type, envelope.SecretsProviders.State)
+		if err != nil {
+			return nil, err
+		}
+		dec, err := sm.Decrypter()
+		if err != nil {
+			return nil, errors.Wrap(err, "getting decrypter for checkpoint")
+		}
+		plaintext, err := dec.DecryptValue(envelope.Ciphertext)
+		if err != nil {
+			return nil, errors.Wrap(err, "decrypting checkpoint")
+		}
+		inner, err := base64.StdEncoding.DecodeString(plaintext)
+		if err != nil {
+			return nil, errors.Wrap(err, "decoding decrypted checkpoint")
+		}
+		return decodeCheckpoint(strings.TrimSuffix(file, encryptedExt), inner)
+	}
+
+	if strings.HasSuffix(file, gzipExt) {
+		r, err := gzip.NewReader(bytes.NewReader(byts))
+		if err != nil {
+			return nil, errors.Wrap(err, "decompressing checkpoint")
+		}
+		defer contract.IgnoreClose(r)
+		inner, err := ioutil.ReadAll(r)
+		if err != nil {
+			return nil, errors.Wrap(err, "decompressing checkpoint")
+		}
+		return inner, nil
+	}
+
+	return byts, nil
+}
+
+// encodeStateFile encodes the given marshaled contents of a file that holds stack state in the backend's checkpoint
+// format. It returns the name of the file to write, which is the given base name with the format's extension.
+func (b *localBackend) encodeStateFile(base string, byts []byte, sm secrets.Manager) (string, []byte, error) {
+	file := base + b.format.ext()
+	byts, err := encodeCheckpoint(file, byts, sm)
+	if err != nil {
+		return "", nil, err
+	}
+	return file, byts, nil
+}
+
+// readStateFile reads and decodes a file written by encodeStateFile, in whichever format it was written.
+func (b *localBackend) readStateFile(file string) ([]byte, error) {
+	byts, err := b.bucket.ReadAll(context.TODO(), file)
+	if err != nil {
+		return nil, err
+	}
+	return decodeCheckpoint(file, byts)
+}
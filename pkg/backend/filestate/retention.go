@@ -0,0 +1,228 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestate
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"gocloud.dev/gcerrors"
+
+	"github.com/pulumi/pulumi/pkg/v2/backend"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/logging"
+)
+
+// Query parameters on the backend URL that configure the retention policy that is applied after each update. These
+// are removed from the URL before it is passed to go-cloud, like the checkpoint format parameters.
+const (
+	// historyKeepParam sets RetentionPolicy.Keep, e.g. `s3://bucket?history_keep=100`.
+	historyKeepParam = "history_keep"
+	// historyOlderThanParam sets RetentionPolicy.OlderThan, e.g. `s3://bucket?history_older_than=90d`.
+	historyOlderThanParam = "history_older_than"
+)
+
+// RetentionPolicy describes which of a stack's update history entries and checkpoint backups to remove. An entry is
+// removed only if it meets every criterion that is set; a policy with no criteria set removes nothing.
+type RetentionPolicy struct {
+	// Keep is the number of most recent entries to retain. Zero means that entries are not retained by count.
+	Keep int
+	// OlderThan is the age past which entries may be removed. Zero means that entries are not retained by age.
+	OlderThan time.Duration
+}
+
+// IsEmpty returns true if the policy does not remove anything.
+func (p RetentionPolicy) IsEmpty() bool {
+	return p.Keep == 0 && p.OlderThan == 0
+}
+
+// PruneResult reports what was removed when pruning a stack's history.
+type PruneResult struct {
+	// Updates is the number of update history entries that were removed.
+	Updates int
+	// Backups is the number of checkpoint backups that were removed.
+	Backups int
+}
+
+// ParseRetentionAge parses an age for a retention policy. In addition to the units accepted by time.ParseDuration,
+// ages may be given in days using the `d` suffix, e.g. `90d`.
+func ParseRetentionAge(s string) (time.Duration, error) {
+	if days := strings.TrimSuffix(s, "d"); days != s {
+		n, err := strconv.ParseUint(days, 10, 32)
+		if err != nil {
+			return 0, errors.Errorf("invalid age %q", s)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+
+	d, err := time.ParseDuration(s)
+	if err != nil || d < 0 {
+		return 0, errors.Errorf("invalid age %q", s)
+	}
+	return d, nil
+}
+
+// parseRetentionPolicy extracts the retention policy from the query parameters of the given backend URL. It returns
+// the URL with those parameters removed.
+func parseRetentionPolicy(backendURL string) (string, RetentionPolicy, error) {
+	var policy RetentionPolicy
+	u, values, err := extractQueryParams(backendURL, historyKeepParam, historyOlderThanParam)
+	if err != nil {
+		return "", policy, err
+	}
+
+	if v := values[historyKeepParam]; v != "" {
+		keep, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			return "", policy, errors.Errorf("invalid value %q for the %q query parameter; expected a number",
+				v, historyKeepParam)
+		}
+		policy.Keep = int(keep)
+	}
+	if v := values[historyOlderThanParam]; v != "" {
+		age, err := ParseRetentionAge(v)
+		if err != nil {
+			return "", policy, errors.Wrapf(err, "invalid value for the %q query parameter", historyOlderThanParam)
+		}
+		policy.OlderThan = age
+	}
+	return u, policy, nil
+}
+
+func (b *localBackend) PruneHistory(ctx context.Context, stackRef backend.StackReference,
+	policy RetentionPolicy) (PruneResult, error) {
+
+	return b.pruneHistory(stackRef.Name(), policy, time.Now())
+}
+
+// retainedFile is a file in a stack's history or backup directory, along with the time encoded in its name.
+type retainedFile struct {
+	key  string
+	time time.Time
+}
+
+// pruneHistory removes the stack's update history entries and checkpoint backups that fall outside the given policy.
+// Each history entry consists of an update's info and a copy of the checkpoint it produced, which are removed together.
+func (b *localBackend) pruneHistory(name tokens.QName, policy RetentionPolicy, now time.Time) (PruneResult, error) {
+	contract.Require(name != "", "name")
+
+	var result PruneResult
+	if policy.IsEmpty() {
+		return result, nil
+	}
+
+	// History files are named <stack-name>-<timestamp>.[checkpoint|history].json, so we group them by the part of the
+	// name that precedes the first dot after the stack name.
+	updates, err := b.listRetainedFiles(b.historyDirectory(name), func(fileName string) string {
+		prefix := string(name) + "-"
+		if !strings.HasPrefix(fileName, prefix) {
+			return ""
+		}
+		rest := strings.TrimPrefix(fileName, prefix)
+		if i := strings.Index(rest, "."); i != -1 {
+			rest = rest[:i]
+		}
+		return rest
+	})
+	if err != nil {
+		return result, errors.Wrap(err, "listing history")
+	}
+	if result.Updates, err = b.removeRetainedFiles(updates, policy, now); err != nil {
+		return result, errors.Wrap(err, "pruning history")
+	}
+
+	// Backups are named <stack-name>.<timestamp><checkpoint-extension>, and each stands alone.
+	backups, err := b.listRetainedFiles(b.backupDirectory(name), func(fileName string) string {
+		base, _, ok := splitCheckpointExt(fileName)
+		if !ok {
+			return ""
+		}
+		return base[strings.LastIndex(base, ".")+1:]
+	})
+	if err != nil {
+		return result, errors.Wrap(err, "listing backups")
+	}
+	if result.Backups, err = b.removeRetainedFiles(backups, policy, now); err != nil {
+		return result, errors.Wrap(err, "pruning backups")
+	}
+
+	return result, nil
+}
+
+// listRetainedFiles lists the files in the given directory, grouped by the timestamps that the given function
+// extracts from their names. Files without a valid timestamp are ignored. The groups are sorted from newest to oldest.
+func (b *localBackend) listRetainedFiles(dir string,
+	timestamp func(fileName string) string) ([][]retainedFile, error) {
+
+	files, err := listBucket(b.bucket, dir)
+	if err != nil {
+		// The directory doesn't exist until a stack has been updated.
+		if gcerrors.Code(errors.Cause(err)) == gcerrors.NotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	groups := make(map[int64][]retainedFile)
+	for _, file := range files {
+		if file.IsDir {
+			continue
+		}
+		nanos, err := strconv.ParseInt(timestamp(objectName(file)), 10, 64)
+		if err != nil {
+			logging.V(7).Infof("ignoring unrecognized file %s", file.Key)
+			continue
+		}
+		groups[nanos] = append(groups[nanos], retainedFile{key: file.Key, time: time.Unix(0, nanos)})
+	}
+
+	var sorted [][]retainedFile
+	for _, group := range groups {
+		sorted = append(sorted, group)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i][0].time.After(sorted[j][0].time)
+	})
+	return sorted, nil
+}
+
+// removeRetainedFiles removes the groups of files that fall outside the given policy, returning the number of groups
+// that were removed. The groups must be sorted from newest to oldest.
+func (b *localBackend) removeRetainedFiles(groups [][]retainedFile, policy RetentionPolicy,
+	now time.Time) (int, error) {
+
+	removed := 0
+	for i, group := range groups {
+		if policy.Keep != 0 && i < policy.Keep {
+			continue
+		}
+		if policy.OlderThan != 0 && now.Sub(group[0].time) < policy.OlderThan {
+			continue
+		}
+
+		for _, file := range group {
+			if err := b.bucket.Delete(context.TODO(), file.key); err != nil {
+				return removed, err
+			}
+		}
+		removed++
+	}
+	return removed, nil
+}
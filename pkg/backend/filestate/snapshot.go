@@ -15,11 +15,28 @@
 package filestate
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/pkg/v2/backend"
+	"github.com/pulumi/pulumi/pkg/v2/engine"
 	"github.com/pulumi/pulumi/pkg/v2/resource/deploy"
 	"github.com/pulumi/pulumi/pkg/v2/secrets"
+	"github.com/pulumi/pulumi/pkg/v2/version"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/cmdutil"
 )
 
+// DisableCheckpointJournalingEnvVar can be set to a truthy value to write a full checkpoint after every step of an
+// update instead of appending each step to a journal.
+const DisableCheckpointJournalingEnvVar = "PULUMI_DISABLE_CHECKPOINT_JOURNALING"
+
 // localSnapshotManager is a simple SnapshotManager implementation that persists snapshots
 // to disk on the local machine.
 type localSnapshotPersister struct {
@@ -38,6 +55,128 @@ func (sp *localSnapshotPersister) Save(snapshot *deploy.Snapshot) error {
 
 }
 
-func (b *localBackend) newSnapshotPersister(stackName tokens.QName, sm secrets.Manager) *localSnapshotPersister {
-	return &localSnapshotPersister{name: stackName, backend: b, sm: sm}
+// localJournalPersister is a SnapshotPersister that appends each step of an update to a journal stored next to the
+// stack's checkpoint. The journal is relative to the base snapshot of the update, which is saved as a full checkpoint
+// before the first entry is written. Saving a full snapshot compacts the journal by removing it.
+type localJournalPersister struct {
+	localSnapshotPersister
+
+	base       *deploy.Snapshot           // the base snapshot the journal is relative to.
+	serializer *backend.JournalSerializer // the journal's serializer, if the journal has been started.
+	sequence   int                        // the sequence number of the next journal entry.
+}
+
+var _ = backend.JournalPersister((*localJournalPersister)(nil))
+
+// journalHeader is written to the journal directory when a journal is started. It identifies the checkpoint that the
+// journal's entries are relative to.
+type journalHeader struct {
+	// Checkpoint is the SHA-256 hash of the checkpoint file the journal is relative to.
+	Checkpoint string `json:"checkpoint"`
+}
+
+// journalHeaderName is the name of the journal header within a journal directory, without its extension. Like the
+// journal's entries, the header is written in the backend's checkpoint format.
+const journalHeaderName = "header"
+
+func (sp *localJournalPersister) Save(snapshot *deploy.Snapshot) error {
+	if err := sp.localSnapshotPersister.Save(snapshot); err != nil {
+		return err
+	}
+
+	// The full checkpoint supersedes the journal, so we can remove it now. If we fail to do so, the journal will
+	// be ignored when loading the stack, as its header no longer matches the checkpoint.
+	sp.serializer = nil
+	return removeAllByPrefix(sp.backend.bucket, sp.backend.journalDirectory(sp.name))
+}
+
+func (sp *localJournalPersister) Append(entry engine.JournalEntry) error {
+	if sp.serializer == nil {
+		if err := sp.start(); err != nil {
+			return err
+		}
+	}
+
+	serialized, err := sp.serializer.Serialize(entry)
+	if err != nil {
+		return err
+	}
+	byts, err := json.Marshal(serialized)
+	if err != nil {
+		return errors.Wrap(err, "marshalling journal entry")
+	}
+
+	// Journal entries hold resource state, so they are compressed and encrypted just like the checkpoint.
+	name := filepath.Join(sp.backend.journalDirectory(sp.name), fmt.Sprintf("%010d", sp.sequence))
+	file, byts, err := sp.backend.encodeStateFile(name, byts, sp.journalSecretsManager())
+	if err != nil {
+		return errors.Wrap(err, "encoding journal entry")
+	}
+	if err = sp.backend.bucket.WriteAll(context.TODO(), file, byts, nil); err != nil {
+		return errors.Wrap(err, "writing journal entry")
+	}
+	sp.sequence++
+	return nil
+}
+
+// start begins a new journal by writing out the base snapshot as a full checkpoint and recording its hash in the
+// journal header. Any journal left behind by a previous update is replaced.
+func (sp *localJournalPersister) start() error {
+	base := sp.base
+	if base == nil {
+		// Use an empty snapshot so that the checkpoint records the secrets manager used by the journal's entries.
+		manifest := deploy.Manifest{Time: time.Now(), Version: version.Version}
+		manifest.Magic = manifest.NewMagic()
+		base = deploy.NewSnapshot(manifest, sp.sm, nil, nil)
+	}
+	if err := sp.Save(base); err != nil {
+		return err
+	}
+
+	file, err := sp.backend.existingStackPath(sp.name)
+	if err != nil {
+		return err
+	}
+	byts, err := sp.backend.bucket.ReadAll(context.TODO(), file)
+	if err != nil {
+		return errors.Wrap(err, "reading checkpoint")
+	}
+	header, err := json.Marshal(journalHeader{Checkpoint: checkpointHash(byts)})
+	if err != nil {
+		return errors.Wrap(err, "marshalling journal header")
+	}
+	headerFile, header, err := sp.backend.encodeStateFile(
+		filepath.Join(sp.backend.journalDirectory(sp.name), journalHeaderName), header, sp.journalSecretsManager())
+	if err != nil {
+		return errors.Wrap(err, "encoding journal header")
+	}
+	if err = sp.backend.bucket.WriteAll(context.TODO(), headerFile, header, nil); err != nil {
+		return errors.Wrap(err, "writing journal header")
+	}
+
+	serializer, err := backend.NewJournalSerializer(base, sp.sm)
+	if err != nil {
+		return err
+	}
+	sp.serializer, sp.sequence = serializer, 0
+	return nil
+}
+
+// journalSecretsManager returns the secrets manager used to encrypt the journal, which is the base snapshot's if the
+// update did not supply one, matching the manager that saveStack uses for the checkpoint.
+func (sp *localJournalPersister) journalSecretsManager() secrets.Manager {
+	if sp.sm == nil && sp.base != nil {
+		return sp.base.SecretsManager
+	}
+	return sp.sm
+}
+
+func (b *localBackend) newSnapshotPersister(stackName tokens.QName, sm secrets.Manager,
+	base *deploy.Snapshot) backend.SnapshotPersister {
+
+	persister := localSnapshotPersister{name: stackName, backend: b, sm: sm}
+	if cmdutil.IsTruthy(os.Getenv(DisableCheckpointJournalingEnvVar)) {
+		return &persister
+	}
+	return &localJournalPersister{localSnapshotPersister: persister, base: base}
 }
@@ -16,6 +16,8 @@ package filestate
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/retry"
@@ -28,6 +30,7 @@ import (
 	"github.com/pulumi/pulumi/pkg/v2/engine"
 
 	"github.com/pkg/errors"
+	"gocloud.dev/blob"
 	"gocloud.dev/gcerrors"
 
 	"github.com/pulumi/pulumi/pkg/v2/backend"
@@ -129,7 +132,7 @@ func (b *localBackend) getStack(name tokens.QName) (*deploy.Snapshot, string, er
 
 	file := b.stackPath(name)
 
-	chk, err := b.getCheckpoint(name)
+	chk, byts, err := b.getCheckpoint(name)
 	if err != nil {
 		return nil, file, errors.Wrap(err, "failed to load checkpoint")
 	}
@@ -140,6 +143,12 @@ func (b *localBackend) getStack(name tokens.QName) (*deploy.Snapshot, string, er
 		return nil, "", err
 	}
 
+	// If an update was interrupted before it could compact its journal, replay the journal on top of the checkpoint.
+	snapshot, err = b.replayJournal(name, byts, snapshot)
+	if err != nil {
+		return nil, file, errors.Wrap(err, "failed to replay checkpoint journal")
+	}
+
 	// Ensure the snapshot passes verification before returning it, to catch bugs early.
 	if !DisableIntegrityChecking {
 		if verifyerr := snapshot.VerifyIntegrity(); verifyerr != nil {
@@ -152,35 +161,121 @@ func (b *localBackend) getStack(name tokens.QName) (*deploy.Snapshot, string, er
 }
 
 // GetCheckpoint loads a checkpoint file for the given stack in this project, from the current project workspace.
-func (b *localBackend) getCheckpoint(stackName tokens.QName) (*apitype.CheckpointV3, error) {
-	chkpath := b.stackPath(stackName)
+// The raw contents of the checkpoint file are returned alongside the checkpoint itself.
+func (b *localBackend) getCheckpoint(stackName tokens.QName) (*apitype.CheckpointV3, []byte, error) {
+	chkpath, err := b.existingStackPath(stackName)
+	if err != nil {
+		return nil, nil, err
+	}
 	bytes, err := b.bucket.ReadAll(context.TODO(), chkpath)
 	if err != nil {
+		return nil, nil, err
+	}
+
+	decoded, err := decodeCheckpoint(chkpath, bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	chk, err := stack.UnmarshalVersionedCheckpointToLatestCheckpoint(decoded)
+	if err != nil {
+		return nil, nil, err
+	}
+	return chk, bytes, nil
+}
+
+// replayJournal replays the journal left behind by an interrupted update, if any, on top of the given snapshot. The
+// journal is ignored if it is not relative to the given checkpoint bytes.
+func (b *localBackend) replayJournal(name tokens.QName, checkpoint []byte,
+	snapshot *deploy.Snapshot) (*deploy.Snapshot, error) {
+
+	dir := b.journalDirectory(name)
+	files, err := listBucket(b.bucket, dir)
+	if err != nil {
+		if gcerrors.Code(errors.Cause(err)) == gcerrors.NotFound {
+			return snapshot, nil
+		}
 		return nil, err
 	}
 
-	return stack.UnmarshalVersionedCheckpointToLatestCheckpoint(bytes)
+	// The header and entries are encoded like checkpoints, so we find the header by its name without the extension.
+	var header *journalHeader
+	var entryFiles []*blob.ListObject
+	for _, file := range files {
+		if base, _, _ := splitCheckpointExt(objectName(file)); base != journalHeaderName {
+			entryFiles = append(entryFiles, file)
+			continue
+		}
+		byts, err := b.readStateFile(file.Key)
+		if err != nil {
+			return nil, errors.Wrap(err, "reading journal header")
+		}
+		header = &journalHeader{}
+		if err = json.Unmarshal(byts, header); err != nil {
+			return nil, errors.Wrap(err, "unmarshalling journal header")
+		}
+	}
+	if header == nil {
+		return snapshot, nil
+	}
+	if header.Checkpoint != checkpointHash(checkpoint) {
+		logging.V(7).Infof("Ignoring stale journal for stack %s", name)
+		return snapshot, nil
+	}
+
+	// listBucket returns the files sorted by name, which matches the order in which the entries were written.
+	var entries []backend.JournalEntryV1
+	for _, file := range entryFiles {
+		byts, err := b.readStateFile(file.Key)
+		if err != nil {
+			return nil, errors.Wrapf(err, "reading journal entry %s", objectName(file))
+		}
+		var entry backend.JournalEntryV1
+		if err = json.Unmarshal(byts, &entry); err != nil {
+			return nil, errors.Wrapf(err, "unmarshalling journal entry %s", objectName(file))
+		}
+		entries = append(entries, entry)
+	}
+	if len(entries) == 0 {
+		return snapshot, nil
+	}
+
+	logging.V(7).Infof("Replaying %d journal entries for stack %s", len(entries), name)
+	return backend.ReplayJournal(snapshot, entries)
+}
+
+// checkpointHash returns the hash of the given checkpoint file contents that identifies the checkpoint in a journal.
+func checkpointHash(checkpoint []byte) string {
+	sum := sha256.Sum256(checkpoint)
+	return hex.EncodeToString(sum[:])
 }
 
 func (b *localBackend) saveStack(name tokens.QName, snap *deploy.Snapshot, sm secrets.Manager) (string, error) {
 	// Make a serializable stack and then use the encoder to encode it.
 	file := b.stackPath(name)
-	m, ext := encoding.Detect(file)
-	if m == nil {
-		return "", errors.Errorf("resource serialization failed; illegal markup extension: '%v'", ext)
-	}
-	if filepath.Ext(file) == "" {
-		file = file + ext
-	}
 	chk, err := stack.SerializeCheckpoint(name, snap, sm, false /* showSecrets */)
 	if err != nil {
 		return "", errors.Wrap(err, "serializaing checkpoint")
 	}
-	byts, err := m.Marshal(chk)
+	byts, err := encoding.JSON.Marshal(chk)
 	if err != nil {
 		return "", errors.Wrap(err, "An IO error occurred while marshalling the checkpoint")
 	}
 
+	// Compress and encrypt the checkpoint as configured. A stack that has no state yet may not have a secrets manager
+	// either, in which case there is nothing to protect and we write the checkpoint unencrypted.
+	if sm == nil && snap != nil {
+		sm = snap.SecretsManager
+	}
+	if b.format.encrypt && sm == nil {
+		if snap != nil {
+			return "", errors.Errorf("cannot encrypt the checkpoint for stack %s because it has no secrets manager", name)
+		}
+		file = strings.TrimSuffix(file, encryptedExt)
+	}
+	if byts, err = encodeCheckpoint(file, byts, sm); err != nil {
+		return "", err
+	}
+
 	// Back up the existing file if it already exists.
 	bck := backupTarget(b.bucket, file)
 
@@ -220,6 +315,9 @@ func (b *localBackend) saveStack(name tokens.QName, snap *deploy.Snapshot, sm se
 
 	logging.V(7).Infof("Saved stack %s checkpoint to: %s (backup=%s)", name, file, bck)
 
+	// Remove any checkpoints for this stack that were written in other formats, as they are now out of date.
+	b.removeOtherCheckpoints(file)
+
 	// And if we are retaining historical checkpoint information, write it out again
 	if cmdutil.IsTruthy(os.Getenv("PULUMI_RETAIN_CHECKPOINTS")) {
 		if err = b.bucket.WriteAll(context.TODO(), fmt.Sprintf("%v.%v", file, time.Now().UnixNano()), byts, nil); err != nil {
@@ -246,11 +344,17 @@ func (b *localBackend) removeStack(name tokens.QName) error {
 	contract.Require(name != "", "name")
 
 	// Just make a backup of the file and don't write out anything new.
-	file := b.stackPath(name)
+	file, err := b.existingStackPath(name)
+	if err != nil {
+		return err
+	}
 	backupTarget(b.bucket, file)
 
 	historyDir := b.historyDirectory(name)
-	return removeAllByPrefix(b.bucket, historyDir)
+	if err := removeAllByPrefix(b.bucket, historyDir); err != nil {
+		return err
+	}
+	return removeAllByPrefix(b.bucket, b.journalDirectory(name))
 }
 
 // backupTarget makes a backup of an existing file, in preparation for writing a new one.  Instead of a copy, it
@@ -274,7 +378,10 @@ func (b *localBackend) backupStack(name tokens.QName) error {
 	}
 
 	// Read the current checkpoint file. (Assuming it aleady exists.)
-	stackPath := b.stackPath(name)
+	stackPath, err := b.existingStackPath(name)
+	if err != nil {
+		return err
+	}
 	byts, err := b.bucket.ReadAll(context.TODO(), stackPath)
 	if err != nil {
 		return err
@@ -284,9 +391,7 @@ func (b *localBackend) backupStack(name tokens.QName) error {
 	backupDir := b.backupDirectory(name)
 
 	// Write out the new backup checkpoint file.
-	stackFile := filepath.Base(stackPath)
-	ext := filepath.Ext(stackFile)
-	base := strings.TrimSuffix(stackFile, ext)
+	base, ext, _ := splitCheckpointExt(filepath.Base(stackPath))
 	backupFile := fmt.Sprintf("%s.%v%s", base, time.Now().UnixNano(), ext)
 	return b.bucket.WriteAll(context.TODO(), filepath.Join(backupDir, backupFile), byts, nil)
 }
@@ -294,17 +399,66 @@ func (b *localBackend) backupStack(name tokens.QName) error {
 func (b *localBackend) stackPath(stack tokens.QName) string {
 	path := filepath.Join(b.StateDir(), workspace.StackDir)
 	if stack != "" {
-		path = filepath.Join(path, fsutil.QnamePath(stack)+".json")
+		path = filepath.Join(path, fsutil.QnamePath(stack)+b.format.ext())
 	}
 
 	return path
 }
 
+// existingStackPath returns the path of the given stack's checkpoint file. Unlike stackPath, this finds checkpoints
+// that were written in a format other than the backend's current one. If the stack has no checkpoint, the path for the
+// current format is returned.
+func (b *localBackend) existingStackPath(stack tokens.QName) (string, error) {
+	file := b.stackPath(stack)
+	for _, candidate := range append([]string{file}, otherCheckpointPaths(file)...) {
+		exists, err := b.bucket.Exists(context.TODO(), candidate)
+		if err != nil {
+			return "", err
+		}
+		if exists {
+			return candidate, nil
+		}
+	}
+	return file, nil
+}
+
+// removeOtherCheckpoints removes the checkpoints that share the given checkpoint's stack but are in other formats.
+func (b *localBackend) removeOtherCheckpoints(file string) {
+	for _, other := range otherCheckpointPaths(file) {
+		if exists, err := b.bucket.Exists(context.TODO(), other); err == nil && exists {
+			err = b.bucket.Delete(context.TODO(), other)
+			if err != nil {
+				logging.V(5).Infof("error deleting checkpoint: %v (%v) skipping", other, err)
+			}
+		}
+	}
+}
+
+// otherCheckpointPaths returns the paths of the checkpoints that share the given checkpoint's stack but are in other
+// formats.
+func otherCheckpointPaths(file string) []string {
+	base, ext, ok := splitCheckpointExt(file)
+	contract.Assertf(ok, "%s is not a checkpoint file", file)
+
+	var others []string
+	for _, other := range checkpointExts {
+		if other != ext {
+			others = append(others, base+other)
+		}
+	}
+	return others
+}
+
 func (b *localBackend) historyDirectory(stack tokens.QName) string {
 	contract.Require(stack != "", "stack")
 	return filepath.Join(b.StateDir(), workspace.HistoryDir, fsutil.QnamePath(stack))
 }
 
+func (b *localBackend) journalDirectory(stack tokens.QName) string {
+	contract.Require(stack != "", "stack")
+	return filepath.Join(b.StateDir(), workspace.JournalDir, fsutil.QnamePath(stack))
+}
+
 func (b *localBackend) backupDirectory(stack tokens.QName) string {
 	contract.Require(stack != "", "stack")
 	return filepath.Join(b.StateDir(), workspace.BackupDir, fsutil.QnamePath(stack))
@@ -334,12 +488,12 @@ func (b *localBackend) getHistory(name tokens.QName) ([]backend.UpdateInfo, erro
 		filepath := file.Key
 
 		// Open all of the history files, ignoring the checkpoints.
-		if !strings.HasSuffix(filepath, ".history.json") {
+		if base, _, ok := splitCheckpointExt(filepath); !ok || !strings.HasSuffix(base, ".history") {
 			continue
 		}
 
 		var update backend.UpdateInfo
-		b, err := b.bucket.ReadAll(context.TODO(), filepath)
+		b, err := b.readStateFile(filepath)
 		if err != nil {
 			return nil, errors.Wrapf(err, "reading history file %s", filepath)
 		}
@@ -390,8 +544,9 @@ func (b *localBackend) renameHistory(oldName tokens.QName, newName tokens.QName)
 	return nil
 }
 
-// addToHistory saves the UpdateInfo and makes a copy of the current Checkpoint file.
-func (b *localBackend) addToHistory(name tokens.QName, update backend.UpdateInfo) error {
+// addToHistory saves the UpdateInfo and makes a copy of the current Checkpoint file. The UpdateInfo is written in the
+// backend's checkpoint format, encrypted with the given secrets manager if necessary.
+func (b *localBackend) addToHistory(name tokens.QName, update backend.UpdateInfo, sm secrets.Manager) error {
 	contract.Require(name != "", "name")
 
 	dir := b.historyDirectory(name)
@@ -405,12 +560,24 @@ func (b *localBackend) addToHistory(name tokens.QName, update backend.UpdateInfo
 		return err
 	}
 
-	historyFile := fmt.Sprintf("%s.history.json", pathPrefix)
+	// History files record the update's configuration and environment, so they are protected like checkpoints.
+	if b.format.encrypt && sm == nil {
+		return errors.Errorf("cannot encrypt the update history for stack %s because it has no secrets manager", name)
+	}
+	historyFile, byts, err := b.encodeStateFile(pathPrefix+".history", byts, sm)
+	if err != nil {
+		return err
+	}
 	if err = b.bucket.WriteAll(context.TODO(), historyFile, byts, nil); err != nil {
 		return err
 	}
 
-	// Make a copy of the checkpoint file. (Assuming it already exists.)
-	checkpointFile := fmt.Sprintf("%s.checkpoint.json", pathPrefix)
-	return b.bucket.Copy(context.TODO(), checkpointFile, b.stackPath(name), nil)
+	// Make a copy of the checkpoint file, retaining its format. (Assuming it already exists.)
+	stackPath, err := b.existingStackPath(name)
+	if err != nil {
+		return err
+	}
+	_, ext, _ := splitCheckpointExt(stackPath)
+	checkpointFile := fmt.Sprintf("%s.checkpoint%s", pathPrefix, ext)
+	return b.bucket.Copy(context.TODO(), checkpointFile, stackPath, nil)
 }
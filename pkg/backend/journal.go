@@ -0,0 +1,260 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/pkg/v2/engine"
+	"github.com/pulumi/pulumi/pkg/v2/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/v2/resource/stack"
+	"github.com/pulumi/pulumi/pkg/v2/secrets"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/config"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
+)
+
+// JournalPersister is a SnapshotPersister that is also able to persist individual journal entries. When a
+// SnapshotManager is created with a JournalPersister, each mutation is appended to the journal instead of rewriting
+// the entire snapshot, which keeps the cost of persisting an update linear in the number of steps. The full snapshot
+// is only saved when the manager is closed; persisters are expected to discard their journal at that point.
+type JournalPersister interface {
+	SnapshotPersister
+
+	// Append persists a single journal entry. Entries are relative to the base snapshot that the SnapshotManager was
+	// created with, and must be serialized before Append returns, as the engine may mutate the entry's states later.
+	Append(entry engine.JournalEntry) error
+}
+
+// JournalEntryV1 is the serialized form of an engine.JournalEntry.
+type JournalEntryV1 struct {
+	// Kind is the kind of the journal entry.
+	Kind engine.JournalEntryKind `json:"kind"`
+	// Op is the operation performed by the entry's step.
+	Op deploy.StepOp `json:"op"`
+	// Old is the state of the resource before the step, if any.
+	Old *JournalStateV1 `json:"old,omitempty"`
+	// New is the state of the resource after the step, if any.
+	New *JournalStateV1 `json:"new,omitempty"`
+}
+
+// JournalStateV1 is the serialized form of a resource state referenced by a journal entry.
+type JournalStateV1 struct {
+	// Ref identifies the state. References below the number of resources in the base snapshot refer to the resource at
+	// that index in the base snapshot; larger references refer to states introduced by earlier journal entries.
+	Ref int `json:"ref"`
+	// State is the state as of the journal entry. It is omitted if the entry did not change the state.
+	State *apitype.ResourceV3 `json:"state,omitempty"`
+}
+
+// JournalSerializer serializes journal entries relative to a base snapshot. The engine identifies resource states by
+// pointer, so the serializer assigns each state a stable reference that can be resolved when the journal is replayed.
+type JournalSerializer struct {
+	refs map[*resource.State]int // the references assigned to each state seen so far.
+	enc  config.Encrypter        // the encrypter to use for secret values.
+}
+
+// NewJournalSerializer creates a new serializer for journal entries relative to the given base snapshot. Secret values
+// are encrypted using the given secrets manager.
+func NewJournalSerializer(base *deploy.Snapshot, sm secrets.Manager) (*JournalSerializer, error) {
+	var enc config.Encrypter = config.NewPanicCrypter()
+	if sm != nil {
+		e, err := sm.Encrypter()
+		if err != nil {
+			return nil, errors.Wrap(err, "getting encrypter for journal")
+		}
+		enc = e
+	}
+
+	refs := make(map[*resource.State]int)
+	if base != nil {
+		for i, res := range base.Resources {
+			refs[res] = i
+		}
+	}
+	return &JournalSerializer{refs: refs, enc: enc}, nil
+}
+
+// Serialize serializes the given journal entry.
+func (s *JournalSerializer) Serialize(entry engine.JournalEntry) (JournalEntryV1, error) {
+	step := entry.Step
+	serialized := JournalEntryV1{Kind: entry.Kind, Op: step.Op()}
+
+	// The old state of a step is usually unchanged, but the engine does mutate it in some cases (e.g. by marking it
+	// for deletion as part of a create-before-delete replacement). Same steps never do, so we skip the state for
+	// those to keep the journal small.
+	if old := step.Old(); old != nil {
+		state, err := s.serializeState(old, step.Op() != deploy.OpSame)
+		if err != nil {
+			return JournalEntryV1{}, err
+		}
+		serialized.Old = state
+	}
+	if new := step.New(); new != nil {
+		state, err := s.serializeState(new, true)
+		if err != nil {
+			return JournalEntryV1{}, err
+		}
+		serialized.New = state
+	}
+	return serialized, nil
+}
+
+func (s *JournalSerializer) serializeState(state *resource.State, includeState bool) (*JournalStateV1, error) {
+	ref, has := s.refs[state]
+	if !has {
+		ref = len(s.refs)
+		s.refs[state] = ref
+		includeState = true
+	}
+
+	serialized := &JournalStateV1{Ref: ref}
+	if includeState {
+		res, err := stack.SerializeResource(state, s.enc, false /* showSecrets */)
+		if err != nil {
+			return nil, errors.Wrapf(err, "serializing state for %s", state.URN)
+		}
+		serialized.State = &res
+	}
+	return serialized, nil
+}
+
+// ReplayJournal replays the given journal entries on top of the base snapshot they were recorded against and returns
+// the resulting snapshot. The base snapshot's resources are updated in place.
+func ReplayJournal(base *deploy.Snapshot, entries []JournalEntryV1) (*deploy.Snapshot, error) {
+	var dec config.Decrypter = config.NewPanicCrypter()
+	var enc config.Encrypter = config.NewPanicCrypter()
+	var states []*resource.State
+	if base != nil {
+		if base.SecretsManager != nil {
+			d, err := base.SecretsManager.Decrypter()
+			if err != nil {
+				return nil, err
+			}
+			e, err := base.SecretsManager.Encrypter()
+			if err != nil {
+				return nil, err
+			}
+			dec, enc = d, e
+		}
+		states = append(states, base.Resources...)
+	}
+
+	resolve := func(serialized *JournalStateV1) (*resource.State, error) {
+		if serialized == nil {
+			return nil, nil
+		}
+
+		var state *resource.State
+		switch {
+		case serialized.Ref < len(states):
+			state = states[serialized.Ref]
+		case serialized.Ref == len(states):
+			if serialized.State == nil {
+				return nil, errors.Errorf("journal introduces state %d without contents", serialized.Ref)
+			}
+			state = &resource.State{}
+			states = append(states, state)
+		default:
+			return nil, errors.Errorf("journal refers to unknown state %d", serialized.Ref)
+		}
+
+		// Update the state in place so that all references to it observe its new contents.
+		if serialized.State != nil {
+			res, err := stack.DeserializeResource(*serialized.State, dec, enc)
+			if err != nil {
+				return nil, err
+			}
+			*state = *res
+		}
+		return state, nil
+	}
+
+	journal := make(engine.JournalEntries, len(entries))
+	for i, entry := range entries {
+		old, err := resolve(entry.Old)
+		if err != nil {
+			return nil, errors.Wrapf(err, "replaying journal entry %d", i)
+		}
+		new, err := resolve(entry.New)
+		if err != nil {
+			return nil, errors.Wrapf(err, "replaying journal entry %d", i)
+		}
+		journal[i] = engine.JournalEntry{Kind: entry.Kind, Step: &journalStep{op: entry.Op, old: old, new: new}}
+	}
+
+	snap := journal.Snap(base)
+	if base != nil {
+		snap.Manifest = base.Manifest
+	}
+	if err := snap.NormalizeURNReferences(); err != nil {
+		return nil, errors.Wrap(err, "failed to normalize URN references")
+	}
+	return snap, nil
+}
+
+// journalStep is a deploy.Step that has been read back from a journal. It carries just enough information to replay
+// the step into a snapshot, and cannot be applied.
+type journalStep struct {
+	op  deploy.StepOp
+	old *resource.State
+	new *resource.State
+}
+
+func (s *journalStep) Apply(preview bool) (resource.Status, deploy.StepCompleteFunc, error) {
+	contract.Failf("journal steps cannot be applied")
+	return resource.StatusOK, nil, nil
+}
+
+func (s *journalStep) Op() deploy.StepOp {
+	return s.op
+}
+
+func (s *journalStep) URN() resource.URN {
+	return s.Res().URN
+}
+
+func (s *journalStep) Type() tokens.Type {
+	return s.Res().Type
+}
+
+func (s *journalStep) Provider() string {
+	return s.Res().Provider
+}
+
+func (s *journalStep) Old() *resource.State {
+	return s.old
+}
+
+func (s *journalStep) New() *resource.State {
+	return s.new
+}
+
+func (s *journalStep) Res() *resource.State {
+	if s.new != nil {
+		return s.new
+	}
+	return s.old
+}
+
+func (s *journalStep) Logical() bool {
+	return true
+}
+
+func (s *journalStep) Deployment() *deploy.Deployment {
+	return nil
+}
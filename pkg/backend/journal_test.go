@@ -0,0 +1,183 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/pkg/v2/engine"
+	"github.com/pulumi/pulumi/pkg/v2/resource/deploy"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+)
+
+type MockJournalPersister struct {
+	MockStackPersister
+
+	serializer *JournalSerializer
+	Entries    []JournalEntryV1
+}
+
+func (m *MockJournalPersister) Append(entry engine.JournalEntry) error {
+	serialized, err := m.serializer.Serialize(entry)
+	if err != nil {
+		return err
+	}
+
+	// Round-trip the entry through JSON to ensure that it does not retain any references to the engine's states.
+	byts, err := json.Marshal(serialized)
+	if err != nil {
+		return err
+	}
+	var roundTripped JournalEntryV1
+	if err = json.Unmarshal(byts, &roundTripped); err != nil {
+		return err
+	}
+	m.Entries = append(m.Entries, roundTripped)
+	return nil
+}
+
+func MockJournalSetup(t *testing.T, baseSnap *deploy.Snapshot) (*SnapshotManager, *MockJournalPersister) {
+	err := baseSnap.VerifyIntegrity()
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	serializer, err := NewJournalSerializer(baseSnap, baseSnap.SecretsManager)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	sp := &MockJournalPersister{serializer: serializer}
+	return NewSnapshotManager(sp, baseSnap), sp
+}
+
+func TestJournalReplay(t *testing.T) {
+	newBase := func() *deploy.Snapshot {
+		a := NewResource("a")
+		b := NewResource("b", a.URN)
+		c := NewResource("c", a.URN, b.URN)
+		d := NewResource("d", c.URN)
+		return NewSnapshot([]*resource.State{a, b, c, d})
+	}
+
+	base := newBase()
+	a, b, c, d := base.Resources[0], base.Resources[1], base.Resources[2], base.Resources[3]
+	manager, sp := MockJournalSetup(t, base)
+
+	applyStep := func(step deploy.Step, successful bool) {
+		mutation, err := manager.BeginMutation(step)
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+
+		err = mutation.End(step, successful)
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+	}
+
+	// b is the same, but now depends on nothing.
+	bPrime := NewResource(string(b.URN))
+	applyStep(deploy.NewSameStep(nil, MockRegisterResourceEvent{}, b, bPrime), true)
+
+	// c is replaced using create-before-delete, which marks the old c for deletion in place.
+	cPrime := NewResource(string(c.URN), bPrime.URN)
+	createReplacement := deploy.NewCreateReplacementStep(nil, MockRegisterResourceEvent{}, c, cPrime, nil, nil, nil, true)
+	c.Delete = true
+	applyStep(createReplacement, true)
+
+	// d fails to update, and so does a new resource e.
+	dPrime := NewResource(string(d.URN), cPrime.URN)
+	applyStep(deploy.NewUpdateStep(nil, MockRegisterResourceEvent{}, d, dPrime, nil, nil, nil, nil), false)
+	e := NewResource("e", bPrime.URN)
+	applyStep(deploy.NewCreateStep(nil, MockRegisterResourceEvent{}, e), false)
+
+	// f is created and then has its outputs registered.
+	f := NewResource("f", cPrime.URN)
+	applyStep(deploy.NewCreateStep(nil, MockRegisterResourceEvent{}, f), true)
+	f.Outputs["foo"] = resource.NewStringProperty("bar")
+	assert.NoError(t, manager.RegisterResourceOutputs(deploy.NewCreateStep(nil, MockRegisterResourceEvent{}, f)))
+
+	// The old c is deleted, and so is a, which is no longer in the program.
+	applyStep(deploy.NewDeleteReplacementStep(nil, c, false), true)
+	applyStep(deploy.NewDeleteStep(nil, a), true)
+
+	// Nothing has been saved in full yet, but everything has been journaled.
+	assert.Empty(t, sp.SavedSnapshots)
+	assert.NotEmpty(t, sp.Entries)
+
+	expected := manager.snap()
+	assert.NoError(t, expected.NormalizeURNReferences())
+
+	// Replaying the journal against a fresh copy of the base snapshot must produce the same snapshot.
+	replayed, err := ReplayJournal(newBase(), sp.Entries)
+	assert.NoError(t, err)
+	assert.NoError(t, replayed.VerifyIntegrity())
+	if assert.Len(t, replayed.Resources, len(expected.Resources)) {
+		for i, res := range expected.Resources {
+			assert.Equal(t, res.URN, replayed.Resources[i].URN)
+			assert.Equal(t, res.Delete, replayed.Resources[i].Delete)
+			assert.Equal(t, res.Dependencies, replayed.Resources[i].Dependencies)
+			assert.Equal(t, res.Outputs, replayed.Resources[i].Outputs)
+		}
+	}
+	assert.Equal(t, 0, len(replayed.PendingOperations))
+
+	// Closing the manager compacts the journal into a full snapshot.
+	assert.NoError(t, manager.Close())
+	if assert.Len(t, sp.SavedSnapshots, 1) {
+		assert.Len(t, sp.LastSnap().Resources, len(expected.Resources))
+	}
+}
+
+func TestJournalPendingOperations(t *testing.T) {
+	manager, sp := MockJournalSetup(t, NewSnapshot(nil))
+
+	// Begin a create, but never finish it.
+	a := NewResource("a")
+	_, err := manager.BeginMutation(deploy.NewCreateStep(nil, MockRegisterResourceEvent{}, a))
+	assert.NoError(t, err)
+
+	replayed, err := ReplayJournal(NewSnapshot(nil), sp.Entries)
+	assert.NoError(t, err)
+	assert.Empty(t, replayed.Resources)
+	if assert.Len(t, replayed.PendingOperations, 1) {
+		assert.Equal(t, a.URN, replayed.PendingOperations[0].Resource.URN)
+		assert.Equal(t, resource.OperationTypeCreating, replayed.PendingOperations[0].Type)
+	}
+}
+
+func TestJournalRefreshFallsBackToFullSnapshots(t *testing.T) {
+	a := NewResource("a")
+	manager, sp := MockJournalSetup(t, NewSnapshot([]*resource.State{a}))
+
+	refresh := deploy.NewRefreshStep(nil, a, nil)
+	mutation, err := manager.BeginMutation(refresh)
+	assert.NoError(t, err)
+	assert.NoError(t, mutation.End(refresh, true))
+
+	// Once the base snapshot may have been rewritten, mutations are saved in full rather than journaled.
+	b := NewResource("b")
+	create := deploy.NewCreateStep(nil, MockRegisterResourceEvent{}, b)
+	mutation, err = manager.BeginMutation(create)
+	assert.NoError(t, err)
+	assert.NoError(t, mutation.End(create, true))
+
+	assert.Empty(t, sp.Entries)
+	assert.Len(t, sp.SavedSnapshots, 2)
+}
@@ -0,0 +1,110 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"strings"
+
+	opentracing "github.com/opentracing/opentracing-go"
+
+	"github.com/pulumi/pulumi/pkg/v2/backend/display"
+	"github.com/pulumi/pulumi/pkg/v2/engine"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/result"
+)
+
+// RunLocalUpdate performs an update of the given kind against a locally hosted stack. The update's events are shown on
+// the CLI and forwarded to callerEventsOpt, if it is non-nil, and its snapshots are saved using the given persister.
+// If recordEventOpt is non-nil, it is called with each engine event so that the events can be saved with the update.
+func RunLocalUpdate(ctx context.Context, b Backend, kind apitype.UpdateKind, stackRef StackReference,
+	update engine.UpdateInfo, op UpdateOperation, opts ApplierOptions, callerEventsOpt chan<- engine.Event,
+	persister SnapshotPersister, recordEventOpt func(engine.Event)) (engine.ResourceChanges, result.Result) {
+
+	actionLabel := ActionLabel(kind, opts.DryRun)
+
+	// Spawn a display loop to show events on the CLI.
+	displayEvents := make(chan engine.Event)
+	displayDone := make(chan bool)
+	go display.ShowEvents(
+		strings.ToLower(actionLabel), kind, stackRef.Name(), op.Proj.Name,
+		displayEvents, displayDone, op.Opts.Display, opts.DryRun)
+
+	// Create a separate event channel for engine events that we'll pipe to both listening streams.
+	engineEvents := make(chan engine.Event)
+
+	scope := op.Scopes.NewScope(engineEvents, opts.DryRun)
+	eventsDone := make(chan bool)
+	go func() {
+		// Pull in all events from the engine and send them to the two listeners.
+		for e := range engineEvents {
+			displayEvents <- e
+
+			// If the caller also wants to see the events, stream them there also.
+			if callerEventsOpt != nil {
+				callerEventsOpt <- e
+			}
+
+			if recordEventOpt != nil {
+				recordEventOpt(e)
+			}
+		}
+
+		close(eventsDone)
+	}()
+
+	// Create the management machinery.
+	manager := NewSnapshotManager(persister, update.GetTarget().Snapshot)
+	engineCtx := &engine.Context{
+		Cancel:          scope.Context(),
+		Events:          engineEvents,
+		SnapshotManager: manager,
+		BackendClient:   NewBackendClient(b),
+	}
+	if parentSpan := opentracing.SpanFromContext(ctx); parentSpan != nil {
+		engineCtx.ParentSpan = parentSpan.Context()
+	}
+
+	// Perform the update
+	var changes engine.ResourceChanges
+	var updateRes result.Result
+	switch kind {
+	case apitype.PreviewUpdate:
+		changes, updateRes = engine.Update(update, engineCtx, op.Opts.Engine, true)
+	case apitype.UpdateUpdate:
+		changes, updateRes = engine.Update(update, engineCtx, op.Opts.Engine, opts.DryRun)
+	case apitype.ResourceImportUpdate:
+		changes, updateRes = engine.Import(update, engineCtx, op.Opts.Engine, op.Imports, opts.DryRun)
+	case apitype.RefreshUpdate:
+		changes, updateRes = engine.Refresh(update, engineCtx, op.Opts.Engine, opts.DryRun)
+	case apitype.DestroyUpdate:
+		changes, updateRes = engine.Destroy(update, engineCtx, op.Opts.Engine, opts.DryRun)
+	default:
+		contract.Failf("Unrecognized update kind: %s", kind)
+	}
+
+	// Wait for the display to finish showing all the events.
+	<-displayDone
+	scope.Close() // Don't take any cancellations anymore, we're shutting down.
+	close(engineEvents)
+	contract.IgnoreClose(manager)
+
+	// Make sure the goroutine writing to displayEvents and events has exited before proceeding.
+	<-eventsDone
+	close(displayEvents)
+
+	return changes, updateRes
+}
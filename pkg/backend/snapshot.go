@@ -57,6 +57,7 @@ type SnapshotPersister interface {
 // that it creates and expects those mutations to be persisted directly to the snapshot.
 type SnapshotManager struct {
 	persister        SnapshotPersister        // The persister responsible for invalidating and persisting the snapshot
+	journal          JournalPersister         // The persister's journal, if it supports journaling and it is in use
 	baseSnapshot     *deploy.Snapshot         // The base snapshot for this plan
 	resources        []*resource.State        // The list of resources operated upon by this plan
 	operations       []resource.Operation     // The set of operations known to be outstanding in this plan
@@ -72,6 +73,7 @@ var _ engine.SnapshotManager = (*SnapshotManager)(nil)
 
 type mutationRequest struct {
 	mutator func() bool
+	entry   *engine.JournalEntry
 	result  chan<- error
 }
 
@@ -95,12 +97,26 @@ func (sm *SnapshotManager) Close() error {
 // meaningful changes (see sameSnapshotMutation.mustWrite for details). Any elided writes
 // are flushed by the next non-elided write or the next call to Close.
 //
+// If the manager's persister supports journaling, mutations that carry a journal entry (see
+// mutateStep) are appended to the journal rather than written as a full checkpoint. The journal
+// is compacted into a full checkpoint by the call to Close.
+//
 // You should never observe or mutate the global snapshot without using this function unless
 // you have a very good justification.
 func (sm *SnapshotManager) mutate(mutator func() bool) error {
+	return sm.doMutate(mutationRequest{mutator: mutator})
+}
+
+// mutateStep is like mutate, but also records a journal entry of the given kind for the given step.
+func (sm *SnapshotManager) mutateStep(kind engine.JournalEntryKind, step deploy.Step, mutator func() bool) error {
+	return sm.doMutate(mutationRequest{mutator: mutator, entry: &engine.JournalEntry{Kind: kind, Step: step}})
+}
+
+func (sm *SnapshotManager) doMutate(request mutationRequest) error {
 	result := make(chan error)
+	request.result = result
 	select {
-	case sm.mutationRequests <- mutationRequest{mutator: mutator, result: result}:
+	case sm.mutationRequests <- request:
 		return <-result
 	case <-sm.cancel:
 		return errors.New("snapshot manager closed")
@@ -119,7 +135,7 @@ func (sm *SnapshotManager) mutate(mutator func() bool) error {
 // Note that this is completely not thread-safe and defeats the purpose of having a `mutate` callback
 // entirely, but the hope is that this state of things will not be permament.
 func (sm *SnapshotManager) RegisterResourceOutputs(step deploy.Step) error {
-	return sm.mutate(func() bool { return true })
+	return sm.mutateStep(engine.JournalEntryOutputs, step, func() bool { return true })
 }
 
 // BeginMutation signals to the SnapshotManager that the engine intends to mutate the global snapshot
@@ -250,7 +266,16 @@ func (ssm *sameSnapshotMutation) End(step deploy.Step, successful bool) error {
 	contract.Require(step.Op() == deploy.OpSame, "step.Op() == deploy.OpSame")
 	contract.Assert(successful)
 	logging.V(9).Infof("SnapshotManager: sameSnapshotMutation.End(..., %v)", successful)
-	return ssm.manager.mutate(func() bool {
+
+	// Skipped creates are never written to the checkpoint (see below), so they are not journaled either.
+	mutate := func(mutator func() bool) error {
+		return ssm.manager.mutateStep(engine.JournalEntrySuccess, step, mutator)
+	}
+	if step.(*deploy.SameStep).IsSkippedCreate() {
+		mutate = ssm.manager.mutate
+	}
+
+	return mutate(func() bool {
 		sameStep := step.(*deploy.SameStep)
 
 		ssm.manager.markDone(step.Old())
@@ -281,7 +306,7 @@ func (ssm *sameSnapshotMutation) End(step deploy.Step, successful bool) error {
 
 func (sm *SnapshotManager) doCreate(step deploy.Step) (engine.SnapshotMutation, error) {
 	logging.V(9).Infof("SnapshotManager.doCreate(%s)", step.URN())
-	err := sm.mutate(func() bool {
+	err := sm.mutateStep(engine.JournalEntryBegin, step, func() bool {
 		sm.markOperationPending(step.New(), resource.OperationTypeCreating)
 		return true
 	})
@@ -299,7 +324,7 @@ type createSnapshotMutation struct {
 func (csm *createSnapshotMutation) End(step deploy.Step, successful bool) error {
 	contract.Require(step != nil, "step != nil")
 	logging.V(9).Infof("SnapshotManager: createSnapshotMutation.End(..., %v)", successful)
-	return csm.manager.mutate(func() bool {
+	return csm.manager.mutateStep(journalEntryKind(successful), step, func() bool {
 		csm.manager.markOperationComplete(step.New())
 		if successful {
 			// There is some very subtle behind-the-scenes magic here that
@@ -325,7 +350,7 @@ func (csm *createSnapshotMutation) End(step deploy.Step, successful bool) error
 
 func (sm *SnapshotManager) doUpdate(step deploy.Step) (engine.SnapshotMutation, error) {
 	logging.V(9).Infof("SnapshotManager.doUpdate(%s)", step.URN())
-	err := sm.mutate(func() bool {
+	err := sm.mutateStep(engine.JournalEntryBegin, step, func() bool {
 		sm.markOperationPending(step.New(), resource.OperationTypeUpdating)
 		return true
 	})
@@ -343,7 +368,7 @@ type updateSnapshotMutation struct {
 func (usm *updateSnapshotMutation) End(step deploy.Step, successful bool) error {
 	contract.Require(step != nil, "step != nil")
 	logging.V(9).Infof("SnapshotManager: updateSnapshotMutation.End(..., %v)", successful)
-	return usm.manager.mutate(func() bool {
+	return usm.manager.mutateStep(journalEntryKind(successful), step, func() bool {
 		usm.manager.markOperationComplete(step.New())
 		if successful {
 			usm.manager.markDone(step.Old())
@@ -355,7 +380,7 @@ func (usm *updateSnapshotMutation) End(step deploy.Step, successful bool) error
 
 func (sm *SnapshotManager) doDelete(step deploy.Step) (engine.SnapshotMutation, error) {
 	logging.V(9).Infof("SnapshotManager.doDelete(%s)", step.URN())
-	err := sm.mutate(func() bool {
+	err := sm.mutateStep(engine.JournalEntryBegin, step, func() bool {
 		sm.markOperationPending(step.Old(), resource.OperationTypeDeleting)
 		return true
 	})
@@ -373,7 +398,7 @@ type deleteSnapshotMutation struct {
 func (dsm *deleteSnapshotMutation) End(step deploy.Step, successful bool) error {
 	contract.Require(step != nil, "step != nil")
 	logging.V(9).Infof("SnapshotManager: deleteSnapshotMutation.End(..., %v)", successful)
-	return dsm.manager.mutate(func() bool {
+	return dsm.manager.mutateStep(journalEntryKind(successful), step, func() bool {
 		dsm.manager.markOperationComplete(step.Old())
 		if successful {
 			contract.Assert(!step.Old().Protect)
@@ -396,7 +421,7 @@ func (rsm *replaceSnapshotMutation) End(step deploy.Step, successful bool) error
 
 func (sm *SnapshotManager) doRead(step deploy.Step) (engine.SnapshotMutation, error) {
 	logging.V(9).Infof("SnapshotManager.doRead(%s)", step.URN())
-	err := sm.mutate(func() bool {
+	err := sm.mutateStep(engine.JournalEntryBegin, step, func() bool {
 		sm.markOperationPending(step.New(), resource.OperationTypeReading)
 		return true
 	})
@@ -414,7 +439,7 @@ type readSnapshotMutation struct {
 func (rsm *readSnapshotMutation) End(step deploy.Step, successful bool) error {
 	contract.Require(step != nil, "step != nil")
 	logging.V(9).Infof("SnapshotManager: readSnapshotMutation.End(..., %v)", successful)
-	return rsm.manager.mutate(func() bool {
+	return rsm.manager.mutateStep(journalEntryKind(successful), step, func() bool {
 		rsm.manager.markOperationComplete(step.New())
 		if successful {
 			if step.Old() != nil {
@@ -440,6 +465,10 @@ func (rsm *refreshSnapshotMutation) End(step deploy.Step, successful bool) error
 		// some other component will rewrite the base snapshot in-memory, so there's no action the snapshot
 		// manager needs to take other than to remember that the base snapshot--and therefore the actual snapshot--may
 		// have changed.
+		//
+		// Because the base snapshot is rewritten, any journal entries recorded from here on could not be replayed
+		// against the persisted base. Stop journaling and fall back to writing full snapshots instead.
+		rsm.manager.journal = nil
 		return false
 	})
 }
@@ -451,7 +480,7 @@ type removePendingReplaceSnapshotMutation struct {
 func (rsm *removePendingReplaceSnapshotMutation) End(step deploy.Step, successful bool) error {
 	contract.Require(step != nil, "step != nil")
 	contract.Require(step.Op() == deploy.OpRemovePendingReplace, "step.Op() == deploy.OpRemovePendingReplace")
-	return rsm.manager.mutate(func() bool {
+	return rsm.manager.mutateStep(engine.JournalEntrySuccess, step, func() bool {
 		res := step.Old()
 		contract.Assert(res.PendingReplacement)
 		rsm.manager.markDone(res)
@@ -461,7 +490,7 @@ func (rsm *removePendingReplaceSnapshotMutation) End(step deploy.Step, successfu
 
 func (sm *SnapshotManager) doImport(step deploy.Step) (engine.SnapshotMutation, error) {
 	logging.V(9).Infof("SnapshotManager.doImport(%s)", step.URN())
-	err := sm.mutate(func() bool {
+	err := sm.mutateStep(engine.JournalEntryBegin, step, func() bool {
 		sm.markOperationPending(step.New(), resource.OperationTypeImporting)
 		return true
 	})
@@ -481,7 +510,7 @@ func (ism *importSnapshotMutation) End(step deploy.Step, successful bool) error
 	contract.Require(step.Op() == deploy.OpImport || step.Op() == deploy.OpImportReplacement,
 		"step.Op() == deploy.OpImport || step.Op() == deploy.OpImportReplacement")
 
-	return ism.manager.mutate(func() bool {
+	return ism.manager.mutateStep(journalEntryKind(successful), step, func() bool {
 		ism.manager.markOperationComplete(step.New())
 		if successful {
 			ism.manager.markNew(step.New())
@@ -490,6 +519,14 @@ func (ism *importSnapshotMutation) End(step deploy.Step, successful bool) error
 	})
 }
 
+// journalEntryKind returns the kind of journal entry that records the end of a step.
+func journalEntryKind(successful bool) engine.JournalEntryKind {
+	if successful {
+		return engine.JournalEntrySuccess
+	}
+	return engine.JournalEntryFailure
+}
+
 // markDone marks a resource as having been processed. Resources that have been marked
 // in this manner won't be persisted in the snapshot.
 func (sm *SnapshotManager) markDone(state *resource.State) {
@@ -610,8 +647,11 @@ func (sm *SnapshotManager) saveSnapshot() error {
 func NewSnapshotManager(persister SnapshotPersister, baseSnap *deploy.Snapshot) *SnapshotManager {
 	mutationRequests, cancel, done := make(chan mutationRequest), make(chan bool), make(chan error)
 
+	journal, _ := persister.(JournalPersister)
+
 	manager := &SnapshotManager{
 		persister:        persister,
+		journal:          journal,
 		baseSnapshot:     baseSnap,
 		dones:            make(map[*resource.State]bool),
 		completeOps:      make(map[*resource.State]bool),
@@ -631,10 +671,19 @@ func NewSnapshotManager(persister SnapshotPersister, baseSnap *deploy.Snapshot)
 			select {
 			case request := <-mutationRequests:
 				var err error
-				if request.mutator() {
+				write := request.mutator()
+				switch {
+				case manager.journal != nil && request.entry != nil:
+					// Record the mutation in the journal. The journal is compacted into a full snapshot once the
+					// manager is closed, so we treat this as an elided write.
+					if err = manager.journal.Append(*request.entry); err != nil {
+						err = errors.Wrap(err, "failed to append to journal")
+					}
+					hasElidedWrites = true
+				case write:
 					err = manager.saveSnapshot()
 					hasElidedWrites = false
-				} else {
+				default:
 					hasElidedWrites = true
 				}
 				request.result <- err
@@ -0,0 +1,627 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sqlitestate implements a backend that stores checkpoints, update history, engine events and stack tags in
+// a single SQLite database file. The SQLite driver requires cgo; in builds without cgo, the backend reports an error
+// when it is used.
+package sqlitestate
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	user "github.com/tweekmonster/luser"
+
+	"github.com/pulumi/pulumi/pkg/v2/backend"
+	"github.com/pulumi/pulumi/pkg/v2/backend/display"
+	"github.com/pulumi/pulumi/pkg/v2/backend/filestate"
+	"github.com/pulumi/pulumi/pkg/v2/engine"
+	"github.com/pulumi/pulumi/pkg/v2/operations"
+	"github.com/pulumi/pulumi/pkg/v2/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/v2/resource/edit"
+	"github.com/pulumi/pulumi/pkg/v2/resource/stack"
+	"github.com/pulumi/pulumi/pkg/v2/util/validation"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/diag/colors"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/config"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/logging"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/result"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/workspace"
+)
+
+// Backend extends the base backend interface with specific information about SQLite backends.
+type Backend interface {
+	backend.Backend
+
+	// CancelCurrentUpdate releases the lock held by the stack's in-progress update, marking the update as failed.
+	CancelCurrentUpdate(ctx context.Context, stackRef backend.StackReference) error
+	// GetUpdateEvents returns the engine events recorded for the given version of the stack. Versions are numbered
+	// from one, starting with the stack's oldest update.
+	GetUpdateEvents(ctx context.Context, stackRef backend.StackReference, version int) ([]apitype.EngineEvent, error)
+}
+
+type sqliteBackend struct {
+	d diag.Sink
+
+	// originalURL is the URL provided when the sqliteBackend was initialized, for example "sqlite://~/state.db".
+	// path is the absolute path of the database file.
+	originalURL string
+	path        string
+
+	db *sql.DB
+}
+
+type sqliteBackendReference struct {
+	name tokens.QName
+}
+
+func (r sqliteBackendReference) String() string {
+	return string(r.name)
+}
+
+func (r sqliteBackendReference) Name() tokens.QName {
+	return r.name
+}
+
+// SQLitePathPrefix is the URL prefix for SQLite backends.
+const SQLitePathPrefix = "sqlite://"
+
+// IsSQLiteBackendURL returns true if the given URL refers to a SQLite backend.
+func IsSQLiteBackendURL(url string) bool {
+	return strings.HasPrefix(url, SQLitePathPrefix)
+}
+
+// New opens (creating if necessary) the SQLite database named by the given URL and returns a backend for it.
+func New(d diag.Sink, originalURL string) (Backend, error) {
+	if !IsSQLiteBackendURL(originalURL) {
+		return nil, errors.Errorf("SQLite URL %s has an illegal prefix; expected %s", originalURL, SQLitePathPrefix)
+	}
+
+	if !driverAvailable {
+		return nil, errors.Errorf("the SQLite backend is not supported by this build of the Pulumi CLI, "+
+			"which was built without cgo; use a build with cgo enabled to log in to %s", originalURL)
+	}
+
+	path, err := databasePath(originalURL)
+	if err != nil {
+		return nil, err
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, errors.Wrapf(err, "creating directory for %s", path)
+	}
+
+	// Foreign keys keep history, events, tags and locks in sync with their stacks. Transactions take the write
+	// lock up front so that concurrent processes wait on each other rather than failing to upgrade a read lock,
+	// and the busy timeout controls how long they wait.
+	dsn := fmt.Sprintf("file:%s?_foreign_keys=on&_busy_timeout=10000&_txlock=immediate", path)
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to open database %s", path)
+	}
+
+	for _, stmt := range schema {
+		if _, err = db.Exec(stmt); err != nil {
+			contract.IgnoreClose(db)
+			return nil, errors.Wrapf(err, "unable to initialize database %s", path)
+		}
+	}
+
+	return &sqliteBackend{
+		d:           d,
+		originalURL: originalURL,
+		path:        path,
+		db:          db,
+	}, nil
+}
+
+// databasePath converts a sqlite:// URL into an absolute path, expanding a leading ~ to the user's home directory.
+func databasePath(url string) (string, error) {
+	path := strings.TrimPrefix(url, SQLitePathPrefix)
+	if path == "" {
+		return "", errors.New("a database path must be specified, for example sqlite://~/.pulumi/state.db")
+	}
+
+	if strings.HasPrefix(path, "~") {
+		usr, err := user.Current()
+		if err != nil {
+			return "", errors.Wrap(err, "Could not determine current user to resolve `sqlite://~` path.")
+		}
+		path = filepath.Join(usr.HomeDir, path[1:])
+	}
+
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return "", errors.Wrap(err, "An IO error occurred while building the absolute path")
+	}
+	return path, nil
+}
+
+func Login(d diag.Sink, url string) (Backend, error) {
+	be, err := New(d, url)
+	if err != nil {
+		return nil, err
+	}
+	return be, workspace.StoreAccount(be.URL(), workspace.Account{}, true)
+}
+
+func (b *sqliteBackend) Name() string {
+	name, err := os.Hostname()
+	contract.IgnoreError(err)
+	if name == "" {
+		name = "local"
+	}
+	return name
+}
+
+func (b *sqliteBackend) URL() string {
+	return b.originalURL
+}
+
+func (b *sqliteBackend) GetPolicyPack(ctx context.Context, policyPack string,
+	d diag.Sink) (backend.PolicyPack, error) {
+
+	return nil, fmt.Errorf("SQLite state backend does not support resource policy")
+}
+
+func (b *sqliteBackend) ListPolicyGroups(ctx context.Context,
+	orgName string) (apitype.ListPolicyGroupsResponse, error) {
+	return apitype.ListPolicyGroupsResponse{}, fmt.Errorf("SQLite state backend does not support resource policy")
+}
+
+func (b *sqliteBackend) ListPolicyPacks(ctx context.Context,
+	orgName string) (apitype.ListPolicyPacksResponse, error) {
+	return apitype.ListPolicyPacksResponse{}, fmt.Errorf("SQLite state backend does not support resource policy")
+}
+
+// SupportsOrganizations tells whether a user can belong to multiple organizations in this backend.
+func (b *sqliteBackend) SupportsOrganizations() bool {
+	return false
+}
+
+func (b *sqliteBackend) ParseStackReference(stackRefName string) (backend.StackReference, error) {
+	return sqliteBackendReference{name: tokens.QName(stackRefName)}, nil
+}
+
+// ValidateStackName verifies the stack name is valid for the SQLite backend. We use the same rules as the
+// httpstate backend.
+func (b *sqliteBackend) ValidateStackName(stackName string) error {
+	if strings.Contains(stackName, "/") {
+		return errors.New("stack names may not contain slashes")
+	}
+
+	validNameRegex := regexp.MustCompile("^[A-Za-z0-9_.-]{1,100}$")
+	if !validNameRegex.MatchString(stackName) {
+		return errors.New("stack names may only contain alphanumeric, hyphens, underscores, or periods")
+	}
+
+	return nil
+}
+
+func (b *sqliteBackend) DoesProjectExist(ctx context.Context, projectName string) (bool, error) {
+	// SQLite backends don't have multiple projects, so just return false here.
+	return false, nil
+}
+
+func (b *sqliteBackend) CreateStack(ctx context.Context, stackRef backend.StackReference,
+	opts interface{}) (backend.Stack, error) {
+
+	contract.Requiref(opts == nil, "opts", "SQLite stacks do not support any options")
+
+	stackName := stackRef.Name()
+	if stackName == "" {
+		return nil, errors.New("invalid empty stack name")
+	}
+
+	tags, err := backend.GetEnvironmentTagsForCurrentStack()
+	if err != nil {
+		return nil, errors.Wrap(err, "getting stack tags")
+	}
+	if err = validation.ValidateStackProperties(string(stackName), tags); err != nil {
+		return nil, errors.Wrap(err, "validating stack properties")
+	}
+
+	if err = b.createStack(ctx, stackName, tags); err != nil {
+		return nil, err
+	}
+
+	stack := newStack(stackRef, nil, b)
+	fmt.Printf("Created stack '%s'\n", stack.Ref())
+
+	return stack, nil
+}
+
+func (b *sqliteBackend) GetStack(ctx context.Context, stackRef backend.StackReference) (backend.Stack, error) {
+	snapshot, err := b.getStack(ctx, stackRef.Name())
+	switch {
+	case err == errStackNotFound:
+		return nil, nil
+	case err != nil:
+		return nil, err
+	default:
+		return newStack(stackRef, snapshot, b), nil
+	}
+}
+
+func (b *sqliteBackend) ListStacks(
+	ctx context.Context, filter backend.ListStacksFilter) ([]backend.StackSummary, error) {
+
+	query, args := `SELECT name FROM stacks`, []interface{}{}
+	if filter.TagName != nil {
+		query = `SELECT stacks.name FROM stacks JOIN tags ON tags.stack = stacks.name WHERE tags.name = ?`
+		args = append(args, *filter.TagName)
+		if filter.TagValue != nil {
+			query += ` AND tags.value = ?`
+			args = append(args, *filter.TagValue)
+		}
+	}
+	query += ` ORDER BY 1`
+
+	rows, err := b.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing stacks")
+	}
+	var names []tokens.QName
+	for rows.Next() {
+		var name string
+		if err = rows.Scan(&name); err != nil {
+			contract.IgnoreClose(rows)
+			return nil, errors.Wrap(err, "error listing stacks")
+		}
+		names = append(names, tokens.QName(name))
+	}
+	contract.IgnoreClose(rows)
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "error listing stacks")
+	}
+
+	// Note that the organization and project filters are not honored, since those concepts don't exist in the
+	// SQLite backend.
+	var results []backend.StackSummary
+	for _, name := range names {
+		snapshot, err := b.getStack(ctx, name)
+		if err != nil {
+			logging.V(5).Infof("error reading stack: %v (%v) skipping", name, err)
+			continue // failure reading the stack information.
+		}
+		results = append(results, newSQLiteStackSummary(sqliteBackendReference{name: name}, snapshot))
+	}
+
+	return results, nil
+}
+
+func (b *sqliteBackend) RemoveStack(ctx context.Context, stack backend.Stack, force bool) (bool, error) {
+	stackName := stack.Ref().Name()
+	snapshot, err := b.getStack(ctx, stackName)
+	if err != nil {
+		return false, err
+	}
+
+	// Don't remove stacks that still have resources.
+	if !force && snapshot != nil && len(snapshot.Resources) > 0 {
+		return true, errors.New("refusing to remove stack because it still contains resources")
+	}
+
+	return false, b.removeStack(ctx, stackName)
+}
+
+func (b *sqliteBackend) RenameStack(ctx context.Context, stack backend.Stack,
+	newName tokens.QName) (backend.StackReference, error) {
+	// Get the current state from the stack to be renamed.
+	stackName := stack.Ref().Name()
+	snap, err := b.getStack(ctx, stackName)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure the new stack name is valid.
+	newRef, err := b.ParseStackReference(string(newName))
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure the destination stack does not already exist.
+	hasExisting, err := b.stackExists(ctx, newName)
+	if err != nil {
+		return nil, err
+	}
+	if hasExisting {
+		return nil, errors.Errorf("a stack named %s already exists", newName)
+	}
+
+	// If we have a snapshot, we need to rename the URNs inside it to use the new stack name.
+	if snap != nil {
+		if err = edit.RenameStack(snap, newName, ""); err != nil {
+			return nil, err
+		}
+	}
+
+	// Now rename the stack and save the snapshot (we pass nil to re-use the existing secrets manager from the
+	// snapshot). The stack's history, tags and lock are renamed along with it.
+	if err = b.renameStack(ctx, stackName, newName, snap); err != nil {
+		return nil, err
+	}
+	return newRef, nil
+}
+
+func (b *sqliteBackend) GetLatestConfiguration(ctx context.Context,
+	stack backend.Stack) (config.Map, error) {
+
+	hist, err := b.GetHistory(ctx, stack.Ref())
+	if err != nil {
+		return nil, err
+	}
+	if len(hist) == 0 {
+		return nil, backend.ErrNoPreviousDeployment
+	}
+
+	return hist[0].Config, nil
+}
+
+func (b *sqliteBackend) PackPolicies(
+	ctx context.Context, policyPackRef backend.PolicyPackReference,
+	cancellationScopes backend.CancellationScopeSource,
+	callerEventsOpt chan<- engine.Event) result.Result {
+
+	return result.Error("SQLite state backend does not support resource policy")
+}
+
+func (b *sqliteBackend) Preview(ctx context.Context, stack backend.Stack,
+	op backend.UpdateOperation) (engine.ResourceChanges, result.Result) {
+	// We can skip PreviewThenPromptThenExecute and just go straight to Execute.
+	opts := backend.ApplierOptions{
+		DryRun:   true,
+		ShowLink: true,
+	}
+	return b.apply(ctx, apitype.PreviewUpdate, stack, op, opts, nil /*events*/)
+}
+
+func (b *sqliteBackend) Update(ctx context.Context, stack backend.Stack,
+	op backend.UpdateOperation) (engine.ResourceChanges, result.Result) {
+	return backend.PreviewThenPromptThenExecute(ctx, apitype.UpdateUpdate, stack, op, b.apply)
+}
+
+func (b *sqliteBackend) Import(ctx context.Context, stack backend.Stack,
+	op backend.UpdateOperation, imports []deploy.Import) (engine.ResourceChanges, result.Result) {
+	op.Imports = imports
+	return backend.PreviewThenPromptThenExecute(ctx, apitype.ResourceImportUpdate, stack, op, b.apply)
+}
+
+func (b *sqliteBackend) Refresh(ctx context.Context, stack backend.Stack,
+	op backend.UpdateOperation) (engine.ResourceChanges, result.Result) {
+	return backend.PreviewThenPromptThenExecute(ctx, apitype.RefreshUpdate, stack, op, b.apply)
+}
+
+func (b *sqliteBackend) Destroy(ctx context.Context, stack backend.Stack,
+	op backend.UpdateOperation) (engine.ResourceChanges, result.Result) {
+	return backend.PreviewThenPromptThenExecute(ctx, apitype.DestroyUpdate, stack, op, b.apply)
+}
+
+func (b *sqliteBackend) Query(ctx context.Context, op backend.QueryOperation) result.Result {
+	return backend.RunQuery(ctx, b, op, nil /*events*/, b.newQuery)
+}
+
+func (b *sqliteBackend) Watch(ctx context.Context, stack backend.Stack,
+	op backend.UpdateOperation) result.Result {
+	return backend.Watch(ctx, b, stack, op, b.apply)
+}
+
+// apply actually performs the provided type of update on a stack stored in the database.
+func (b *sqliteBackend) apply(
+	ctx context.Context, kind apitype.UpdateKind, stack backend.Stack,
+	op backend.UpdateOperation, opts backend.ApplierOptions,
+	events chan<- engine.Event) (engine.ResourceChanges, result.Result) {
+
+	stackRef := stack.Ref()
+	stackName := stackRef.Name()
+	actionLabel := backend.ActionLabel(kind, opts.DryRun)
+
+	if !(op.Opts.Display.JSONDisplay || op.Opts.Display.Type == display.DisplayWatch) {
+		// Print a banner so it's clear this is a local deployment.
+		fmt.Printf(op.Opts.Display.Color.Colorize(
+			colors.SpecHeadline+"%s (%s):"+colors.Reset+"\n"), actionLabel, stackRef)
+	}
+
+	// Lock the stack for the duration of the update. Previews don't write any state, so they don't need to.
+	start := time.Now().Unix()
+	var updateID int64
+	if !opts.DryRun {
+		id, err := b.lockStack(ctx, stackName, backend.UpdateInfo{
+			Kind:        kind,
+			StartTime:   start,
+			Message:     op.M.Message,
+			Environment: op.M.Environment,
+			Config:      op.StackConfiguration.Config,
+		})
+		if err != nil {
+			return nil, result.FromError(err)
+		}
+		updateID = id
+	}
+
+	// Start the update.
+	update, err := b.newUpdate(ctx, stackName, op)
+	if err != nil {
+		if !opts.DryRun {
+			contract.IgnoreError(b.unlockStack(ctx, stackName))
+		}
+		return nil, result.FromError(err)
+	}
+
+	// Perform the update, recording the events of real updates so they can be saved along with the update's history.
+	var recordedEvents []apitype.EngineEvent
+	var recordEvent func(engine.Event)
+	if !opts.DryRun {
+		recordEvent = func(e engine.Event) {
+			apiEvent, convErr := display.ConvertEngineEvent(e)
+			if convErr != nil {
+				logging.V(3).Infof("error converting engine event: %v", convErr)
+				return
+			}
+			apiEvent.Sequence = len(recordedEvents)
+			apiEvent.Timestamp = int(time.Now().Unix())
+			recordedEvents = append(recordedEvents, apiEvent)
+		}
+	}
+	persister := b.newSnapshotPersister(ctx, stackName, op.SecretsManager)
+	changes, updateRes := backend.RunLocalUpdate(ctx, b, kind, stackRef, update, op, opts, events, persister, recordEvent)
+	end := time.Now().Unix()
+
+	// Save update results, along with the update's events, and release the stack's lock.
+	var saveErr error
+	if !opts.DryRun {
+		backendUpdateResult := backend.SucceededResult
+		if updateRes != nil {
+			backendUpdateResult = backend.FailedResult
+		}
+		info := backend.UpdateInfo{
+			Kind:            kind,
+			StartTime:       start,
+			Message:         op.M.Message,
+			Environment:     op.M.Environment,
+			Config:          update.GetTarget().Config,
+			Result:          backendUpdateResult,
+			EndTime:         end,
+			ResourceChanges: changes,
+		}
+		saveErr = b.completeUpdate(ctx, stackName, updateID, info, recordedEvents)
+	}
+
+	if updateRes != nil {
+		// We swallow saveErr as it is less important than the updateErr.
+		return changes, updateRes
+	}
+
+	if saveErr != nil {
+		return changes, result.FromError(errors.Wrap(saveErr, "saving update info"))
+	}
+
+	// Make sure to print a link to the stack's state before exiting.
+	if !op.Opts.Display.SuppressPermaLink && opts.ShowLink && !op.Opts.Display.JSONDisplay {
+		fmt.Printf(op.Opts.Display.Color.Colorize(
+			colors.SpecHeadline+"Permalink: "+
+				colors.Underline+colors.BrightBlue+"file://%s"+colors.Reset+"\n"), filepath.ToSlash(b.path))
+	}
+
+	return changes, nil
+}
+
+func (b *sqliteBackend) GetHistory(ctx context.Context,
+	stackRef backend.StackReference) ([]backend.UpdateInfo, error) {
+	return b.getHistory(ctx, stackRef.Name())
+}
+
+func (b *sqliteBackend) GetUpdateEvents(ctx context.Context, stackRef backend.StackReference,
+	version int) ([]apitype.EngineEvent, error) {
+	return b.getUpdateEvents(ctx, stackRef.Name(), version)
+}
+
+func (b *sqliteBackend) CancelCurrentUpdate(ctx context.Context, stackRef backend.StackReference) error {
+	return b.unlockStack(ctx, stackRef.Name())
+}
+
+func (b *sqliteBackend) GetLogs(ctx context.Context, stack backend.Stack, cfg backend.StackConfiguration,
+	query operations.LogQuery) ([]operations.LogEntry, error) {
+
+	target, err := b.getTarget(ctx, stack.Ref().Name(), cfg.Config, cfg.Decrypter)
+	if err != nil {
+		return nil, err
+	}
+
+	return filestate.GetLogsForTarget(target, query)
+}
+
+func (b *sqliteBackend) ExportDeployment(ctx context.Context,
+	stk backend.Stack) (*apitype.UntypedDeployment, error) {
+
+	snap, err := b.getStack(ctx, stk.Ref().Name())
+	if err != nil {
+		return nil, err
+	}
+
+	if snap == nil {
+		snap = deploy.NewSnapshot(deploy.Manifest{}, nil, nil, nil)
+	}
+
+	sdep, err := stack.SerializeDeployment(snap, snap.SecretsManager /* showSecrets */, false)
+	if err != nil {
+		return nil, errors.Wrap(err, "serializing deployment")
+	}
+
+	data, err := json.Marshal(sdep)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apitype.UntypedDeployment{
+		Version:    3,
+		Deployment: json.RawMessage(data),
+	}, nil
+}
+
+func (b *sqliteBackend) ImportDeployment(ctx context.Context, stk backend.Stack,
+	deployment *apitype.UntypedDeployment) error {
+
+	stackName := stk.Ref().Name()
+	if _, err := b.getStack(ctx, stackName); err != nil {
+		return err
+	}
+
+	snap, err := stack.DeserializeUntypedDeployment(deployment, stack.DefaultSecretsProvider)
+	if err != nil {
+		return err
+	}
+
+	return b.saveStack(ctx, stackName, snap, snap.SecretsManager)
+}
+
+func (b *sqliteBackend) Logout() error {
+	contract.IgnoreClose(b.db)
+	return workspace.DeleteAccount(b.originalURL)
+}
+
+func (b *sqliteBackend) CurrentUser() (string, error) {
+	user, err := user.Current()
+	if err != nil {
+		return "", err
+	}
+	return user.Username, nil
+}
+
+// GetStackTags fetches the stack's existing tags.
+func (b *sqliteBackend) GetStackTags(ctx context.Context,
+	stack backend.Stack) (map[apitype.StackTagName]string, error) {
+	return b.getTags(ctx, stack.Ref().Name())
+}
+
+// UpdateStackTags updates the stacks's tags, replacing all existing tags.
+func (b *sqliteBackend) UpdateStackTags(ctx context.Context,
+	stack backend.Stack, tags map[apitype.StackTagName]string) error {
+
+	stackName := stack.Ref().Name()
+	if err := validation.ValidateStackProperties(string(stackName), tags); err != nil {
+		return errors.Wrap(err, "validating stack properties")
+	}
+	return b.setTags(ctx, stackName, tags)
+}
@@ -0,0 +1,204 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build cgo
+
+package sqlitestate
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	user "github.com/tweekmonster/luser"
+
+	"github.com/pulumi/pulumi/pkg/v2/backend"
+	"github.com/pulumi/pulumi/pkg/v2/resource/deploy"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/tokens"
+)
+
+func newTestBackend(t *testing.T) (*sqliteBackend, func()) {
+	dir, err := ioutil.TempDir("", "sqlitestate")
+	assert.NoError(t, err)
+
+	b, err := New(nil, SQLitePathPrefix+filepath.Join(dir, "state.db"))
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	return b.(*sqliteBackend), func() {
+		assert.NoError(t, b.(*sqliteBackend).db.Close())
+		assert.NoError(t, os.RemoveAll(dir))
+	}
+}
+
+func newTestSnapshot(stackName tokens.QName) *deploy.Snapshot {
+	urn := resource.NewURN(stackName, "proj", "", "pulumi:pulumi:Stack", "proj-"+tokens.QName(stackName))
+	return deploy.NewSnapshot(deploy.Manifest{}, nil, []*resource.State{
+		{URN: urn, Type: "pulumi:pulumi:Stack", Custom: false},
+	}, nil)
+}
+
+func TestDatabasePath(t *testing.T) {
+	usr, err := user.Current()
+	assert.NoError(t, err)
+
+	path, err := databasePath(SQLitePathPrefix + "~/alpha/state.db")
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(usr.HomeDir, "alpha", "state.db"), path)
+
+	abs, err := filepath.Abs("state.db")
+	assert.NoError(t, err)
+	path, err = databasePath(SQLitePathPrefix + "state.db")
+	assert.NoError(t, err)
+	assert.Equal(t, abs, path)
+
+	_, err = databasePath(SQLitePathPrefix)
+	assert.Error(t, err)
+}
+
+func TestStackLifecycle(t *testing.T) {
+	ctx := context.Background()
+	b, cleanup := newTestBackend(t)
+	defer cleanup()
+
+	ref := sqliteBackendReference{name: "dev"}
+	assert.NoError(t, b.createStack(ctx, "dev", map[apitype.StackTagName]string{"owner": "me"}))
+
+	// Creating the stack again fails.
+	err := b.createStack(ctx, "dev", nil)
+	assert.IsType(t, &backend.StackAlreadyExistsError{}, err)
+
+	s, err := b.GetStack(ctx, ref)
+	assert.NoError(t, err)
+	assert.NotNil(t, s)
+
+	missing, err := b.GetStack(ctx, sqliteBackendReference{name: "missing"})
+	assert.NoError(t, err)
+	assert.Nil(t, missing)
+
+	// Tags round-trip.
+	tags, err := b.GetStackTags(ctx, s)
+	assert.NoError(t, err)
+	assert.Equal(t, map[apitype.StackTagName]string{"owner": "me"}, tags)
+	assert.NoError(t, b.setTags(ctx, "dev", map[apitype.StackTagName]string{"team": "infra"}))
+	tags, err = b.GetStackTags(ctx, s)
+	assert.NoError(t, err)
+	assert.Equal(t, map[apitype.StackTagName]string{"team": "infra"}, tags)
+
+	// Checkpoints round-trip through export and import.
+	assert.NoError(t, b.saveStack(ctx, "dev", newTestSnapshot("dev"), nil))
+	dep, err := b.ExportDeployment(ctx, s)
+	assert.NoError(t, err)
+	assert.NoError(t, b.saveStack(ctx, "dev", nil, nil))
+	assert.NoError(t, b.ImportDeployment(ctx, s, dep))
+	snap, err := b.getStack(ctx, "dev")
+	assert.NoError(t, err)
+	assert.Len(t, snap.Resources, 1)
+
+	// Stacks with resources are not removed without force.
+	hasResources, err := b.RemoveStack(ctx, s, false)
+	assert.True(t, hasResources)
+	assert.Error(t, err)
+
+	// Renaming carries the tags along and rewrites URNs.
+	newRef, err := b.RenameStack(ctx, s, "prod")
+	assert.NoError(t, err)
+	assert.Equal(t, tokens.QName("prod"), newRef.Name())
+	snap, err = b.getStack(ctx, "prod")
+	assert.NoError(t, err)
+	assert.Equal(t, tokens.QName("prod"), snap.Resources[0].URN.Stack())
+	tags, err = b.getTags(ctx, "prod")
+	assert.NoError(t, err)
+	assert.Equal(t, map[apitype.StackTagName]string{"team": "infra"}, tags)
+
+	teamName := "team"
+	summaries, err := b.ListStacks(ctx, backend.ListStacksFilter{TagName: &teamName})
+	assert.NoError(t, err)
+	if assert.Len(t, summaries, 1) {
+		assert.Equal(t, "prod", summaries[0].Name().String())
+		assert.Equal(t, 1, *summaries[0].ResourceCount())
+	}
+
+	prod, err := b.GetStack(ctx, newRef)
+	assert.NoError(t, err)
+	hasResources, err = b.RemoveStack(ctx, prod, true)
+	assert.False(t, hasResources)
+	assert.NoError(t, err)
+
+	// Removing the stack removes its tags.
+	tags, err = b.getTags(ctx, "prod")
+	assert.NoError(t, err)
+	assert.Empty(t, tags)
+}
+
+func TestUpdateLocking(t *testing.T) {
+	ctx := context.Background()
+	b, cleanup := newTestBackend(t)
+	defer cleanup()
+
+	assert.NoError(t, b.createStack(ctx, "dev", nil))
+	ref := sqliteBackendReference{name: "dev"}
+
+	info := backend.UpdateInfo{Kind: apitype.UpdateUpdate, StartTime: 1}
+	updateID, err := b.lockStack(ctx, "dev", info)
+	assert.NoError(t, err)
+
+	// A second update conflicts with the first.
+	_, err = b.lockStack(ctx, "dev", info)
+	assert.IsType(t, backend.ConflictingUpdateError{}, err)
+
+	// The in-progress update shows up in the history.
+	history, err := b.GetHistory(ctx, ref)
+	assert.NoError(t, err)
+	if assert.Len(t, history, 1) {
+		assert.Equal(t, backend.InProgressResult, history[0].Result)
+	}
+
+	// Completing the update records its events and releases the lock.
+	info.Result, info.EndTime = backend.SucceededResult, 2
+	events := []apitype.EngineEvent{
+		{Sequence: 0, StdoutEvent: &apitype.StdoutEngineEvent{Message: "hello"}},
+		{Sequence: 1, SummaryEvent: &apitype.SummaryEvent{}},
+	}
+	assert.NoError(t, b.completeUpdate(ctx, "dev", updateID, info, events))
+
+	history, err = b.GetHistory(ctx, ref)
+	assert.NoError(t, err)
+	if assert.Len(t, history, 1) {
+		assert.Equal(t, backend.SucceededResult, history[0].Result)
+	}
+	recorded, err := b.GetUpdateEvents(ctx, ref, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, events, recorded)
+	_, err = b.GetUpdateEvents(ctx, ref, 2)
+	assert.Error(t, err)
+
+	// A canceled update is marked as failed and its lock is released.
+	_, err = b.lockStack(ctx, "dev", info)
+	assert.NoError(t, err)
+	assert.NoError(t, b.CancelCurrentUpdate(ctx, ref))
+	assert.Error(t, b.CancelCurrentUpdate(ctx, ref))
+
+	history, err = b.GetHistory(ctx, ref)
+	assert.NoError(t, err)
+	if assert.Len(t, history, 2) {
+		assert.Equal(t, backend.FailedResult, history[0].Result)
+		assert.Equal(t, backend.SucceededResult, history[1].Result)
+	}
+}
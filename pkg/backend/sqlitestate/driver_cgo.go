@@ -0,0 +1,24 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build cgo
+
+package sqlitestate
+
+import (
+	_ "github.com/mattn/go-sqlite3" // driver for sqlite3
+)
+
+// driverAvailable is true if the SQLite driver is compiled into this binary. The driver requires cgo.
+const driverAvailable = true
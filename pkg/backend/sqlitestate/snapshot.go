@@ -0,0 +1,44 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlitestate
+
+import (
+	"context"
+
+	"github.com/pulumi/pulumi/pkg/v2/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/v2/secrets"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/tokens"
+)
+
+// sqliteSnapshotPersister is a simple SnapshotPersister implementation that persists snapshots to the database.
+type sqliteSnapshotPersister struct {
+	ctx     context.Context
+	name    tokens.QName
+	backend *sqliteBackend
+	sm      secrets.Manager
+}
+
+func (sp *sqliteSnapshotPersister) SecretsManager() secrets.Manager {
+	return sp.sm
+}
+
+func (sp *sqliteSnapshotPersister) Save(snapshot *deploy.Snapshot) error {
+	return sp.backend.saveStack(sp.ctx, sp.name, snapshot, sp.sm)
+}
+
+func (b *sqliteBackend) newSnapshotPersister(ctx context.Context, stackName tokens.QName,
+	sm secrets.Manager) *sqliteSnapshotPersister {
+	return &sqliteSnapshotPersister{ctx: ctx, name: stackName, backend: b, sm: sm}
+}
@@ -0,0 +1,131 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlitestate
+
+import (
+	"context"
+	"time"
+
+	"github.com/pulumi/pulumi/pkg/v2/backend"
+	"github.com/pulumi/pulumi/pkg/v2/engine"
+	"github.com/pulumi/pulumi/pkg/v2/operations"
+	"github.com/pulumi/pulumi/pkg/v2/resource/deploy"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/result"
+)
+
+// Stack is a stack stored in a SQLite database.
+type Stack interface {
+	backend.Stack
+	sqlite() // at the moment, no SQLite specific info, so just use a marker function.
+}
+
+// sqliteStack is a SQLite stack descriptor.
+type sqliteStack struct {
+	ref      backend.StackReference // the stack's reference (qualified name).
+	snapshot *deploy.Snapshot       // a snapshot representing the latest deployment state.
+	b        *sqliteBackend         // a pointer to the backend this stack belongs to.
+}
+
+func newStack(ref backend.StackReference, snapshot *deploy.Snapshot, b *sqliteBackend) Stack {
+	return &sqliteStack{
+		ref:      ref,
+		snapshot: snapshot,
+		b:        b,
+	}
+}
+
+func (s *sqliteStack) sqlite() {}
+
+func (s *sqliteStack) Ref() backend.StackReference                            { return s.ref }
+func (s *sqliteStack) Snapshot(ctx context.Context) (*deploy.Snapshot, error) { return s.snapshot, nil }
+func (s *sqliteStack) Backend() backend.Backend                               { return s.b }
+
+func (s *sqliteStack) Remove(ctx context.Context, force bool) (bool, error) {
+	return backend.RemoveStack(ctx, s, force)
+}
+
+func (s *sqliteStack) Rename(ctx context.Context, newName tokens.QName) (backend.StackReference, error) {
+	return backend.RenameStack(ctx, s, newName)
+}
+
+func (s *sqliteStack) Preview(ctx context.Context, op backend.UpdateOperation) (engine.ResourceChanges, result.Result) {
+	return backend.PreviewStack(ctx, s, op)
+}
+
+func (s *sqliteStack) Update(ctx context.Context, op backend.UpdateOperation) (engine.ResourceChanges, result.Result) {
+	return backend.UpdateStack(ctx, s, op)
+}
+
+func (s *sqliteStack) Import(ctx context.Context, op backend.UpdateOperation,
+	imports []deploy.Import) (engine.ResourceChanges, result.Result) {
+	return backend.ImportStack(ctx, s, op, imports)
+}
+
+func (s *sqliteStack) Refresh(ctx context.Context, op backend.UpdateOperation) (engine.ResourceChanges, result.Result) {
+	return backend.RefreshStack(ctx, s, op)
+}
+
+func (s *sqliteStack) Destroy(ctx context.Context, op backend.UpdateOperation) (engine.ResourceChanges, result.Result) {
+	return backend.DestroyStack(ctx, s, op)
+}
+
+func (s *sqliteStack) Watch(ctx context.Context, op backend.UpdateOperation) result.Result {
+	return backend.WatchStack(ctx, s, op)
+}
+
+func (s *sqliteStack) GetLogs(ctx context.Context, cfg backend.StackConfiguration,
+	query operations.LogQuery) ([]operations.LogEntry, error) {
+	return backend.GetStackLogs(ctx, s, cfg, query)
+}
+
+func (s *sqliteStack) ExportDeployment(ctx context.Context) (*apitype.UntypedDeployment, error) {
+	return backend.ExportStackDeployment(ctx, s)
+}
+
+func (s *sqliteStack) ImportDeployment(ctx context.Context, deployment *apitype.UntypedDeployment) error {
+	return backend.ImportStackDeployment(ctx, s, deployment)
+}
+
+type sqliteStackSummary struct {
+	ref      backend.StackReference
+	snapshot *deploy.Snapshot
+}
+
+func newSQLiteStackSummary(ref backend.StackReference, snapshot *deploy.Snapshot) sqliteStackSummary {
+	return sqliteStackSummary{ref: ref, snapshot: snapshot}
+}
+
+func (ss sqliteStackSummary) Name() backend.StackReference {
+	return ss.ref
+}
+
+func (ss sqliteStackSummary) LastUpdate() *time.Time {
+	if ss.snapshot != nil {
+		if t := ss.snapshot.Manifest.Time; !t.IsZero() {
+			return &t
+		}
+	}
+	return nil
+}
+
+func (ss sqliteStackSummary) ResourceCount() *int {
+	if ss.snapshot != nil {
+		count := len(ss.snapshot.Resources)
+		return &count
+	}
+	return nil
+}
@@ -0,0 +1,567 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlitestate
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/pkg/v2/backend"
+	"github.com/pulumi/pulumi/pkg/v2/engine"
+	"github.com/pulumi/pulumi/pkg/v2/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/v2/resource/stack"
+	"github.com/pulumi/pulumi/pkg/v2/secrets"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/config"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/logging"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/workspace"
+)
+
+// DisableIntegrityChecking can be set to true to disable checkpoint state integrity verification.  This is not
+// recommended, because it could mean proceeding even in the face of a corrupted checkpoint state, but can
+// be used as a last resort when a command absolutely must be run.
+var DisableIntegrityChecking bool
+
+// schema is the set of statements that create the backend's tables. Each statement is idempotent, so the schema
+// is applied every time a database is opened.
+var schema = []string{
+	// stacks holds the latest checkpoint for each stack, serialized as an apitype.VersionedCheckpoint.
+	`CREATE TABLE IF NOT EXISTS stacks (
+		name TEXT PRIMARY KEY,
+		checkpoint BLOB NOT NULL
+	)`,
+	// history holds one row per update, with the update's backend.UpdateInfo and a copy of the checkpoint as of
+	// the end of the update.
+	`CREATE TABLE IF NOT EXISTS history (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		stack TEXT NOT NULL REFERENCES stacks(name) ON DELETE CASCADE ON UPDATE CASCADE,
+		info BLOB NOT NULL,
+		checkpoint BLOB
+	)`,
+	`CREATE INDEX IF NOT EXISTS history_stack ON history(stack, id)`,
+	// events holds the engine events for each update, serialized as apitype.EngineEvents.
+	`CREATE TABLE IF NOT EXISTS events (
+		update_id INTEGER NOT NULL REFERENCES history(id) ON DELETE CASCADE,
+		sequence INTEGER NOT NULL,
+		event BLOB NOT NULL,
+		PRIMARY KEY (update_id, sequence)
+	)`,
+	// tags holds each stack's tags.
+	`CREATE TABLE IF NOT EXISTS tags (
+		stack TEXT NOT NULL REFERENCES stacks(name) ON DELETE CASCADE ON UPDATE CASCADE,
+		name TEXT NOT NULL,
+		value TEXT NOT NULL,
+		PRIMARY KEY (stack, name)
+	)`,
+	// locks records the stacks that currently have an update in progress.
+	`CREATE TABLE IF NOT EXISTS locks (
+		stack TEXT PRIMARY KEY REFERENCES stacks(name) ON DELETE CASCADE ON UPDATE CASCADE,
+		update_id INTEGER NOT NULL,
+		owner TEXT NOT NULL,
+		acquired INTEGER NOT NULL
+	)`,
+}
+
+// errStackNotFound is returned by getStack when the stack does not exist.
+var errStackNotFound = errors.New("stack not found")
+
+type localQuery struct {
+	root string
+	proj *workspace.Project
+}
+
+func (q *localQuery) GetRoot() string {
+	return q.root
+}
+
+func (q *localQuery) GetProject() *workspace.Project {
+	return q.proj
+}
+
+// update is an implementation of engine.Update backed by a SQLite database.
+type update struct {
+	root    string
+	proj    *workspace.Project
+	target  *deploy.Target
+	backend *sqliteBackend
+}
+
+func (u *update) GetRoot() string {
+	return u.root
+}
+
+func (u *update) GetProject() *workspace.Project {
+	return u.proj
+}
+
+func (u *update) GetTarget() *deploy.Target {
+	return u.target
+}
+
+func (b *sqliteBackend) newQuery(ctx context.Context,
+	op backend.QueryOperation) (engine.QueryInfo, error) {
+
+	return &localQuery{root: op.Root, proj: op.Proj}, nil
+}
+
+func (b *sqliteBackend) newUpdate(ctx context.Context, stackName tokens.QName,
+	op backend.UpdateOperation) (*update, error) {
+	contract.Require(stackName != "", "stackName")
+
+	// Construct the deployment target.
+	target, err := b.getTarget(ctx, stackName, op.StackConfiguration.Config, op.StackConfiguration.Decrypter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Construct and return a new update.
+	return &update{
+		root:    op.Root,
+		proj:    op.Proj,
+		target:  target,
+		backend: b,
+	}, nil
+}
+
+func (b *sqliteBackend) getTarget(ctx context.Context, stackName tokens.QName, cfg config.Map,
+	dec config.Decrypter) (*deploy.Target, error) {
+
+	snapshot, err := b.getStack(ctx, stackName)
+	if err != nil {
+		return nil, err
+	}
+	return &deploy.Target{
+		Name:      stackName,
+		Config:    cfg,
+		Decrypter: dec,
+		Snapshot:  snapshot,
+	}, nil
+}
+
+// queryer is the subset of *sql.DB and *sql.Tx used to read state, so reads can happen inside or outside of a
+// transaction.
+type queryer interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// getStack loads the latest snapshot for the given stack. If the stack does not exist, errStackNotFound is returned.
+func (b *sqliteBackend) getStack(ctx context.Context, name tokens.QName) (*deploy.Snapshot, error) {
+	if name == "" {
+		return nil, errors.New("invalid empty stack name")
+	}
+
+	bytes, err := getCheckpointBytes(ctx, b.db, name)
+	if err != nil {
+		return nil, err
+	}
+	chk, err := stack.UnmarshalVersionedCheckpointToLatestCheckpoint(bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load checkpoint")
+	}
+
+	// Materialize an actual snapshot object.
+	snapshot, err := stack.DeserializeCheckpoint(chk)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure the snapshot passes verification before returning it, to catch bugs early.
+	if !DisableIntegrityChecking {
+		if verifyerr := snapshot.VerifyIntegrity(); verifyerr != nil {
+			return nil, errors.Wrapf(verifyerr, "%s: snapshot integrity failure; refusing to use it", name)
+		}
+	}
+
+	return snapshot, nil
+}
+
+// getCheckpointBytes reads the serialized checkpoint for the given stack.
+func getCheckpointBytes(ctx context.Context, q queryer, name tokens.QName) ([]byte, error) {
+	var bytes []byte
+	err := q.QueryRowContext(ctx, `SELECT checkpoint FROM stacks WHERE name = ?`, string(name)).Scan(&bytes)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, errStackNotFound
+	case err != nil:
+		return nil, errors.Wrapf(err, "reading checkpoint for stack %s", name)
+	}
+	return bytes, nil
+}
+
+// stackExists returns true if a stack with the given name exists.
+func (b *sqliteBackend) stackExists(ctx context.Context, name tokens.QName) (bool, error) {
+	var count int
+	err := b.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM stacks WHERE name = ?`, string(name)).Scan(&count)
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// saveStack writes the checkpoint for the given stack, creating the stack if it does not exist.
+func (b *sqliteBackend) saveStack(ctx context.Context, name tokens.QName, snap *deploy.Snapshot,
+	sm secrets.Manager) error {
+
+	bytes, err := marshalCheckpoint(name, snap, sm)
+	if err != nil {
+		return err
+	}
+
+	err = b.inTx(ctx, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO stacks (name, checkpoint) VALUES (?, ?)
+			ON CONFLICT(name) DO UPDATE SET checkpoint = excluded.checkpoint`,
+			string(name), bytes)
+		return err
+	})
+	if err != nil {
+		return errors.Wrapf(err, "saving checkpoint for stack %s", name)
+	}
+
+	logging.V(7).Infof("Saved stack %s checkpoint to: %s", name, b.path)
+
+	if !DisableIntegrityChecking {
+		// Finally, *after* writing the checkpoint, check the integrity.  This is done afterwards so that we write
+		// out the checkpoint since it may contain resource state updates.  But we will warn the user that the
+		// checkpoint is already written and might be bad.
+		if verifyerr := snap.VerifyIntegrity(); verifyerr != nil {
+			return errors.Wrapf(verifyerr,
+				"%s: snapshot integrity failure; it was already written, but is invalid", name)
+		}
+	}
+
+	return nil
+}
+
+func marshalCheckpoint(name tokens.QName, snap *deploy.Snapshot, sm secrets.Manager) ([]byte, error) {
+	chk, err := stack.SerializeCheckpoint(name, snap, sm, false /* showSecrets */)
+	if err != nil {
+		return nil, errors.Wrap(err, "serializing checkpoint")
+	}
+	bytes, err := json.Marshal(chk)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshalling checkpoint")
+	}
+	return bytes, nil
+}
+
+// createStack creates a new stack with an empty checkpoint and the given tags. If the stack already exists, a
+// backend.StackAlreadyExistsError is returned.
+func (b *sqliteBackend) createStack(ctx context.Context, name tokens.QName,
+	tags map[apitype.StackTagName]string) error {
+
+	bytes, err := marshalCheckpoint(name, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return b.inTx(ctx, func(tx *sql.Tx) error {
+		if _, err := getCheckpointBytes(ctx, tx, name); err == nil {
+			return &backend.StackAlreadyExistsError{StackName: string(name)}
+		} else if err != errStackNotFound {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, `INSERT INTO stacks (name, checkpoint) VALUES (?, ?)`,
+			string(name), bytes); err != nil {
+			return err
+		}
+		return setTagsTx(ctx, tx, name, tags)
+	})
+}
+
+// removeStack removes a stack along with its history, events, tags and lock.
+func (b *sqliteBackend) removeStack(ctx context.Context, name tokens.QName) error {
+	contract.Require(name != "", "name")
+
+	return b.inTx(ctx, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `DELETE FROM stacks WHERE name = ?`, string(name))
+		return err
+	})
+}
+
+// renameStack renames a stack, replacing its checkpoint with the given (already renamed) snapshot. The stack's
+// history, tags and lock follow the rename.
+func (b *sqliteBackend) renameStack(ctx context.Context, oldName, newName tokens.QName, snap *deploy.Snapshot) error {
+	bytes, err := marshalCheckpoint(newName, snap, nil)
+	if err != nil {
+		return err
+	}
+
+	return b.inTx(ctx, func(tx *sql.Tx) error {
+		res, err := tx.ExecContext(ctx, `UPDATE stacks SET name = ?, checkpoint = ? WHERE name = ?`,
+			string(newName), bytes, string(oldName))
+		if err != nil {
+			return err
+		}
+		if n, err := res.RowsAffected(); err != nil || n != 1 {
+			return errStackNotFound
+		}
+		return nil
+	})
+}
+
+// getHistory returns the stored update history. The first element of the result will be the most recent update
+// record.
+func (b *sqliteBackend) getHistory(ctx context.Context, name tokens.QName) ([]backend.UpdateInfo, error) {
+	contract.Require(name != "", "name")
+
+	rows, err := b.db.QueryContext(ctx, `SELECT info FROM history WHERE stack = ? ORDER BY id DESC`, string(name))
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading history for stack %s", name)
+	}
+	defer contract.IgnoreClose(rows)
+
+	var updates []backend.UpdateInfo
+	for rows.Next() {
+		var bytes []byte
+		if err = rows.Scan(&bytes); err != nil {
+			return nil, errors.Wrapf(err, "reading history for stack %s", name)
+		}
+
+		var update backend.UpdateInfo
+		if err = json.Unmarshal(bytes, &update); err != nil {
+			return nil, errors.Wrapf(err, "reading history for stack %s", name)
+		}
+		updates = append(updates, update)
+	}
+	return updates, rows.Err()
+}
+
+// getUpdateEvents returns the engine events that were recorded for the given version of the stack, in order.
+// Versions are numbered from one, starting with the stack's oldest update.
+func (b *sqliteBackend) getUpdateEvents(ctx context.Context, name tokens.QName,
+	version int) ([]apitype.EngineEvent, error) {
+
+	var updateID int64
+	err := b.db.QueryRowContext(ctx, `SELECT id FROM history WHERE stack = ? ORDER BY id LIMIT 1 OFFSET ?`,
+		string(name), version-1).Scan(&updateID)
+	switch {
+	case version < 1 || err == sql.ErrNoRows:
+		return nil, errors.Errorf("stack '%s' has no update with version %d", name, version)
+	case err != nil:
+		return nil, errors.Wrapf(err, "reading history for stack %s", name)
+	}
+
+	rows, err := b.db.QueryContext(ctx,
+		`SELECT event FROM events WHERE update_id = ? ORDER BY sequence`, updateID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading events for update %d", updateID)
+	}
+	defer contract.IgnoreClose(rows)
+
+	var events []apitype.EngineEvent
+	for rows.Next() {
+		var bytes []byte
+		if err = rows.Scan(&bytes); err != nil {
+			return nil, errors.Wrapf(err, "reading events for update %d", updateID)
+		}
+
+		var event apitype.EngineEvent
+		if err = json.Unmarshal(bytes, &event); err != nil {
+			return nil, errors.Wrapf(err, "reading events for update %d", updateID)
+		}
+		events = append(events, event)
+	}
+	return events, rows.Err()
+}
+
+// lockStack marks an update of the given stack as in progress. It records an in-progress history entry for the
+// update and returns its ID. If another update of the stack is already in progress, a
+// backend.ConflictingUpdateError is returned.
+func (b *sqliteBackend) lockStack(ctx context.Context, name tokens.QName, info backend.UpdateInfo) (int64, error) {
+	info.Result = backend.InProgressResult
+	bytes, err := json.Marshal(&info)
+	if err != nil {
+		return 0, err
+	}
+
+	var updateID int64
+	err = b.inTx(ctx, func(tx *sql.Tx) error {
+		var owner string
+		var acquired int64
+		err := tx.QueryRowContext(ctx,
+			`SELECT owner, acquired FROM locks WHERE stack = ?`, string(name)).Scan(&owner, &acquired)
+		switch {
+		case err == nil:
+			return backend.ConflictingUpdateError{
+				Err: errors.Errorf("the stack is currently locked by %s since %v; "+
+					"if this update is no longer running, use `pulumi cancel` to unlock it",
+					owner, time.Unix(acquired, 0).Format(time.RFC1123)),
+			}
+		case err != sql.ErrNoRows:
+			return err
+		}
+
+		res, err := tx.ExecContext(ctx, `INSERT INTO history (stack, info) VALUES (?, ?)`, string(name), bytes)
+		if err != nil {
+			return err
+		}
+		if updateID, err = res.LastInsertId(); err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `INSERT INTO locks (stack, update_id, owner, acquired) VALUES (?, ?, ?, ?)`,
+			string(name), updateID, lockOwner(), time.Now().Unix())
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	return updateID, nil
+}
+
+// completeUpdate records the final update information, events and checkpoint of an update that was started by
+// lockStack, and releases the stack's lock. All of this happens in a single transaction.
+func (b *sqliteBackend) completeUpdate(ctx context.Context, name tokens.QName, updateID int64,
+	info backend.UpdateInfo, events []apitype.EngineEvent) error {
+
+	bytes, err := json.Marshal(&info)
+	if err != nil {
+		return err
+	}
+
+	return b.inTx(ctx, func(tx *sql.Tx) error {
+		chk, err := getCheckpointBytes(ctx, tx, name)
+		if err != nil {
+			return err
+		}
+
+		if _, err = tx.ExecContext(ctx, `UPDATE history SET info = ?, checkpoint = ? WHERE id = ?`,
+			bytes, chk, updateID); err != nil {
+			return err
+		}
+
+		stmt, err := tx.PrepareContext(ctx, `INSERT INTO events (update_id, sequence, event) VALUES (?, ?, ?)`)
+		if err != nil {
+			return err
+		}
+		defer contract.IgnoreClose(stmt)
+		for i, e := range events {
+			eventBytes, err := json.Marshal(e)
+			if err != nil {
+				return err
+			}
+			if _, err = stmt.ExecContext(ctx, updateID, i, eventBytes); err != nil {
+				return err
+			}
+		}
+
+		_, err = tx.ExecContext(ctx, `DELETE FROM locks WHERE stack = ? AND update_id = ?`, string(name), updateID)
+		return err
+	})
+}
+
+// unlockStack forcibly releases the given stack's lock, marking the update that held it as failed.
+func (b *sqliteBackend) unlockStack(ctx context.Context, name tokens.QName) error {
+	return b.inTx(ctx, func(tx *sql.Tx) error {
+		var updateID int64
+		err := tx.QueryRowContext(ctx,
+			`SELECT update_id FROM locks WHERE stack = ?`, string(name)).Scan(&updateID)
+		switch {
+		case err == sql.ErrNoRows:
+			return errors.Errorf("stack '%s' has no update in progress", name)
+		case err != nil:
+			return err
+		}
+
+		var bytes []byte
+		if err = tx.QueryRowContext(ctx, `SELECT info FROM history WHERE id = ?`, updateID).Scan(&bytes); err != nil {
+			return err
+		}
+		var info backend.UpdateInfo
+		if err = json.Unmarshal(bytes, &info); err != nil {
+			return err
+		}
+		info.Result = backend.FailedResult
+		info.EndTime = time.Now().Unix()
+		if bytes, err = json.Marshal(&info); err != nil {
+			return err
+		}
+
+		if _, err = tx.ExecContext(ctx, `UPDATE history SET info = ? WHERE id = ?`, bytes, updateID); err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, `DELETE FROM locks WHERE stack = ?`, string(name))
+		return err
+	})
+}
+
+// lockOwner returns a description of the current process for use in lock records.
+func lockOwner() string {
+	host, err := os.Hostname()
+	contract.IgnoreError(err)
+	return fmt.Sprintf("pid %d on %s", os.Getpid(), host)
+}
+
+// getTags returns the tags for the given stack.
+func (b *sqliteBackend) getTags(ctx context.Context, name tokens.QName) (map[apitype.StackTagName]string, error) {
+	rows, err := b.db.QueryContext(ctx, `SELECT name, value FROM tags WHERE stack = ?`, string(name))
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading tags for stack %s", name)
+	}
+	defer contract.IgnoreClose(rows)
+
+	tags := make(map[apitype.StackTagName]string)
+	for rows.Next() {
+		var tag, value string
+		if err = rows.Scan(&tag, &value); err != nil {
+			return nil, errors.Wrapf(err, "reading tags for stack %s", name)
+		}
+		tags[tag] = value
+	}
+	return tags, rows.Err()
+}
+
+// setTags replaces all of the given stack's tags.
+func (b *sqliteBackend) setTags(ctx context.Context, name tokens.QName, tags map[apitype.StackTagName]string) error {
+	return b.inTx(ctx, func(tx *sql.Tx) error {
+		return setTagsTx(ctx, tx, name, tags)
+	})
+}
+
+func setTagsTx(ctx context.Context, tx *sql.Tx, name tokens.QName, tags map[apitype.StackTagName]string) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM tags WHERE stack = ?`, string(name)); err != nil {
+		return err
+	}
+	for tag, value := range tags {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO tags (stack, name, value) VALUES (?, ?, ?)`,
+			string(name), tag, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// inTx runs the given function inside of a transaction, committing if it succeeds and rolling back otherwise.
+func (b *sqliteBackend) inTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := b.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err = fn(tx); err != nil {
+		contract.IgnoreError(tx.Rollback())
+		return err
+	}
+	return tx.Commit()
+}
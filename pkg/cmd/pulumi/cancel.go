@@ -15,18 +15,26 @@
 package main
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/result"
 
 	"github.com/spf13/cobra"
 
+	"github.com/pulumi/pulumi/pkg/v2/backend"
 	"github.com/pulumi/pulumi/pkg/v2/backend/display"
 	"github.com/pulumi/pulumi/pkg/v2/backend/httpstate"
+	"github.com/pulumi/pulumi/pkg/v2/backend/sqlitestate"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/diag/colors"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/cmdutil"
 )
 
+// updateCanceler is implemented by the backends that can cancel a stack's in-progress update.
+type updateCanceler interface {
+	CancelCurrentUpdate(ctx context.Context, stackRef backend.StackReference) error
+}
+
 func newCancelCmd() *cobra.Command {
 	var yes bool
 	var stack string
@@ -61,9 +69,14 @@ func newCancelCmd() *cobra.Command {
 				return result.FromError(err)
 			}
 
-			// Ensure that we are targeting the Pulumi cloud.
-			backend, ok := s.Backend().(httpstate.Backend)
-			if !ok {
+			// Ensure that we are targeting a backend that tracks in-progress updates.
+			var backend updateCanceler
+			switch b := s.Backend().(type) {
+			case httpstate.Backend:
+				backend = b
+			case sqlitestate.Backend:
+				backend = b
+			default:
 				return result.Error("the `cancel` command is not supported for local stacks")
 			}
 
@@ -79,6 +79,7 @@ func newConfigCmd() *cobra.Command {
 	cmd.AddCommand(newConfigGetCmd(&stack))
 	cmd.AddCommand(newConfigRmCmd(&stack))
 	cmd.AddCommand(newConfigSetCmd(&stack))
+	cmd.AddCommand(newConfigSetAllCmd(&stack))
 	cmd.AddCommand(newConfigRefreshCmd(&stack))
 	cmd.AddCommand(newConfigCopyCmd(&stack))
 
@@ -401,6 +402,7 @@ func newConfigSetCmd(stack *string) *cobra.Command {
 	var plaintext bool
 	var secret bool
 	var path bool
+	var jsonValue bool
 
 	setCmd := &cobra.Command{
 		Use:   "set <key> [value]",
@@ -414,7 +416,13 @@ func newConfigSetCmd(stack *string) *cobra.Command {
 			"    - `pulumi config set --path parent.nested value` " +
 			"will set the value of `parent` to a map `nested: value`.\n" +
 			"    - `pulumi config set --path '[\"parent.name\"].[\"nested.name\"]' value` will set the value of \n" +
-			"	`parent.name` to a map `nested.name: value`.",
+			"	`parent.name` to a map `nested.name: value`.\n\n" +
+			"The `--json` flag can be used to set a structured value from a JSON or YAML document. Leaves of the\n" +
+			"form `{\"secure\": \"<plaintext>\"}` are encrypted individually, and `--secret` encrypts every leaf:\n\n" +
+			"    - `pulumi config set --json server '{\"port\": 8080, \"hosts\": [\"a\", \"b\"]}'` " +
+			"will set the value of `server` to a map.\n" +
+			"    - `pulumi config set --json --path server.auth '{\"user\": \"admin\", \"key\": {\"secure\": \"k\"}}'`\n" +
+			"	will set `auth` inside of `server`, encrypting only the `key` leaf.",
 		Args: cmdutil.RangeArgs(1, 2),
 		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
 			opts := display.Options{
@@ -458,7 +466,24 @@ func newConfigSetCmd(stack *string) *cobra.Command {
 
 			// Encrypt the config value if needed.
 			var v config.Value
-			if secret {
+			if jsonValue {
+				// Secure leaves are encrypted individually, so only create an encrypter if one is needed.
+				v, err = config.ParseObjectValue(value, secret, &lazyStackEncrypter{stack: s})
+				if err != nil {
+					return err
+				}
+
+				// If any plaintext leaf looks like a secret, and --plaintext was not passed, warn the user.
+				if !plaintext {
+					if leaf, ok := valueLooksLikeSecret(key, v); ok {
+						return errors.Errorf(
+							"config value '%s' looks like a secret; "+
+								"rerun with --secret to encrypt it, mark it as {\"secure\": ...}, "+
+								"or pass --plaintext if you meant to store in plaintext",
+							leaf)
+					}
+				}
+			} else if secret {
 				c, cerr := getStackEncrypter(s)
 				if cerr != nil {
 					return cerr
@@ -497,6 +522,9 @@ func newConfigSetCmd(stack *string) *cobra.Command {
 	setCmd.PersistentFlags().BoolVar(
 		&path, "path", false,
 		"The key contains a path to a property in a map or list to set")
+	setCmd.PersistentFlags().BoolVar(
+		&jsonValue, "json", false,
+		"The value is a JSON or YAML document; leaves of the form {\"secure\": \"<plaintext>\"} are encrypted")
 	setCmd.PersistentFlags().BoolVar(
 		&plaintext, "plaintext", false,
 		"Save the value as plaintext (unencrypted)")
@@ -507,6 +535,100 @@ func newConfigSetCmd(stack *string) *cobra.Command {
 	return setCmd
 }
 
+func newConfigSetAllCmd(stack *string) *cobra.Command {
+	var plaintext bool
+	var secret bool
+	var path bool
+	var fromFile string
+
+	setAllCmd := &cobra.Command{
+		Use:   "set-all --from-file <file>",
+		Short: "Set multiple configuration values",
+		Long: "Set multiple configuration values from a JSON or YAML file.\n\n" +
+			"The file must contain a map from configuration keys to values. Values may be strings, numbers,\n" +
+			"booleans, maps or lists. Leaves of the form `{\"secure\": \"<plaintext>\"}` are encrypted individually,\n" +
+			"and `--secret` encrypts every value in the file. The `--path` flag treats each key as a path to a\n" +
+			"property in a map or list, as with `pulumi config set --path`.",
+		Args: cmdutil.NoArgs,
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			opts := display.Options{
+				Color: cmdutil.GetGlobalColorization(),
+			}
+
+			if fromFile == "" {
+				return errors.New("a file of configuration values must be specified with --from-file")
+			}
+
+			// Ensure the stack exists.
+			s, err := requireStack(*stack, true, opts, true /*setCurrent*/)
+			if err != nil {
+				return err
+			}
+
+			b, err := ioutil.ReadFile(fromFile)
+			if err != nil {
+				return errors.Wrapf(err, "reading %s", fromFile)
+			}
+
+			// Secure leaves are encrypted individually, so only create an encrypter if one is needed.
+			values, err := config.ParseObjectValues(string(b), secret, &lazyStackEncrypter{stack: s})
+			if err != nil {
+				return errors.Wrapf(err, "parsing %s", fromFile)
+			}
+
+			names := make([]string, 0, len(values))
+			for name := range values {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			ps, err := loadProjectStack(s)
+			if err != nil {
+				return err
+			}
+
+			for _, name := range names {
+				key, err := parseConfigKey(name)
+				if err != nil {
+					return errors.Wrapf(err, "invalid configuration key '%s'", name)
+				}
+
+				v := values[name]
+				if !plaintext {
+					// If we're saving plaintext configuration values, and --plaintext was not passed, check them.
+					if _, ok := valueLooksLikeSecret(key, v); ok {
+						return errors.Errorf(
+							"config value for '%s' looks like a secret; "+
+								"rerun with --secret to encrypt it, or --plaintext if you meant to store in plaintext",
+							name)
+					}
+				}
+
+				if err = ps.Config.Set(key, v, path); err != nil {
+					return errors.Wrapf(err, "setting configuration key '%s'", name)
+				}
+			}
+
+			return saveProjectStack(s, ps)
+		}),
+	}
+
+	setAllCmd.PersistentFlags().StringVar(
+		&fromFile, "from-file", "",
+		"A JSON or YAML file containing a map from configuration keys to values")
+	setAllCmd.PersistentFlags().BoolVar(
+		&path, "path", false,
+		"The keys contain paths to properties in a map or list to set")
+	setAllCmd.PersistentFlags().BoolVar(
+		&plaintext, "plaintext", false,
+		"Save plain string values as plaintext (unencrypted) even if they look like secrets")
+	setAllCmd.PersistentFlags().BoolVar(
+		&secret, "secret", false,
+		"Encrypt every value instead of storing them in plaintext")
+
+	return setAllCmd
+}
+
 var stackConfigFile string
 
 func getProjectStackPath(stack backend.Stack) (string, error) {
@@ -687,11 +809,12 @@ func getConfig(stack backend.Stack, key config.Key, path, jsonOut bool) error {
 				Secret: v.Secure(),
 			}
 
-			if v.Object() {
-				var obj interface{}
-				if err := json.Unmarshal([]byte(raw), &obj); err != nil {
-					return err
-				}
+			// Emit the typed value for objects as well as for numbers and booleans nested inside of objects.
+			obj, _, err := cfg.GetObject(key, path, d)
+			if err != nil {
+				return errors.Wrap(err, "could not decrypt configuration value")
+			}
+			if _, isString := obj.(string); !isString {
 				value.ObjectValue = obj
 			}
 
@@ -745,6 +868,54 @@ func looksLikeSecret(k config.Key, v string) bool {
 		(info.Entropy >= (entropyThreshold/2) && entropyPerChar >= entropyPerCharThreshold))
 }
 
+// valueLooksLikeSecret returns the first plaintext leaf of a configuration value that looks like a secret, if any.
+// Leaves of structured values are checked using the name of the property that contains them, or the key's name for
+// leaves that are not contained in a map, so that e.g. the "password" property of an object is checked as a password.
+func valueLooksLikeSecret(k config.Key, v config.Value) (string, bool) {
+	if v.Secure() && !v.Object() {
+		return "", false
+	}
+
+	obj, err := v.ToObject()
+	if err != nil {
+		return "", false
+	}
+
+	var check func(name string, obj interface{}) (string, bool)
+	check = func(name string, obj interface{}) (string, bool) {
+		switch obj := obj.(type) {
+		case string:
+			if looksLikeSecret(config.MustMakeKey(k.Namespace(), name), obj) {
+				return obj, true
+			}
+		case []interface{}:
+			for _, e := range obj {
+				if leaf, ok := check(name, e); ok {
+					return leaf, true
+				}
+			}
+		case map[string]interface{}:
+			// Secure leaves are encrypted, so they need not be checked.
+			if _, isSecure := obj["secure"]; isSecure && len(obj) == 1 {
+				return "", false
+			}
+
+			keys := make([]string, 0, len(obj))
+			for key := range obj {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				if leaf, ok := check(key, obj[key]); ok {
+					return leaf, true
+				}
+			}
+		}
+		return "", false
+	}
+	return check(k.Name(), obj)
+}
+
 // getStackConfiguration loads configuration information for a given stack. If stackConfigFile is non empty,
 // it is uses instead of the default configuration file for the stack
 func getStackConfiguration(stack backend.Stack, sm secrets.Manager) (backend.StackConfiguration, error) {
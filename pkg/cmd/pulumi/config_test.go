@@ -41,3 +41,24 @@ func TestSecretDetection(t *testing.T) {
 	// The key name does not match the, so even though this "looks like" a secret, we say it is not.
 	assert.False(t, looksLikeSecret(config.MustMakeKey("test", "okay"), "1415fc1f4eaeb5e096ee58c1480016638fff29bf"))
 }
+
+func TestStructuredSecretDetection(t *testing.T) {
+	const token = "1415fc1f4eaeb5e096ee58c1480016638fff29bf"
+	key := config.MustMakeKey("test", "server")
+
+	v, err := config.ParseObjectValue(`{"port": 8080, "auth": {"apiToken": "`+token+`"}}`, false, nil)
+	assert.NoError(t, err)
+	leaf, ok := valueLooksLikeSecret(key, v)
+	assert.True(t, ok)
+	assert.Equal(t, token, leaf)
+
+	// Leaves are checked using the name of the property that contains them.
+	v, err = config.ParseObjectValue(`{"okay": ["`+token+`"]}`, false, nil)
+	assert.NoError(t, err)
+	_, ok = valueLooksLikeSecret(key, v)
+	assert.False(t, ok)
+
+	// Scalar values are checked using the key's name.
+	_, ok = valueLooksLikeSecret(config.MustMakeKey("test", "token"), config.NewValue(token))
+	assert.True(t, ok)
+}
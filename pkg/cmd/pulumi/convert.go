@@ -0,0 +1,78 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/cmdutil"
+)
+
+func newConvertCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert",
+		Short: "Work with Pulumi programs written in PCL",
+		Long: "Work with Pulumi programs written in PCL.\n" +
+			"\n" +
+			"PCL, the Pulumi Configuration Language, is the HCL2-based language that Pulumi uses to\n" +
+			"describe programs independently of any particular programming language, e.g. the programs\n" +
+			"produced by `pulumi import`. A PCL program is the set of .pp files in a directory. The\n" +
+			"convert family of commands formats and checks these files.",
+		Args: cmdutil.NoArgs,
+	}
+
+	cmd.AddCommand(newConvertFmtCmd())
+	cmd.AddCommand(newConvertLintCmd())
+
+	return cmd
+}
+
+// collectPCLFiles returns the paths of the PCL files named by the given paths, sorted by name. Each path may refer to
+// a file or to a directory, in which case all of the .pp files in the directory are included. If no paths are given,
+// the .pp files in the current directory are returned.
+func collectPCLFiles(paths []string) ([]string, error) {
+	if len(paths) == 0 {
+		paths = []string{"."}
+	}
+
+	var files []string
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+
+		entries, err := ioutil.ReadDir(path)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			if !entry.IsDir() && filepath.Ext(entry.Name()) == ".pp" {
+				files = append(files, filepath.Join(path, entry.Name()))
+			}
+		}
+	}
+	sort.Strings(files)
+	return files, nil
+}
@@ -0,0 +1,99 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/v2/codegen/hcl2/syntax"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/diag/colors"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/cmdutil"
+)
+
+func newConvertFmtCmd() *cobra.Command {
+	var check bool
+
+	cmd := &cobra.Command{
+		Use:   "fmt [path...]",
+		Short: "Rewrite PCL files in canonical format",
+		Long: "Rewrite PCL files in canonical format.\n" +
+			"\n" +
+			"Each path may name a .pp file or a directory, in which case every .pp file in the directory\n" +
+			"is formatted. If no paths are given, the .pp files in the current directory are formatted.\n" +
+			"Formatting only changes whitespace: comments and the order of declarations are preserved.\n" +
+			"The name of each file that is rewritten is printed.\n" +
+			"\n" +
+			"If --check is passed, no files are rewritten. Instead, the names of the files that are not\n" +
+			"in canonical format are printed, and the command exits with a non-zero status if there\n" +
+			"are any.",
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			paths, err := collectPCLFiles(args)
+			if err != nil {
+				return err
+			}
+
+			var unformatted []string
+			for _, path := range paths {
+				info, err := os.Stat(path)
+				if err != nil {
+					return err
+				}
+				contents, err := ioutil.ReadFile(path)
+				if err != nil {
+					return err
+				}
+
+				formatted, diags := syntax.Format(contents, path)
+				if diags.HasErrors() {
+					files := map[string]*hcl.File{path: {Bytes: contents}}
+					color := cmdutil.GetGlobalColorization() != colors.Never
+					wr := hcl.NewDiagnosticTextWriter(os.Stderr, files, 0, color)
+					if err := wr.WriteDiagnostics(diags); err != nil {
+						return err
+					}
+					return errors.Errorf("could not parse %s", path)
+				}
+				if bytes.Equal(contents, formatted) {
+					continue
+				}
+
+				unformatted = append(unformatted, path)
+				fmt.Println(path)
+				if !check {
+					if err = ioutil.WriteFile(path, formatted, info.Mode()); err != nil {
+						return err
+					}
+				}
+			}
+
+			if check && len(unformatted) != 0 {
+				return errors.Errorf("%d file(s) are not formatted", len(unformatted))
+			}
+			return nil
+		}),
+	}
+
+	cmd.PersistentFlags().BoolVar(
+		&check, "check", false, "Report files that are not formatted instead of rewriting them")
+
+	return cmd
+}
@@ -0,0 +1,104 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/v2/codegen/hcl2"
+	"github.com/pulumi/pulumi/pkg/v2/codegen/hcl2/syntax"
+	"github.com/pulumi/pulumi/pkg/v2/codegen/schema"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/diag/colors"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/cmdutil"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
+)
+
+func newConvertLintCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lint [path...]",
+		Short: "Check a PCL program for problems",
+		Long: "Check a PCL program for problems.\n" +
+			"\n" +
+			"The .pp files named by the given paths are checked together as a single program. Each path\n" +
+			"may name a .pp file or a directory, in which case every .pp file in the directory is\n" +
+			"included. If no paths are given, the .pp files in the current directory are checked.\n" +
+			"\n" +
+			"The program is type-checked against the schemas of the packages it uses. Errors, unknown\n" +
+			"resource properties, type mismatches and unused config and local variables are reported\n" +
+			"along with suggestions for fixing them where possible. The command exits with a non-zero\n" +
+			"status if any errors are found.",
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			paths, err := collectPCLFiles(args)
+			if err != nil {
+				return err
+			}
+			if len(paths) == 0 {
+				return errors.New("no PCL files found")
+			}
+
+			parser := syntax.NewParser()
+			for _, path := range paths {
+				contents, err := ioutil.ReadFile(path)
+				if err != nil {
+					return err
+				}
+				if err = parser.ParseFile(bytes.NewReader(contents), path); err != nil {
+					return err
+				}
+			}
+
+			color := cmdutil.GetGlobalColorization() != colors.Never
+			diagWriter := parser.NewDiagnosticWriter(os.Stderr, 0, color)
+			if parser.Diagnostics.HasErrors() {
+				contract.IgnoreError(diagWriter.WriteDiagnostics(parser.Diagnostics))
+				return errors.New("could not parse program")
+			}
+
+			cwd, err := os.Getwd()
+			if err != nil {
+				return err
+			}
+			sink := cmdutil.Diag()
+			ctx, err := plugin.NewContext(sink, sink, nil, nil, cwd, nil, true, nil)
+			if err != nil {
+				return err
+			}
+			defer contract.IgnoreClose(ctx)
+
+			program, diags, err := hcl2.BindProgram(parser.Files, hcl2.Loader(schema.NewCachingPluginLoader(ctx.Host)),
+				hcl2.CheckResourceInputs)
+			if err != nil {
+				return err
+			}
+			diags = append(append(parser.Diagnostics, diags...), hcl2.Lint(program)...)
+
+			if err = diagWriter.WriteDiagnostics(diags); err != nil {
+				return err
+			}
+			if diags.HasErrors() {
+				return errors.New("the program contains errors")
+			}
+			return nil
+		}),
+	}
+
+	return cmd
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/pulumi/pulumi/pkg/v2/backend"
 	"github.com/pulumi/pulumi/pkg/v2/backend/filestate"
 	"github.com/pulumi/pulumi/pkg/v2/backend/httpstate"
+	"github.com/pulumi/pulumi/pkg/v2/backend/sqlitestate"
 	"github.com/pulumi/pulumi/pkg/v2/resource/stack"
 	"github.com/pulumi/pulumi/pkg/v2/secrets"
 	"github.com/pulumi/pulumi/pkg/v2/secrets/passphrase"
@@ -63,7 +64,7 @@ func getStackSecretsManager(s backend.Stack) (secrets.Manager, error) {
 		}
 
 		switch s.(type) {
-		case filestate.Stack:
+		case filestate.Stack, sqlitestate.Stack:
 			return newPassphraseSecretsManager(s.Ref().Name(), stackConfigFile,
 				false /* rotatePassphraseSecretsProvider */)
 		case httpstate.Stack:
@@ -92,3 +93,21 @@ func validateSecretsProvider(typ string) error {
 		strings.Join(supportedKinds, ","),
 	)
 }
+
+// lazyStackEncrypter is a config.Encrypter that only creates the stack's encrypter, which may prompt for a
+// passphrase, the first time a value actually needs to be encrypted.
+type lazyStackEncrypter struct {
+	stack     backend.Stack
+	encrypter config.Encrypter
+}
+
+func (e *lazyStackEncrypter) EncryptValue(plaintext string) (string, error) {
+	if e.encrypter == nil {
+		enc, err := getStackEncrypter(e.stack)
+		if err != nil {
+			return "", err
+		}
+		e.encrypter = enc
+	}
+	return e.encrypter.EncryptValue(plaintext)
+}
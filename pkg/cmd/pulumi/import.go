@@ -20,7 +20,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/blang/semver"
@@ -34,12 +36,14 @@ import (
 	gogen "github.com/pulumi/pulumi/pkg/v2/codegen/go"
 	"github.com/pulumi/pulumi/pkg/v2/codegen/hcl2"
 	"github.com/pulumi/pulumi/pkg/v2/codegen/importer"
+	"github.com/pulumi/pulumi/pkg/v2/codegen/importer/terraform"
 	"github.com/pulumi/pulumi/pkg/v2/codegen/nodejs"
 	"github.com/pulumi/pulumi/pkg/v2/codegen/python"
 	"github.com/pulumi/pulumi/pkg/v2/codegen/schema"
 	"github.com/pulumi/pulumi/pkg/v2/engine"
 	"github.com/pulumi/pulumi/pkg/v2/resource/deploy"
 	"github.com/pulumi/pulumi/pkg/v2/resource/stack"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/diag"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/tokens"
@@ -95,6 +99,76 @@ func makeImportFile(typ, name, id, parentSpec, providerSpec, version string) (im
 	}, nil
 }
 
+// parseProviderMap parses a list of provider=package mappings from Terraform provider names to Pulumi package names.
+func parseProviderMap(mappings []string) (map[string]string, error) {
+	result := map[string]string{}
+	for _, m := range mappings {
+		equals := strings.Index(m, "=")
+		if equals <= 0 || equals == len(m)-1 {
+			return nil, errors.Errorf("provider mapping '%v' must be of the form provider=package", m)
+		}
+		result[m[:equals]] = m[equals+1:]
+	}
+	return result, nil
+}
+
+// makeImportFileFromTerraformState creates an import file for the resources in the given Terraform state file and
+// writes it to the given path. The resources of each Terraform provider are mapped using the schema of the Pulumi
+// package given by packageNames or terraform.DefaultPackageNames, or of the package with the provider's name if it
+// appears in neither. Resources in the state that cannot be mapped to Pulumi resources are reported as warnings.
+func makeImportFileFromTerraformState(statePath, importFilePath string,
+	packageNames map[string]string) (importFile, error) {
+
+	f, err := os.Open(statePath)
+	if err != nil {
+		return importFile{}, err
+	}
+	defer contract.IgnoreClose(f)
+
+	state, err := terraform.ReadState(f)
+	if err != nil {
+		return importFile{}, err
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return importFile{}, err
+	}
+	sink := cmdutil.Diag()
+	ctx, err := plugin.NewContext(sink, sink, nil, nil, cwd, nil, true, nil)
+	if err != nil {
+		return importFile{}, err
+	}
+	defer contract.IgnoreClose(ctx)
+
+	resources, skipped, err := terraform.MapState(state, schema.NewCachingPluginLoader(ctx.Host), packageNames)
+	if err != nil {
+		return importFile{}, err
+	}
+	for _, s := range skipped {
+		sink.Warningf(diag.Message("", "skipping %v: %v"), s.Address, s.Reason)
+	}
+	if len(resources) == 0 {
+		return importFile{}, errors.Errorf("%v contains no resources that can be imported", statePath)
+	}
+
+	imports := importFile{Resources: make([]importSpec, len(resources))}
+	for i, r := range resources {
+		imports.Resources[i] = importSpec{Type: r.Type, Name: r.Name, ID: r.ID}
+	}
+
+	contents, err := json.MarshalIndent(imports, "", "    ")
+	if err != nil {
+		return importFile{}, err
+	}
+	if err = ioutil.WriteFile(importFilePath, contents, 0600); err != nil {
+		return importFile{}, errors.Wrap(err, "could not write import file")
+	}
+	fmt.Printf("Wrote an import file for %d resource(s) to %v.\n", len(resources), importFilePath)
+
+	return imports, nil
+}
+
 type importSpec struct {
 	Type     tokens.Type  `json:"type"`
 	Name     tokens.QName `json:"name"`
@@ -233,8 +307,8 @@ func generateImportedDefinitions(out io.Writer, stackName tokens.QName, projectN
 	if err != nil {
 		return false, err
 	}
-	loader := schema.NewPluginLoader(ctx.Host)
-	return true, importer.GenerateLanguageDefinitions(out, loader, func(w io.Writer, p *hcl2.Program) error {
+	loader := schema.NewCachingPluginLoader(ctx.Host)
+	return true, importer.GenerateLanguageDefinitionsWithReferences(out, loader, func(w io.Writer, p *hcl2.Program) error {
 		files, _, err := programGenerator(p)
 		if err != nil {
 			return err
@@ -249,13 +323,19 @@ func generateImportedDefinitions(out io.Writer, stackName tokens.QName, projectN
 			return err
 		}
 		return nil
-	}, resources, names)
+	}, resources, snap.Resources, names)
 }
 
 func newImportCmd() *cobra.Command {
 	var parentSpec string
 	var providerSpec string
 	var importFilePath string
+	var importFrom string
+	var importFileOutPath string
+	var providerMap []string
+	var discover bool
+	var discoverType string
+	var discoverFilters []string
 	var outputFilePath string
 
 	var debug bool
@@ -319,7 +399,9 @@ func newImportCmd() *cobra.Command {
 			"The name table maps language names to parent and provider URNs. These names are\n" +
 			"used in the genrated definitions, and should match the corresponding declarations\n" +
 			"in the source program. This table is required if any parents or providers are\n" +
-			"specified by the resources to import.\n" +
+			"specified by the resources to import. Any other resource in the stack may also be\n" +
+			"added to the name table, in which case property values that match its ID or ARN\n" +
+			"may be generated as references to it.\n" +
 			"\n" +
 			"The resources list contains the set of resources to import. Each resource is\n" +
 			"specified as a triple of its type, name, and ID. The format of the ID is specific\n" +
@@ -327,19 +409,84 @@ func newImportCmd() *cobra.Command {
 			"these names must correspond to entries in the name table. If a resource does not\n" +
 			"specify a provider, it will be imported using the default provider for its type. A\n" +
 			"resource that does specify a provider may specify the version of the provider\n" +
-			"that will be used for its import.\n",
+			"that will be used for its import.\n" +
+			"\n" +
+			"String inputs of the imported resources that match the ID or ARN of another imported\n" +
+			"resource are generated as references to that resource rather than as literals if\n" +
+			"their names refer to another resource (e.g. `vpcId`, `roleArn`, or a `namespace` that\n" +
+			"matches a `Namespace`). A resource's own name and identity fields are never replaced.\n" +
+			"The definitions are ordered so that each resource follows the resources it refers to.\n" +
+			"\n" +
+			"Resources that are managed by Terraform may be imported by passing the path to a\n" +
+			"Terraform state file along with `--from terraform-state`. The state must use version 4\n" +
+			"of the state format, which is written by Terraform 0.12 and later. Each resource in the\n" +
+			"state is mapped to a Pulumi resource using the Terraform metadata in the schema of the\n" +
+			"Pulumi package that corresponds to the resource's Terraform provider. This is the package\n" +
+			"with the same name as the provider, except for providers whose packages are named\n" +
+			"differently (e.g. google and azurerm, whose packages are gcp and azure) and providers\n" +
+			"mapped to a package using `--provider-map provider=package`. Resources that cannot be\n" +
+			"mapped are skipped with a warning. The resulting import file is written to the path\n" +
+			"given by `--import-file-out`, which defaults to the path of the state file with its\n" +
+			"extension replaced by `.import.json`, and the resources are then imported.\n" +
+			"\n" +
+			"Existing resources may also be discovered by passing `--discover` along with the type\n" +
+			"of the resources to find using `--type`. The resources are listed by the default\n" +
+			"provider for the type's package, which must support listing resources, and may be\n" +
+			"narrowed using one or more `--filter key=value` flags whose meaning is specific to the\n" +
+			"provider. The discovered resources are offered for selection before they are imported;\n" +
+			"if the command is not interactive or `--yes` is passed, all of them are imported.\n",
 		Run: cmdutil.RunResultFunc(func(cmd *cobra.Command, args []string) result.Result {
 			var importFile importFile
-			if importFilePath != "" {
+			var filters map[string]string
+			if discover {
+				if len(args) != 0 || importFilePath != "" || parentSpec != "" || providerSpec != "" {
+					return result.Errorf("resources may not be specified in conjunction with --discover")
+				}
+				if discoverType == "" {
+					return result.Errorf("--discover requires the type of the resources to find to be passed using --type")
+				}
+				f, err := parseDiscoveryFilters(discoverFilters)
+				if err != nil {
+					return result.FromError(err)
+				}
+				filters = f
+			} else if discoverType != "" || len(discoverFilters) != 0 {
+				return result.Errorf("--type and --filter may only be used in conjunction with --discover")
+			} else if len(providerMap) != 0 && importFrom != "terraform-state" {
+				return result.Errorf("--provider-map may only be used in conjunction with --from terraform-state")
+			} else if importFilePath != "" {
 				if len(args) != 0 || parentSpec != "" || providerSpec != "" {
 					return result.Errorf("an inline resource may not be specified in conjunction with an import file")
 				}
-				f, err := readImportFile(importFilePath)
-				if err != nil {
-					return result.FromError(errors.Wrap(err, "could not read import file"))
+				switch importFrom {
+				case "":
+					f, err := readImportFile(importFilePath)
+					if err != nil {
+						return result.FromError(errors.Wrap(err, "could not read import file"))
+					}
+					importFile = f
+				case "terraform-state":
+					if importFileOutPath == "" {
+						importFileOutPath = strings.TrimSuffix(importFilePath, filepath.Ext(importFilePath)) +
+							".import.json"
+					}
+					packageNames, err := parseProviderMap(providerMap)
+					if err != nil {
+						return result.FromError(err)
+					}
+					f, err := makeImportFileFromTerraformState(importFilePath, importFileOutPath, packageNames)
+					if err != nil {
+						return result.FromError(errors.Wrap(err, "could not read Terraform state"))
+					}
+					importFile = f
+				default:
+					return result.Errorf("unsupported import source '%v'; the only supported source is terraform-state",
+						importFrom)
 				}
-				importFile = f
 			} else {
+				if importFrom != "" {
+					return result.Errorf("--from requires the path to the state file to be passed using --file")
+				}
 				if len(args) != 3 {
 					return result.Errorf("an inline resource must be specified if no import file is used")
 				}
@@ -361,11 +508,6 @@ func newImportCmd() *cobra.Command {
 				output = f
 			}
 
-			imports, nameTable, err := parseImportFile(importFile, protectResources)
-			if err != nil {
-				return result.FromError(err)
-			}
-
 			yes = yes || skipConfirmations()
 			interactive := cmdutil.Interactive()
 			if !interactive && !yes {
@@ -434,6 +576,29 @@ func newImportCmd() *cobra.Command {
 				return result.FromError(errors.Wrap(err, "getting stack configuration"))
 			}
 
+			if discover {
+				typ := tokens.Type(discoverType)
+				ids, err := discoverResources(s.Ref().Name(), proj.Name, cfg, typ, filters)
+				if err != nil {
+					return result.FromError(errors.Wrap(err, "could not discover resources"))
+				}
+				if len(ids) == 0 {
+					return result.Errorf("no %v resources were found", typ)
+				}
+				if ids, err = selectDiscoveredResources(typ, ids, interactive && !yes); err != nil {
+					return result.FromError(err)
+				}
+				if len(ids) == 0 {
+					return result.Errorf("no resources were selected")
+				}
+				importFile = makeImportFileFromDiscovery(typ, ids)
+			}
+
+			imports, nameTable, err := parseImportFile(importFile, protectResources)
+			if err != nil {
+				return result.FromError(err)
+			}
+
 			opts.Engine = engine.UpdateOptions{
 				Parallel:      parallel,
 				Debug:         debug,
@@ -510,6 +675,27 @@ func newImportCmd() *cobra.Command {
 		&providerSpec, "provider", "", "The name and URN of the provider to use for the import in the format name=urn")
 	cmd.PersistentFlags().StringVarP(
 		&importFilePath, "file", "f", "", "The path to a JSON-encoded file containing a list of resources to import")
+	cmd.PersistentFlags().StringVar(
+		&importFrom, "from", "",
+		"The format of the file passed using --file if it is not a Pulumi import file. The only supported format is "+
+			"terraform-state")
+	cmd.PersistentFlags().StringVar(
+		&importFileOutPath, "import-file-out", "",
+		"The path to the import file that is generated when importing with --from")
+	cmd.PersistentFlags().StringArrayVar(
+		&providerMap, "provider-map", nil,
+		"A mapping of the form provider=package from the name of a Terraform provider to the name of the Pulumi "+
+			"package whose schema is used to import its resources with --from terraform-state. "+
+			"May be specified multiple times")
+	cmd.PersistentFlags().BoolVar(
+		&discover, "discover", false,
+		"Discover existing resources of the type given by --type using the provider and choose which to import")
+	cmd.PersistentFlags().StringVar(
+		&discoverType, "type", "", "The type of the resources to discover when importing with --discover")
+	cmd.PersistentFlags().StringArrayVar(
+		&discoverFilters, "filter", nil,
+		"A provider-specific filter of the form key=value that limits the resources found by --discover. "+
+			"May be specified multiple times")
 	cmd.PersistentFlags().StringVarP(
 		&outputFilePath, "out", "o", "", "The path to the file that will contain the generated resource declarations")
 
@@ -549,10 +735,13 @@ func newImportCmd() *cobra.Command {
 		&protectResources, "protect", "", true,
 		"Allow resources to be imported with protection from deletion enabled")
 
-	if hasDebugCommands() {
-		cmd.PersistentFlags().StringVar(
-			&eventLogPath, "event-log", "",
-			"Log events to a file at this path")
+	// The event log is used by the Automation API to report structured errors, so the flag is always available, but
+	// it is only shown alongside the other debug commands.
+	cmd.PersistentFlags().StringVar(
+		&eventLogPath, "event-log", "",
+		"Log events to a file at this path")
+	if !hasDebugCommands() {
+		_ = cmd.PersistentFlags().MarkHidden("event-log")
 	}
 
 	// internal flag
@@ -0,0 +1,155 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+	survey "gopkg.in/AlecAivazis/survey.v1"
+	surveycore "gopkg.in/AlecAivazis/survey.v1/core"
+
+	"github.com/pulumi/pulumi/pkg/v2/backend"
+	"github.com/pulumi/pulumi/pkg/v2/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/v2/resource/deploy/providers"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/cmdutil"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
+)
+
+// parseDiscoveryFilters parses a list of filters of the form key=value into a map. Only the first equals sign
+// separates the key from the value, so keys such as `tag:team` and values that contain equals signs are allowed.
+func parseDiscoveryFilters(filters []string) (map[string]string, error) {
+	result := map[string]string{}
+	for _, f := range filters {
+		equals := strings.Index(f, "=")
+		if equals <= 0 {
+			return nil, errors.Errorf("filter '%v' must be of the form key=value", f)
+		}
+		result[f[:equals]] = f[equals+1:]
+	}
+	return result, nil
+}
+
+// discoverResources returns the IDs of the existing resources of the given type that match the given filters. The
+// resources are listed by the default provider for the type's package, which is configured using the stack's
+// configuration.
+func discoverResources(stackName tokens.QName, projectName tokens.PackageName, cfg backend.StackConfiguration,
+	typ tokens.Type, filters map[string]string) ([]resource.ID, error) {
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+	sink := cmdutil.Diag()
+	ctx, err := plugin.NewContext(sink, sink, nil, nil, cwd, nil, true, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer contract.IgnoreClose(ctx)
+
+	pkg := typ.Package()
+	provider, err := ctx.Host.Provider(pkg, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not load the %v provider", pkg)
+	}
+	if provider == nil {
+		return nil, errors.Errorf("could not find the %v provider", pkg)
+	}
+
+	target := &deploy.Target{Name: stackName, Config: cfg.Config, Decrypter: cfg.Decrypter}
+	inputs, err := target.GetPackageConfig(pkg)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not fetch configuration for the %v provider", pkg)
+	}
+
+	urn := resource.NewURN(stackName, projectName, "", providers.MakeProviderType(pkg), "default")
+	inputs, failures, err := provider.CheckConfig(urn, nil, inputs, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(failures) != 0 {
+		return nil, errors.Errorf("invalid configuration for the %v provider: %v", pkg, failures[0].Reason)
+	}
+	if err = provider.Configure(inputs); err != nil {
+		return nil, errors.Wrapf(err, "could not configure the %v provider", pkg)
+	}
+
+	return provider.List(typ, filters)
+}
+
+// selectDiscoveredResources prompts the user to choose which of the discovered resources to import. If the command
+// is not interactive or confirmations are skipped, all of the resources are selected.
+func selectDiscoveredResources(typ tokens.Type, ids []resource.ID, prompt bool) ([]resource.ID, error) {
+	if !prompt || len(ids) == 0 {
+		return ids, nil
+	}
+
+	options := make([]string, len(ids))
+	for i, id := range ids {
+		options[i] = string(id)
+	}
+
+	surveycore.DisableColor = true
+	cmdutil.EndKeypadTransmitMode()
+
+	var selected []string
+	if err := survey.AskOne(&survey.MultiSelect{
+		Message:  fmt.Sprintf("Please choose the %v resources to import:", typ),
+		Options:  options,
+		Default:  options,
+		PageSize: 15,
+	}, &selected, nil); err != nil {
+		return nil, errors.New("no resources selected")
+	}
+
+	result := make([]resource.ID, len(selected))
+	for i, s := range selected {
+		result[i] = resource.ID(s)
+	}
+	return result, nil
+}
+
+// invalidNameChars matches the characters that may not appear in a resource name.
+var invalidNameChars = regexp.MustCompile(`[^A-Za-z0-9_.\-]+`)
+
+// makeImportFileFromDiscovery creates an import file for the discovered resources of the given type. Each resource is
+// named after its ID.
+func makeImportFileFromDiscovery(typ tokens.Type, ids []resource.ID) importFile {
+	names := map[tokens.QName]bool{}
+	resources := make([]importSpec, len(ids))
+	for i, id := range ids {
+		name := strings.Trim(invalidNameChars.ReplaceAllString(string(id), "-"), "-")
+		switch {
+		case name == "":
+			name = "resource"
+		case !tokens.IsName(name[:1]):
+			name = "resource-" + name
+		}
+		unique := name
+		for n := 2; names[tokens.QName(unique)]; n++ {
+			unique = fmt.Sprintf("%s-%d", name, n)
+		}
+		names[tokens.QName(unique)] = true
+
+		resources[i] = importSpec{Type: typ, Name: tokens.QName(unique), ID: id}
+	}
+	return importFile{Resources: resources}
+}
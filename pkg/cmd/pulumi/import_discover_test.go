@@ -0,0 +1,55 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/tokens"
+)
+
+func TestParseDiscoveryFilters(t *testing.T) {
+	filters, err := parseDiscoveryFilters([]string{"tag:team=x", "name=a=b"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"tag:team": "x", "name": "a=b"}, filters)
+
+	_, err = parseDiscoveryFilters([]string{"=x"})
+	assert.Error(t, err)
+	_, err = parseDiscoveryFilters([]string{"team"})
+	assert.Error(t, err)
+}
+
+func TestMakeImportFileFromDiscovery(t *testing.T) {
+	typ := tokens.Type("aws:s3/bucket:Bucket")
+	f := makeImportFileFromDiscovery(typ, []resource.ID{
+		"my-bucket",
+		"arn:aws:s3:::my-bucket",
+		"1234",
+		"my bucket",
+		"my-bucket",
+	})
+
+	var names []tokens.QName
+	for _, r := range f.Resources {
+		assert.Equal(t, typ, r.Type)
+		names = append(names, r.Name)
+	}
+	assert.Equal(t, []tokens.QName{"my-bucket", "arn-aws-s3-my-bucket", "resource-1234", "my-bucket-2", "my-bucket-3"},
+		names)
+	assert.Equal(t, resource.ID("arn:aws:s3:::my-bucket"), f.Resources[1].ID)
+}
@@ -0,0 +1,34 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseProviderMap(t *testing.T) {
+	packageNames, err := parseProviderMap([]string{"google=google-native", "awscc=aws"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"google": "google-native", "awscc": "aws"}, packageNames)
+
+	_, err = parseProviderMap([]string{"=gcp"})
+	assert.Error(t, err)
+	_, err = parseProviderMap([]string{"google="})
+	assert.Error(t, err)
+	_, err = parseProviderMap([]string{"google"})
+	assert.Error(t, err)
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/pulumi/pulumi/pkg/v2/backend/display"
 	"github.com/pulumi/pulumi/pkg/v2/backend/filestate"
 	"github.com/pulumi/pulumi/pkg/v2/backend/httpstate"
+	"github.com/pulumi/pulumi/pkg/v2/backend/sqlitestate"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/cmdutil"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/workspace"
 )
@@ -127,6 +128,8 @@ func newLoginCmd() *cobra.Command {
 			var err error
 			if filestate.IsFileStateBackendURL(cloudURL) {
 				be, err = filestate.Login(cmdutil.Diag(), cloudURL)
+			} else if sqlitestate.IsSQLiteBackendURL(cloudURL) {
+				be, err = sqlitestate.Login(cmdutil.Diag(), cloudURL)
 			} else {
 				be, err = httpstate.Login(commandContext(), cmdutil.Diag(), cloudURL, displayOptions)
 			}
@@ -152,7 +155,7 @@ func newLoginCmd() *cobra.Command {
 
 func validateCloudBackendType(typ string) error {
 	kind := strings.SplitN(typ, ":", 2)[0]
-	supportedKinds := []string{"azblob", "gs", "s3", "file", "https"}
+	supportedKinds := []string{"azblob", "gs", "s3", "file", "sqlite", "https"}
 	for _, supportedKind := range supportedKinds {
 		if kind == supportedKind {
 			return nil
@@ -160,7 +163,7 @@ func validateCloudBackendType(typ string) error {
 	}
 	return errors.Errorf(
 		"unknown backend cloudUrl format '%s' (supported Url formats are: "+
-			"azblob://, gs://, s3://, file:// and https://)",
+			"azblob://, gs://, s3://, file://, sqlite:// and https://)",
 		kind,
 	)
 }
@@ -21,6 +21,7 @@ import (
 	"github.com/pulumi/pulumi/pkg/v2/backend"
 	"github.com/pulumi/pulumi/pkg/v2/backend/filestate"
 	"github.com/pulumi/pulumi/pkg/v2/backend/httpstate"
+	"github.com/pulumi/pulumi/pkg/v2/backend/sqlitestate"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/cmdutil"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/workspace"
 )
@@ -67,7 +68,7 @@ func newLogoutCmd() *cobra.Command {
 
 			var be backend.Backend
 			var err error
-			if filestate.IsFileStateBackendURL(cloudURL) {
+			if filestate.IsFileStateBackendURL(cloudURL) || sqlitestate.IsSQLiteBackendURL(cloudURL) {
 				return workspace.DeleteAccount(cloudURL)
 			}
 
@@ -0,0 +1,145 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/blang/semver"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/v2/codegen/schema"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/cmdutil"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
+)
+
+func newPackageCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "package",
+		Short: "Work with Pulumi packages",
+		Long: "Work with Pulumi packages.\n" +
+			"\n" +
+			"A Pulumi package is described by a schema that lists its resources, functions and types.\n" +
+			"The package family of commands operates on these schemas, for example to generate the\n" +
+			"language SDKs for a package or to find the breaking changes between two versions of a package.",
+		Args: cmdutil.NoArgs,
+	}
+
+	cmd.AddCommand(newPackageDiffSchemaCmd())
+	cmd.AddCommand(newPackageGenSDKCmd())
+	cmd.AddCommand(newPackageValidateCmd())
+
+	return cmd
+}
+
+// loadPackageSchema loads and binds the schema for a package. The source may be the path to a JSON schema file or the
+// name of a resource plugin, optionally followed by `@` and a version, in which case the schema is retrieved from the
+// plugin.
+func loadPackageSchema(source string) (*schema.Package, error) {
+	if _, err := os.Stat(source); err == nil {
+		contents, err := ioutil.ReadFile(source)
+		if err != nil {
+			return nil, err
+		}
+
+		var spec schema.PackageSpec
+		if err = json.Unmarshal(contents, &spec); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse schema %v", source)
+		}
+		pkg, err := schema.ImportSpec(spec, nil)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to bind schema %v", source)
+		}
+		return pkg, nil
+	}
+
+	name, version, err := parsePluginSource(source)
+	if err != nil {
+		return nil, err
+	}
+
+	var pkg *schema.Package
+	err = withPluginHost(func(host plugin.Host) error {
+		pkg, err = schema.NewCachingPluginLoader(host).LoadPackage(name, version)
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load schema for plugin %v", source)
+	}
+	return pkg, nil
+}
+
+// readPackageSchema reads the JSON schema for a package without binding it. The source is interpreted as by
+// loadPackageSchema. Unlike loadPackageSchema, readPackageSchema does not install missing plugins.
+func readPackageSchema(source string) ([]byte, error) {
+	if _, err := os.Stat(source); err == nil {
+		return ioutil.ReadFile(source)
+	}
+
+	name, version, err := parsePluginSource(source)
+	if err != nil {
+		return nil, err
+	}
+
+	var contents []byte
+	err = withPluginHost(func(host plugin.Host) error {
+		provider, err := host.Provider(tokens.Package(name), version)
+		if err != nil {
+			return err
+		}
+		contents, err = provider.GetSchema(0)
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read schema for plugin %v", source)
+	}
+	return contents, nil
+}
+
+// parsePluginSource parses a plugin name that is optionally followed by `@` and a version.
+func parsePluginSource(source string) (string, *semver.Version, error) {
+	i := strings.Index(source, "@")
+	if i == -1 {
+		return source, nil, nil
+	}
+
+	v, err := semver.ParseTolerant(source[i+1:])
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "invalid plugin version %q", source[i+1:])
+	}
+	return source[:i], &v, nil
+}
+
+// withPluginHost calls the given function with a plugin host rooted at the current working directory.
+func withPluginHost(f func(host plugin.Host) error) error {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	sink := cmdutil.Diag()
+	ctx, err := plugin.NewContext(sink, sink, nil, nil, cwd, nil, true, nil)
+	if err != nil {
+		return err
+	}
+	defer contract.IgnoreClose(ctx)
+
+	return f(ctx.Host)
+}
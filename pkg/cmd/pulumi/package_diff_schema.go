@@ -0,0 +1,98 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/v2/codegen/schema"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/cmdutil"
+)
+
+func newPackageDiffSchemaCmd() *cobra.Command {
+	var jsonOut bool
+	var allowBreaking bool
+
+	cmd := &cobra.Command{
+		Use:   "diff-schema <old> <new>",
+		Short: "Compare two versions of a package schema",
+		Long: "Compare two versions of a package schema.\n" +
+			"\n" +
+			"Each schema is read from the given JSON file or, if no such file exists, retrieved from the\n" +
+			"resource plugin with the given name and version, e.g. aws@3.20.0. Every change to the\n" +
+			"package's configuration, provider, resources, functions and types is listed and classified\n" +
+			"as breaking or non-breaking. Breaking changes include removed resources, functions, types\n" +
+			"and properties, changed property types, inputs that became required and outputs that became\n" +
+			"optional.\n" +
+			"\n" +
+			"The command exits with a non-zero status if any breaking changes are found, unless\n" +
+			"--allow-breaking is passed.",
+		Args: cmdutil.ExactArgs(2),
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			oldPkg, err := loadPackageSchema(args[0])
+			if err != nil {
+				return err
+			}
+			newPkg, err := loadPackageSchema(args[1])
+			if err != nil {
+				return err
+			}
+
+			changes := schema.DiffPackages(oldPkg, newPkg)
+			if jsonOut {
+				if changes == nil {
+					changes = []*schema.Change{}
+				}
+				if err = printJSON(changes); err != nil {
+					return err
+				}
+			} else {
+				printSchemaChanges(changes)
+			}
+
+			if !allowBreaking && schema.HasBreakingChanges(changes) {
+				return errors.New("the new schema contains breaking changes")
+			}
+			return nil
+		}),
+	}
+
+	cmd.PersistentFlags().BoolVarP(
+		&jsonOut, "json", "j", false, "Emit output as JSON")
+	cmd.PersistentFlags().BoolVar(
+		&allowBreaking, "allow-breaking", false, "Exit successfully even if breaking changes are found")
+
+	return cmd
+}
+
+// printSchemaChanges prints the given changes followed by a summary.
+func printSchemaChanges(changes []*schema.Change) {
+	if len(changes) == 0 {
+		fmt.Println("No changes.")
+		return
+	}
+
+	breaking := 0
+	for _, c := range changes {
+		if c.Breaking {
+			breaking++
+		}
+		fmt.Println(c)
+	}
+	fmt.Printf("\n%d changes, %d breaking.\n", len(changes), breaking)
+}
@@ -0,0 +1,164 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/blang/semver"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/v2/codegen/dotnet"
+	gogen "github.com/pulumi/pulumi/pkg/v2/codegen/go"
+	"github.com/pulumi/pulumi/pkg/v2/codegen/nodejs"
+	"github.com/pulumi/pulumi/pkg/v2/codegen/python"
+	"github.com/pulumi/pulumi/pkg/v2/codegen/schema"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/cmdutil"
+)
+
+// sdkGenerator generates the files of a language SDK for a package.
+type sdkGenerator func(tool string, pkg *schema.Package) (map[string][]byte, error)
+
+// sdkGenerators maps the name of each supported language to its SDK generator. The SDK for each language is written
+// to a directory with the language's name under the output directory.
+var sdkGenerators = map[string]sdkGenerator{
+	"dotnet": func(tool string, pkg *schema.Package) (map[string][]byte, error) {
+		return dotnet.GeneratePackage(tool, pkg, nil)
+	},
+	"go": gogen.GeneratePackage,
+	"nodejs": func(tool string, pkg *schema.Package) (map[string][]byte, error) {
+		return nodejs.GeneratePackage(tool, pkg, nil)
+	},
+	"python": func(tool string, pkg *schema.Package) (map[string][]byte, error) {
+		return python.GeneratePackage(tool, pkg, nil)
+	},
+}
+
+func newPackageGenSDKCmd() *cobra.Command {
+	var languages []string
+	var out string
+	var version string
+
+	cmd := &cobra.Command{
+		Use:   "gen-sdk <schema-file|plugin-name[@version]>",
+		Short: "Generate language SDKs for a package",
+		Long: "Generate language SDKs for a package.\n" +
+			"\n" +
+			"The package's schema is read from the given JSON file or, if no such file exists, retrieved\n" +
+			"from the resource plugin with the given name. The SDK for each language is written to a\n" +
+			"directory with the language's name under the output directory, e.g. sdk/go, sdk/nodejs,\n" +
+			"sdk/python and sdk/dotnet. Any errors encountered while binding the schema are reported\n" +
+			"and no SDKs are generated.",
+		Args: cmdutil.ExactArgs(1),
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			langs, err := parseSDKLanguages(languages)
+			if err != nil {
+				return err
+			}
+
+			pkg, err := loadPackageSchema(args[0])
+			if err != nil {
+				return err
+			}
+			if version != "" {
+				v, err := semver.ParseTolerant(version)
+				if err != nil {
+					return errors.Wrapf(err, "invalid package version %q", version)
+				}
+				pkg.Version = &v
+			}
+			if pkg.Version == nil {
+				for _, lang := range langs {
+					if lang == "dotnet" {
+						return errors.New("the dotnet SDK requires a package version; " +
+							"add a version to the schema or pass --version")
+					}
+				}
+			}
+
+			for _, lang := range langs {
+				dir := filepath.Join(out, lang)
+				if err := genSDK(lang, pkg, dir); err != nil {
+					return err
+				}
+				fmt.Printf("Generated %s SDK for %s in %s\n", lang, pkg.Name, dir)
+			}
+			return nil
+		}),
+	}
+
+	cmd.PersistentFlags().StringSliceVarP(&languages, "language", "l", []string{"all"},
+		"The languages for which to generate SDKs: all, "+strings.Join(sdkLanguages(), ", "))
+	cmd.PersistentFlags().StringVarP(&out, "out", "o", "sdk",
+		"The directory into which the SDKs are written")
+	cmd.PersistentFlags().StringVar(&version, "version", "",
+		"The version of the package, overriding any version in the schema")
+
+	return cmd
+}
+
+// sdkLanguages returns the sorted names of the languages for which SDKs can be generated.
+func sdkLanguages() []string {
+	var langs []string
+	for lang := range sdkGenerators {
+		langs = append(langs, lang)
+	}
+	sort.Strings(langs)
+	return langs
+}
+
+// parseSDKLanguages validates the languages requested on the command line and expands "all".
+func parseSDKLanguages(languages []string) ([]string, error) {
+	var langs []string
+	seen := map[string]bool{}
+	for _, lang := range languages {
+		if lang == "all" {
+			return sdkLanguages(), nil
+		}
+		if _, ok := sdkGenerators[lang]; !ok {
+			return nil, errors.Errorf("unsupported language %q; supported languages are all, %s",
+				lang, strings.Join(sdkLanguages(), ", "))
+		}
+		if !seen[lang] {
+			langs, seen[lang] = append(langs, lang), true
+		}
+	}
+	return langs, nil
+}
+
+// genSDK generates the SDK for the given package in the given language and writes its files to dir.
+func genSDK(lang string, pkg *schema.Package, dir string) error {
+	files, err := sdkGenerators[lang]("pulumi", pkg)
+	if err != nil {
+		return errors.Wrapf(err, "generating %s SDK", lang)
+	}
+
+	for name, contents := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+			return errors.Wrapf(err, "creating directory for %s", path)
+		}
+		if err := ioutil.WriteFile(path, contents, 0600); err != nil {
+			return errors.Wrapf(err, "writing %s", path)
+		}
+	}
+	return nil
+}
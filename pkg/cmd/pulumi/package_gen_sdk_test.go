@@ -0,0 +1,61 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSDKLanguages(t *testing.T) {
+	langs, err := parseSDKLanguages([]string{"all"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"dotnet", "go", "nodejs", "python"}, langs)
+
+	langs, err = parseSDKLanguages([]string{"python", "go", "python"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"python", "go"}, langs)
+
+	_, err = parseSDKLanguages([]string{"go", "cobol"})
+	assert.Error(t, err)
+}
+
+func TestGenSDK(t *testing.T) {
+	pkg, err := loadPackageSchema(filepath.Join("..", "..", "codegen", "internal", "test", "testdata",
+		"simple-resource-schema", "schema.json"))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	dir, err := ioutil.TempDir("", "gen-sdk")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	for _, lang := range []string{"go", "nodejs", "python"} {
+		assert.NoError(t, genSDK(lang, pkg, filepath.Join(dir, lang)))
+	}
+	assert.FileExists(t, filepath.Join(dir, "go", "example", "resource.go"))
+	assert.FileExists(t, filepath.Join(dir, "nodejs", "resource.ts"))
+	assert.FileExists(t, filepath.Join(dir, "python", "pulumi_example", "resource.py"))
+
+	_, err = loadPackageSchema(filepath.Join(dir, "missing.json"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,60 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/v2/codegen/schema"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/cmdutil"
+)
+
+func newPackageValidateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate <schema-file|plugin-name[@version]>",
+		Short: "Validate a package schema",
+		Long: "Validate a package schema.\n" +
+			"\n" +
+			"The schema is read from the given JSON file or, if no such file exists, retrieved from the\n" +
+			"resource plugin with the given name. The schema is checked against the package metaschema,\n" +
+			"and its type references, tokens, enum values and module format are checked for errors.\n" +
+			"Each error is reported along with a JSON pointer to its location in the schema.",
+		Args: cmdutil.ExactArgs(1),
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			contents, err := readPackageSchema(args[0])
+			if err != nil {
+				return err
+			}
+
+			err = schema.ValidateJSON(contents)
+			if errs, ok := err.(schema.ValidationErrors); ok {
+				for _, e := range errs {
+					fmt.Println(e)
+				}
+				return errors.Errorf("%s is not a valid package schema", args[0])
+			} else if err != nil {
+				return err
+			}
+
+			fmt.Printf("%s is a valid package schema.\n", args[0])
+			return nil
+		}),
+	}
+
+	return cmd
+}
@@ -35,6 +35,7 @@ func newPreviewCmd() *cobra.Command {
 	var configArray []string
 	var configPath bool
 	var client string
+	var skipBuild bool
 
 	// Flags for engine.UpdateOptions.
 	var jsonDisplay bool
@@ -107,7 +108,7 @@ func newPreviewCmd() *cobra.Command {
 				return result.FromError(err)
 			}
 
-			proj, root, err := readProjectForUpdate(client)
+			proj, root, err := readProjectForUpdate(client, skipBuild)
 			if err != nil {
 				return result.FromError(err)
 			}
@@ -200,6 +201,9 @@ func newPreviewCmd() *cobra.Command {
 	cmd.PersistentFlags().StringVar(
 		&client, "client", "", "The address of an existing language runtime host to connect to")
 	_ = cmd.PersistentFlags().MarkHidden("client")
+	cmd.PersistentFlags().BoolVar(
+		&skipBuild, "skip-build", false,
+		"Run the most recently built program without rebuilding it (Go programs only)")
 
 	cmd.PersistentFlags().StringVarP(
 		&message, "message", "m", "",
@@ -260,10 +264,13 @@ func newPreviewCmd() *cobra.Command {
 		&suppressPermaLink, "suppress-permalink", false,
 		"Suppress display of the state permalink")
 
-	if hasDebugCommands() {
-		cmd.PersistentFlags().StringVar(
-			&eventLogPath, "event-log", "",
-			"Log events to a file at this path")
+	// The event log is used by the Automation API to report structured errors, so the flag is always available, but
+	// it is only shown alongside the other debug commands.
+	cmd.PersistentFlags().StringVar(
+		&eventLogPath, "event-log", "",
+		"Log events to a file at this path")
+	if !hasDebugCommands() {
+		_ = cmd.PersistentFlags().MarkHidden("event-log")
 	}
 
 	// internal flag
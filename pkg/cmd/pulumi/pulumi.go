@@ -40,6 +40,7 @@ import (
 	"github.com/pulumi/pulumi/pkg/v2/backend/filestate"
 	"github.com/pulumi/pulumi/pkg/v2/backend/httpstate"
 	"github.com/pulumi/pulumi/pkg/v2/backend/httpstate/client"
+	"github.com/pulumi/pulumi/pkg/v2/backend/sqlitestate"
 	"github.com/pulumi/pulumi/pkg/v2/version"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/diag"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/diag/colors"
@@ -113,6 +114,9 @@ func NewPulumiCmd() *cobra.Command {
 				}
 			}
 
+			// The --disable-integrity-checking flag applies to every backend that stores checkpoints locally.
+			sqlitestate.DisableIntegrityChecking = filestate.DisableIntegrityChecking
+
 			logging.InitLogging(logToStderr, verbose, logFlow)
 			cmdutil.InitTracing("pulumi-cli", "pulumi", tracing)
 			if tracingHeaderFlag != "" {
@@ -206,6 +210,8 @@ func NewPulumiCmd() *cobra.Command {
 	//     - Other Commands:
 	cmd.AddCommand(newLogsCmd())
 	cmd.AddCommand(newPluginCmd())
+	cmd.AddCommand(newPackageCmd())
+	cmd.AddCommand(newConvertCmd())
 	cmd.AddCommand(newVersionCmd())
 	cmd.AddCommand(newHistoryCmd())
 	cmd.AddCommand(newConsoleCmd())
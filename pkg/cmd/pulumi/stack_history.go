@@ -13,9 +13,11 @@ import (
 
 	"github.com/pulumi/pulumi/pkg/v2/backend"
 	"github.com/pulumi/pulumi/pkg/v2/backend/display"
+	"github.com/pulumi/pulumi/pkg/v2/backend/filestate"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/diag/colors"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/config"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/cmdutil"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/result"
 )
 
 const errorDecryptingValue = "ERROR_UNABLE_TO_DECRYPT"
@@ -71,6 +73,86 @@ This command displays data about previous updates for a stack.`,
 		"Show secret values when listing config instead of displaying blinded values")
 	cmd.PersistentFlags().BoolVarP(
 		&jsonOut, "json", "j", false, "Emit output as JSON")
+
+	cmd.AddCommand(newStackHistoryPruneCmd(&stack))
+	return cmd
+}
+
+func newStackHistoryPruneCmd(stack *string) *cobra.Command {
+	var keep int
+	var olderThan string
+	var yes bool
+
+	cmd := &cobra.Command{
+		Use:   "prune",
+		Args:  cmdutil.NoArgs,
+		Short: "Remove old update history and checkpoint backups for a stack",
+		Long: "Remove old update history and checkpoint backups for a stack\n" +
+			"\n" +
+			"This command permanently removes the history entries and checkpoint backups of a stack that fall\n" +
+			"outside the given retention policy. Entries are removed only if they meet every given criterion; for\n" +
+			"example, `--keep 10 --older-than 90d` removes entries that are older than 90 days, except for the 10\n" +
+			"most recent ones.\n" +
+			"\n" +
+			"Only the filestate backend supports pruning history. A filestate backend can also prune history\n" +
+			"automatically after each update, using the `history_keep` and `history_older_than` query parameters\n" +
+			"of the backend URL, e.g. `pulumi login 's3://bucket?history_keep=100'`.",
+		Run: cmdutil.RunResultFunc(func(cmd *cobra.Command, args []string) result.Result {
+			yes = yes || skipConfirmations()
+			opts := display.Options{
+				Color: cmdutil.GetGlobalColorization(),
+			}
+
+			if keep < 0 {
+				return result.Errorf("--keep must not be negative")
+			}
+			policy := filestate.RetentionPolicy{Keep: keep}
+			if olderThan != "" {
+				age, err := filestate.ParseRetentionAge(olderThan)
+				if err != nil {
+					return result.FromError(errors.Wrap(err, "parsing --older-than"))
+				}
+				policy.OlderThan = age
+			}
+			if policy.IsEmpty() {
+				return result.Errorf("at least one of --keep or --older-than must be specified")
+			}
+
+			s, err := requireStack(*stack, false /*offerNew */, opts, false /*setCurrent*/)
+			if err != nil {
+				return result.FromError(err)
+			}
+			b, ok := s.Backend().(filestate.Backend)
+			if !ok {
+				return result.Errorf("pruning history is only supported by the filestate backend")
+			}
+
+			// Ensure the user really wants to do this.
+			prompt := fmt.Sprintf("This will permanently remove old history and backups for the '%s' stack!", s.Ref())
+			if !yes && !confirmPrompt(prompt, s.Ref().String(), opts) {
+				fmt.Println("confirmation declined")
+				return result.Bail()
+			}
+
+			pruned, err := b.PruneHistory(commandContext(), s.Ref(), policy)
+			if err != nil {
+				return result.FromError(err)
+			}
+			fmt.Printf("Removed %d updates and %d backups from the history of stack '%s'\n",
+				pruned.Updates, pruned.Backups, s.Ref())
+			return nil
+		}),
+	}
+
+	cmd.Flags().IntVar(
+		&keep, "keep", 0,
+		"The number of most recent updates and backups to keep")
+	cmd.Flags().StringVar(
+		&olderThan, "older-than", "",
+		"Only remove updates and backups older than this age (e.g. 90d or 12h)")
+	cmd.Flags().BoolVarP(
+		&yes, "yes", "y", false,
+		"Skip confirmation prompts, and proceed with pruning anyway")
 	return cmd
 }
 
@@ -23,6 +23,7 @@ import (
 	"github.com/pulumi/pulumi/pkg/v2/backend/display"
 	"github.com/pulumi/pulumi/pkg/v2/resource/deploy"
 	"github.com/pulumi/pulumi/pkg/v2/resource/stack"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/config"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/cmdutil"
 )
@@ -109,9 +110,16 @@ func getStackOutputs(snap *deploy.Snapshot, showSecrets bool) (map[string]interf
 		return map[string]interface{}{}, nil
 	}
 
+	// Don't display the types recorded for typed outputs.
+	outputs := state.Outputs
+	if _, ok := outputs[resource.StackOutputTypesKey]; ok {
+		outputs = outputs.Copy()
+		delete(outputs, resource.StackOutputTypesKey)
+	}
+
 	// massageSecrets will remove all the secrets from the property map, so it should be safe to pass a panic
 	// crypter. This also ensure that if for some reason we didn't remove everything, we don't accidentally disclose
 	// secret values!
-	return stack.SerializeProperties(display.MassageSecrets(state.Outputs, showSecrets),
+	return stack.SerializeProperties(display.MassageSecrets(outputs, showSecrets),
 		config.NewPanicCrypter(), showSecrets)
 }
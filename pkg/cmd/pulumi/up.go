@@ -52,6 +52,7 @@ func newUpCmd() *cobra.Command {
 	var configArray []string
 	var path bool
 	var client string
+	var skipBuild bool
 
 	// Flags for engine.UpdateOptions.
 	var policyPackPaths []string
@@ -86,7 +87,7 @@ func newUpCmd() *cobra.Command {
 			return result.FromError(err)
 		}
 
-		proj, root, err := readProjectForUpdate(client)
+		proj, root, err := readProjectForUpdate(client, skipBuild)
 		if err != nil {
 			return result.FromError(err)
 		}
@@ -404,6 +405,9 @@ func newUpCmd() *cobra.Command {
 	cmd.PersistentFlags().StringVar(
 		&client, "client", "", "The address of an existing language runtime host to connect to")
 	_ = cmd.PersistentFlags().MarkHidden("client")
+	cmd.PersistentFlags().BoolVar(
+		&skipBuild, "skip-build", false,
+		"Run the most recently built program without rebuilding it (Go programs only)")
 
 	cmd.PersistentFlags().StringVarP(
 		&message, "message", "m", "",
@@ -467,10 +471,13 @@ func newUpCmd() *cobra.Command {
 		&yes, "yes", "y", false,
 		"Automatically approve and perform the update after previewing it")
 
-	if hasDebugCommands() {
-		cmd.PersistentFlags().StringVar(
-			&eventLogPath, "event-log", "",
-			"Log events to a file at this path")
+	// The event log is used by the Automation API to report structured errors, so the flag is always available, but
+	// it is only shown alongside the other debug commands.
+	cmd.PersistentFlags().StringVar(
+		&eventLogPath, "event-log", "",
+		"Log events to a file at this path")
+	if !hasDebugCommands() {
+		_ = cmd.PersistentFlags().MarkHidden("event-log")
 	}
 
 	// internal flag
@@ -39,6 +39,7 @@ import (
 	"github.com/pulumi/pulumi/pkg/v2/backend/display"
 	"github.com/pulumi/pulumi/pkg/v2/backend/filestate"
 	"github.com/pulumi/pulumi/pkg/v2/backend/httpstate"
+	"github.com/pulumi/pulumi/pkg/v2/backend/sqlitestate"
 	"github.com/pulumi/pulumi/pkg/v2/backend/state"
 	"github.com/pulumi/pulumi/pkg/v2/engine"
 	"github.com/pulumi/pulumi/pkg/v2/resource/stack"
@@ -97,6 +98,9 @@ func currentBackend(opts display.Options) (backend.Backend, error) {
 	if filestate.IsFileStateBackendURL(url) {
 		return filestate.New(cmdutil.Diag(), url)
 	}
+	if sqlitestate.IsSQLiteBackendURL(url) {
+		return sqlitestate.New(cmdutil.Diag(), url)
+	}
 	return httpstate.Login(commandContext(), cmdutil.Diag(), url, opts)
 }
 
@@ -132,6 +136,11 @@ func createSecretsManager(b backend.Backend, stackRef backend.StackReference, se
 		secretsProvider = passphrase.Type
 	}
 
+	if _, ok := b.(sqlitestate.Backend); ok && isDefaultSecretsProvider {
+		// The default when using the SQLite backend is also the passphrase secrets provider
+		secretsProvider = passphrase.Type
+	}
+
 	if _, ok := b.(httpstate.Backend); ok && isDefaultSecretsProvider {
 		stack, err := state.CurrentStack(commandContext(), b)
 		if err != nil {
@@ -412,7 +421,7 @@ func parseAndSaveConfigArray(s backend.Stack, configArray []string, path bool) e
 // containing directory, which will be used as the root of the project's Pulumi program. If a
 // client address is present, the returned project will always have the runtime set to "client"
 // with the address option set to the client address.
-func readProjectForUpdate(clientAddress string) (*workspace.Project, string, error) {
+func readProjectForUpdate(clientAddress string, skipBuild bool) (*workspace.Project, string, error) {
 	proj, root, err := readProject()
 	if err != nil {
 		return nil, "", err
@@ -421,6 +430,12 @@ func readProjectForUpdate(clientAddress string) (*workspace.Project, string, err
 		proj.Runtime = workspace.NewProjectRuntimeInfo("client", map[string]interface{}{
 			"address": clientAddress,
 		})
+	} else if skipBuild {
+		// Only the Go language host builds programs ahead of running them.
+		if proj.Runtime.Name() != "go" {
+			return nil, "", errors.Errorf("--skip-build is not supported for %s programs", proj.Runtime.Name())
+		}
+		proj.Runtime.SetOption("skipBuild", true)
 	}
 	return proj, root, nil
 }
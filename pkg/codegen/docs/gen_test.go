@@ -249,7 +249,7 @@ $ pulumi import prov:module/resource:Resource test test
 					Properties: map[string]schema.PropertySpec{
 						"options": {
 							TypeSpec: schema.TypeSpec{
-								Ref: "#/types/prov:module/getModuleResource:getModuleResource",
+								Ref: "#/types/prov:module/getModuleResourceOptions:getModuleResourceOptions",
 							},
 						},
 					},
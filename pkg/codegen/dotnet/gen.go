@@ -390,7 +390,9 @@ type plainType struct {
 func (pt *plainType) genInputProperty(w io.Writer, prop *schema.Property, indent string) {
 	wireName := prop.Name
 	propertyName := pt.mod.propertyName(prop)
-	propertyType := pt.mod.typeString(prop.Type, pt.propertyTypeQualifier, true, pt.state, pt.wrapInput, false, !prop.IsRequired)
+	// Plain properties are never wrapped in Input, even when the rest of the type's properties are.
+	wrapInput := pt.wrapInput && !prop.IsPlain
+	propertyType := pt.mod.typeString(prop.Type, pt.propertyTypeQualifier, true, pt.state, wrapInput, false, !prop.IsRequired)
 
 	// First generate the input attribute.
 	attributeArgs := ""
@@ -416,8 +418,8 @@ func (pt *plainType) genInputProperty(w io.Writer, prop *schema.Property, indent
 	switch prop.Type.(type) {
 	case *schema.ArrayType, *schema.MapType:
 		backingFieldName := "_" + prop.Name
-		requireInitializers := !pt.wrapInput
-		backingFieldType := pt.mod.typeString(prop.Type, pt.propertyTypeQualifier, true, pt.state, pt.wrapInput, requireInitializers, false)
+		requireInitializers := !wrapInput
+		backingFieldType := pt.mod.typeString(prop.Type, pt.propertyTypeQualifier, true, pt.state, wrapInput, requireInitializers, false)
 
 		fmt.Fprintf(w, "%s[Input(\"%s\"%s)]\n", indent, wireName, attributeArgs)
 		fmt.Fprintf(w, "%sprivate %s? %s;\n", indent, backingFieldType, backingFieldName)
@@ -438,7 +440,7 @@ func (pt *plainType) genInputProperty(w io.Writer, prop *schema.Property, indent
 		fmt.Fprintf(w, "%s}\n", indent)
 	default:
 		initializer := ""
-		if prop.IsRequired && (!isValueType(prop.Type) || pt.wrapInput) {
+		if prop.IsRequired && (!isValueType(prop.Type) || wrapInput) {
 			initializer = " = null!;"
 		}
 
@@ -45,6 +45,14 @@ func TestGeneratePackage(t *testing.T) {
 				"Workload.cs",
 			},
 		},
+		{
+			"Simple schema with plain inputs",
+			"simple-plain-schema",
+			[]string{
+				"Component.cs",
+				"Inputs/ConfigArgs.cs",
+			},
+		},
 	}
 	testDir := filepath.Join("..", "internal", "test", "testdata")
 	for _, tt := range tests {
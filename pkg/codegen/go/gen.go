@@ -273,6 +273,33 @@ func (pkg *pkgContext) plainType(t schema.Type, optional bool) string {
 	return typ
 }
 
+// argsType returns the type of the given property in an args struct. Plain properties use their plain type; all other
+// properties accept inputs.
+func (pkg *pkgContext) argsType(p *schema.Property) string {
+	if p.IsPlain {
+		return pkg.plainArgsType(p.Type, !p.IsRequired)
+	}
+	return pkg.inputType(p.Type, !p.IsRequired)
+}
+
+// plainArgsType returns the plain type of a plain input. Only the plain property itself is plain: object types, including
+// those nested in arrays and maps, use their args structs so that their own non-plain properties still accept inputs.
+func (pkg *pkgContext) plainArgsType(t schema.Type, optional bool) string {
+	switch t := t.(type) {
+	case *schema.ArrayType:
+		return "[]" + pkg.plainArgsType(t.ElementType, false)
+	case *schema.MapType:
+		return "map[string]" + pkg.plainArgsType(t.ElementType, false)
+	case *schema.ObjectType:
+		typ := pkg.tokenToType(t.Token) + "Args"
+		if optional {
+			return "*" + typ
+		}
+		return typ
+	}
+	return pkg.plainType(t, optional)
+}
+
 func (pkg *pkgContext) inputType(t schema.Type, optional bool) string {
 	var typ string
 	switch t := t.(type) {
@@ -641,7 +668,7 @@ func (pkg *pkgContext) genInputTypes(w io.Writer, t *schema.ObjectType, details
 	fmt.Fprintf(w, "type %sArgs struct {\n", name)
 	for _, p := range t.Properties {
 		printCommentWithDeprecationMessage(w, p.Comment, p.DeprecationMessage, true)
-		fmt.Fprintf(w, "\t%s %s `pulumi:\"%s\"`\n", Title(p.Name), pkg.inputType(p.Type, !p.IsRequired), p.Name)
+		fmt.Fprintf(w, "\t%s %s `pulumi:\"%s\"`\n", Title(p.Name), pkg.argsType(p), p.Name)
 	}
 	fmt.Fprintf(w, "}\n\n")
 
@@ -858,6 +885,45 @@ func (pkg *pkgContext) getDefaultValue(dv *schema.DefaultValue, t schema.Type) (
 	return val, nil
 }
 
+// isNilType returns true if the zero value of the given Go type is nil.
+func isNilType(typ string) bool {
+	return typ == "interface{}" || strings.HasPrefix(typ, "*") || strings.HasPrefix(typ, "[]") ||
+		strings.HasPrefix(typ, "map[")
+}
+
+// genPlainDefaults emits the code that applies a plain input property's constant or default value. As a plain value
+// cannot be told apart from its zero value unless it is optional, defaults only apply to optional primitives.
+func (pkg *pkgContext) genPlainDefaults(w io.Writer, p *schema.Property) error {
+	typ := pkg.plainType(p.Type, false)
+	if p.ConstValue != nil {
+		v, err := pkg.getConstValue(p.ConstValue)
+		if err != nil {
+			return err
+		}
+
+		if p.IsRequired {
+			fmt.Fprintf(w, "\targs.%s = %s(%s)\n", Title(p.Name), typ, v)
+		} else {
+			fmt.Fprintf(w, "\t{\n")
+			fmt.Fprintf(w, "\t\tv := %s(%s)\n", typ, v)
+			fmt.Fprintf(w, "\t\targs.%s = &v\n", Title(p.Name))
+			fmt.Fprintf(w, "\t}\n")
+		}
+	}
+	if p.DefaultValue != nil && !p.IsRequired && !isNilType(typ) {
+		v, err := pkg.getDefaultValue(p.DefaultValue, p.Type)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(w, "\tif args.%s == nil {\n", Title(p.Name))
+		fmt.Fprintf(w, "\t\tv := %s(%s)\n", typ, v)
+		fmt.Fprintf(w, "\t\targs.%s = &v\n", Title(p.Name))
+		fmt.Fprintf(w, "\t}\n")
+	}
+	return nil
+}
+
 func (pkg *pkgContext) genResource(w io.Writer, r *schema.Resource) error {
 	name := resourceName(r)
 
@@ -912,7 +978,7 @@ func (pkg *pkgContext) genResource(w io.Writer, r *schema.Resource) error {
 		case *schema.EnumType:
 			// not a pointer type and already handled above
 		default:
-			if p.IsRequired {
+			if p.IsRequired && (!p.IsPlain || isNilType(pkg.plainType(p.Type, false))) {
 				fmt.Fprintf(w, "\tif args.%s == nil {\n", Title(p.Name))
 				fmt.Fprintf(w, "\t\treturn nil, errors.New(\"invalid value for required argument '%s'\")\n", Title(p.Name))
 				fmt.Fprintf(w, "\t}\n")
@@ -921,6 +987,13 @@ func (pkg *pkgContext) genResource(w io.Writer, r *schema.Resource) error {
 	}
 
 	for _, p := range r.InputProperties {
+		if p.IsPlain {
+			if err := pkg.genPlainDefaults(w, p); err != nil {
+				return err
+			}
+			continue
+		}
+
 		if p.ConstValue != nil {
 			v, err := pkg.getConstValue(p.ConstValue)
 			if err != nil {
@@ -1041,7 +1114,7 @@ func (pkg *pkgContext) genResource(w io.Writer, r *schema.Resource) error {
 	fmt.Fprintf(w, "type %sArgs struct {\n", name)
 	for _, p := range r.InputProperties {
 		printCommentWithDeprecationMessage(w, p.Comment, p.DeprecationMessage, true)
-		fmt.Fprintf(w, "\t%s %s\n", Title(p.Name), pkg.inputType(p.Type, !p.IsRequired))
+		fmt.Fprintf(w, "\t%s %s\n", Title(p.Name), pkg.argsType(p))
 	}
 	fmt.Fprintf(w, "}\n\n")
 
@@ -1063,13 +1136,110 @@ func (pkg *pkgContext) genResource(w io.Writer, r *schema.Resource) error {
 	fmt.Fprintf(w, "}\n\n")
 	genOutputMethods(w, name, name+"Output")
 	fmt.Fprintf(w, "\n")
+
+	// Emit the resource's methods.
+	for _, m := range r.Methods {
+		pkg.genMethod(w, name, m)
+	}
+
 	fmt.Fprintf(w, "func init() {\n")
 	fmt.Fprintf(w, "\tpulumi.RegisterOutputType(%sOutput{})\n", name)
+	for _, m := range r.Methods {
+		if m.Function.Outputs != nil {
+			fmt.Fprintf(w, "\tpulumi.RegisterOutputType(%s%sResultOutput{})\n", name, Title(m.Name))
+		}
+	}
 	fmt.Fprintf(w, "}\n\n")
 
 	return nil
 }
 
+// methodArgs returns the inputs of a method's function, excluding the `__self__` argument.
+func methodArgs(f *schema.Function) []*schema.Property {
+	if f.Inputs == nil {
+		return nil
+	}
+	var args []*schema.Property
+	for _, p := range f.Inputs.Properties {
+		if p.Name != "__self__" {
+			args = append(args, p)
+		}
+	}
+	return args
+}
+
+func (pkg *pkgContext) genMethod(w io.Writer, resourceName string, method *schema.Method) {
+	f := method.Function
+	methodName := Title(method.Name)
+	typeName := resourceName + methodName
+	args := methodArgs(f)
+
+	// Emit the method itself, which calls the provider with the resource as `__self__`.
+	argsig := "ctx *pulumi.Context"
+	argsVar := "nil"
+	if len(args) > 0 {
+		argsig = fmt.Sprintf("%s, args *%sArgs", argsig, typeName)
+		argsVar = "args"
+	}
+	printCommentWithDeprecationMessage(w, f.Comment, f.DeprecationMessage, false)
+	if f.Outputs == nil {
+		fmt.Fprintf(w, "func (r *%s) %s(%s) error {\n", resourceName, methodName, argsig)
+		fmt.Fprintf(w, "\t_, err := ctx.Call(%q, %s, nil, r)\n", f.Token, argsVar)
+		fmt.Fprintf(w, "\treturn err\n")
+	} else {
+		fmt.Fprintf(w, "func (r *%s) %s(%s) (%sResultOutput, error) {\n", resourceName, methodName, argsig, typeName)
+		fmt.Fprintf(w, "\tout, err := ctx.Call(%q, %s, %sResultOutput{}, r)\n", f.Token, argsVar, typeName)
+		fmt.Fprintf(w, "\tif err != nil {\n")
+		fmt.Fprintf(w, "\t\treturn %sResultOutput{}, err\n", typeName)
+		fmt.Fprintf(w, "\t}\n")
+		fmt.Fprintf(w, "\treturn out.(%sResultOutput), nil\n", typeName)
+	}
+	fmt.Fprintf(w, "}\n\n")
+
+	// Emit the args types.
+	if len(args) > 0 {
+		fmt.Fprintf(w, "type %sArgs struct {\n", camel(typeName))
+		for _, p := range args {
+			printCommentWithDeprecationMessage(w, p.Comment, p.DeprecationMessage, true)
+			fmt.Fprintf(w, "\t%s %s `pulumi:\"%s\"`\n", Title(p.Name), pkg.plainType(p.Type, !p.IsRequired), p.Name)
+		}
+		fmt.Fprintf(w, "}\n\n")
+
+		fmt.Fprintf(w, "// The set of arguments for the %s method of the %s resource.\n", methodName, resourceName)
+		fmt.Fprintf(w, "type %sArgs struct {\n", typeName)
+		for _, p := range args {
+			printCommentWithDeprecationMessage(w, p.Comment, p.DeprecationMessage, true)
+			fmt.Fprintf(w, "\t%s %s\n", Title(p.Name), pkg.argsType(p))
+		}
+		fmt.Fprintf(w, "}\n\n")
+
+		fmt.Fprintf(w, "func (%sArgs) ElementType() reflect.Type {\n", typeName)
+		fmt.Fprintf(w, "\treturn reflect.TypeOf((*%sArgs)(nil)).Elem()\n", camel(typeName))
+		fmt.Fprintf(w, "}\n\n")
+	}
+
+	// Emit the result types.
+	if f.Outputs != nil {
+		pkg.genPlainType(w, typeName+"Result", f.Outputs.Comment, "", f.Outputs.Properties)
+
+		fmt.Fprintf(w, "type %sResultOutput struct{ *pulumi.OutputState }\n\n", typeName)
+
+		fmt.Fprintf(w, "func (%sResultOutput) ElementType() reflect.Type {\n", typeName)
+		fmt.Fprintf(w, "\treturn reflect.TypeOf((*%sResult)(nil)).Elem()\n", typeName)
+		fmt.Fprintf(w, "}\n\n")
+
+		for _, p := range f.Outputs.Properties {
+			printCommentWithDeprecationMessage(w, p.Comment, p.DeprecationMessage, false)
+			outputType, applyType := pkg.outputType(p.Type, !p.IsRequired), pkg.plainType(p.Type, !p.IsRequired)
+
+			fmt.Fprintf(w, "func (o %sResultOutput) %s() %s {\n", typeName, Title(p.Name), outputType)
+			fmt.Fprintf(w, "\treturn o.ApplyT(func (v %sResult) %s { return v.%s }).(%s)\n", typeName, applyType,
+				Title(p.Name), outputType)
+			fmt.Fprintf(w, "}\n\n")
+		}
+	}
+}
+
 func (pkg *pkgContext) genFunction(w io.Writer, f *schema.Function) {
 	// If the function starts with New or Get, it will conflict; so rename them.
 	name := pkg.functionNames[f]
@@ -1232,6 +1402,16 @@ func (pkg *pkgContext) getImports(member interface{}, imports stringSet) {
 				imports.add("github.com/pkg/errors")
 			}
 		}
+		for _, m := range member.Methods {
+			for _, p := range methodArgs(m.Function) {
+				pkg.getTypeImports(p.Type, false, imports, seen)
+			}
+			if m.Function.Outputs != nil {
+				for _, p := range m.Function.Outputs.Properties {
+					pkg.getTypeImports(p.Type, false, imports, seen)
+				}
+			}
+		}
 	case *schema.Function:
 		if member.Inputs != nil {
 			pkg.getTypeImports(member.Inputs, false, imports, seen)
@@ -1443,6 +1623,18 @@ func generatePackageContextMap(tool string, pkg *schema.Package, goInfo GoPackag
 			pkg.names.add("Get" + resourceName(r))
 		}
 
+		for _, m := range r.Methods {
+			methodName := resourceName(r) + Title(m.Name)
+			if len(methodArgs(m.Function)) > 0 {
+				pkg.names.add(methodName + "Args")
+				pkg.names.add(camel(methodName) + "Args")
+			}
+			if m.Function.Outputs != nil {
+				pkg.names.add(methodName + "Result")
+				pkg.names.add(methodName + "ResultOutput")
+			}
+		}
+
 		markOptionalPropertyTypesAsRequiringPtr(seenMap, r.InputProperties, !r.IsProvider)
 		markOptionalPropertyTypesAsRequiringPtr(seenMap, r.Properties, !r.IsProvider)
 	}
@@ -1453,6 +1645,11 @@ func generatePackageContextMap(tool string, pkg *schema.Package, goInfo GoPackag
 	}
 
 	for _, f := range pkg.Functions {
+		// Methods are generated as part of the resources that define them.
+		if f.IsMethod {
+			continue
+		}
+
 		pkg := getPkgFromToken(f.Token)
 		pkg.functions = append(pkg.functions, f)
 
@@ -77,6 +77,21 @@ func TestGeneratePackage(t *testing.T) {
 				filepath.Join("plant", "tree", "v1", "pulumiEnums.go"),
 			},
 		},
+		{
+			"Simple schema with resource methods",
+			"simple-methods-schema",
+			[]string{
+				filepath.Join("example", "foo.go"),
+			},
+		},
+		{
+			"Simple schema with plain inputs",
+			"simple-plain-schema",
+			[]string{
+				filepath.Join("example", "component.go"),
+				filepath.Join("example", "pulumiTypes.go"),
+			},
+		},
 	}
 	testDir := filepath.Join("..", "internal", "test", "testdata")
 	for _, tt := range tests {
@@ -30,6 +30,7 @@ import (
 
 type bindOptions struct {
 	allowMissingVariables bool
+	checkResourceInputs   bool
 	loader                schema.Loader
 	packageCache          *PackageCache
 }
@@ -58,6 +59,13 @@ func AllowMissingVariables(options *bindOptions) {
 	options.allowMissingVariables = true
 }
 
+// CheckResourceInputs enables checking the inputs of each resource against the resource's schema. Unknown inputs,
+// missing required inputs and inputs of the wrong type are reported as warnings, as the type system does not yet model
+// every conversion that a provider accepts.
+func CheckResourceInputs(options *bindOptions) {
+	options.checkResourceInputs = true
+}
+
 func PluginHost(host plugin.Host) BindOption {
 	return Loader(schema.NewPluginLoader(host))
 }
@@ -91,7 +99,7 @@ func BindProgram(files []*syntax.File, opts ...BindOption) (*Program, hcl.Diagno
 		if err != nil {
 			return nil, nil, err
 		}
-		options.loader = schema.NewPluginLoader(ctx.Host)
+		options.loader = schema.NewCachingPluginLoader(ctx.Host)
 
 		defer contract.IgnoreClose(ctx)
 	}
@@ -127,11 +135,12 @@ func BindProgram(files []*syntax.File, opts ...BindOption) (*Program, hcl.Diagno
 		return files[i].Name < files[j].Name
 	})
 	for _, f := range files {
-		fileDiags, err := b.declareNodes(f)
-		if err != nil {
-			return nil, nil, err
-		}
-		diagnostics = append(diagnostics, fileDiags...)
+		diagnostics = append(diagnostics, b.declareNodes(f)...)
+	}
+
+	// Load the schemas for all of the packages referenced by the program.
+	if err := b.loadReferencedPackageSchemas(b.nodes); err != nil {
+		return nil, nil, err
 	}
 
 	// Now bind the nodes.
@@ -148,7 +157,7 @@ func BindProgram(files []*syntax.File, opts ...BindOption) (*Program, hcl.Diagno
 
 // declareNodes declares all of the top-level nodes in the given file. This includes config, resources, outputs, and
 // locals.
-func (b *binder) declareNodes(file *syntax.File) (hcl.Diagnostics, error) {
+func (b *binder) declareNodes(file *syntax.File) hcl.Diagnostics {
 	var diagnostics hcl.Diagnostics
 
 	// Declare body items in source order.
@@ -158,10 +167,6 @@ func (b *binder) declareNodes(file *syntax.File) (hcl.Diagnostics, error) {
 			v := &LocalVariable{syntax: item}
 			attrDiags := b.declareNode(item.Name, v)
 			diagnostics = append(diagnostics, attrDiags...)
-
-			if err := b.loadReferencedPackageSchemas(v); err != nil {
-				return nil, err
-			}
 		case *hclsyntax.Block:
 			switch item.Type {
 			case "config":
@@ -187,10 +192,6 @@ func (b *binder) declareNodes(file *syntax.File) (hcl.Diagnostics, error) {
 				}
 				diags := b.declareNode(name, v)
 				diagnostics = append(diagnostics, diags...)
-
-				if err := b.loadReferencedPackageSchemas(v); err != nil {
-					return nil, err
-				}
 			case "resource":
 				if len(item.Labels) != 2 {
 					diagnostics = append(diagnostics, labelsErrorf(item, "resource variables must have exactly two labels"))
@@ -201,10 +202,6 @@ func (b *binder) declareNodes(file *syntax.File) (hcl.Diagnostics, error) {
 				}
 				declareDiags := b.declareNode(item.Labels[0], resource)
 				diagnostics = append(diagnostics, declareDiags...)
-
-				if err := b.loadReferencedPackageSchemas(resource); err != nil {
-					return nil, err
-				}
 			case "output":
 				name, typ := "<unnamed>", model.Type(model.DynamicType)
 				switch len(item.Labels) {
@@ -228,15 +225,11 @@ func (b *binder) declareNodes(file *syntax.File) (hcl.Diagnostics, error) {
 				}
 				diags := b.declareNode(name, v)
 				diagnostics = append(diagnostics, diags...)
-
-				if err := b.loadReferencedPackageSchemas(v); err != nil {
-					return nil, err
-				}
 			}
 		}
 	}
 
-	return diagnostics, nil
+	return diagnostics
 }
 
 // declareNode declares a single top-level node. If a node with the same name has already been declared, it returns an
@@ -105,7 +105,7 @@ func (b *binder) bindConfigVariable(node *ConfigVariable) hcl.Diagnostics {
 	if defaultValue, ok := block.Body.Attribute("default"); ok {
 		node.DefaultValue = defaultValue.Value
 		if model.InputType(node.typ).ConversionFrom(node.DefaultValue.Type()) == model.NoConversion {
-			diagnostics = append(diagnostics, model.ExprNotConvertible(model.InputType(node.typ), node.DefaultValue))
+			diagnostics = append(diagnostics, exprNotConvertible(model.InputType(node.typ), node.DefaultValue))
 		}
 	}
 	node.Definition = block
@@ -123,7 +123,7 @@ func (b *binder) bindOutputVariable(node *OutputVariable) hcl.Diagnostics {
 	if value, ok := block.Body.Attribute("value"); ok {
 		node.Value = value.Value
 		if model.InputType(node.typ).ConversionFrom(node.Value.Type()) == model.NoConversion {
-			diagnostics = append(diagnostics, model.ExprNotConvertible(model.InputType(node.typ), node.Value))
+			diagnostics = append(diagnostics, exprNotConvertible(model.InputType(node.typ), node.Value))
 		}
 	}
 	node.Definition = block
@@ -166,6 +166,24 @@ func (s *optionsScopes) GetScopeForAttribute(attr *hclsyntax.Attribute) (*model.
 	return s.root, nil
 }
 
+// resourceInputObjectType returns the object type that describes a resource's input properties. Input types are of
+// the form union(T, output(T)), so the object type is the element of the union that is not an output.
+func resourceInputObjectType(inputType model.Type) (*model.ObjectType, bool) {
+	if union, ok := inputType.(*model.UnionType); ok {
+		for _, t := range union.ElementTypes {
+			if objectType, ok := t.(*model.ObjectType); ok {
+				return objectType, true
+			}
+		}
+		return nil, false
+	}
+	objectType, ok := inputType.(*model.ObjectType)
+	return objectType, ok
+}
+
+// resourceOptionNames lists the attributes that are allowed in a resource's options block.
+var resourceOptionNames = []string{"dependsOn", "ignoreChanges", "parent", "protect", "provider", "range"}
+
 // bindResourceBody binds the body of a resource.
 func (b *binder) bindResourceBody(node *Resource) hcl.Diagnostics {
 	var diagnostics hcl.Diagnostics
@@ -263,27 +281,43 @@ func (b *binder) bindResourceBody(node *Resource) hcl.Diagnostics {
 		}
 	}
 
-	// Typecheck the attributes.
-	if objectType, ok := node.InputType.(*model.ObjectType); ok {
+	// Typecheck the attributes. Checking inputs against the resource's schema is only done on request: the type
+	// system does not yet model every conversion that a provider accepts (e.g. from number to int), so problems with
+	// the resource's inputs are reported as warnings rather than errors.
+	objectType, ok := node.InputType.(*model.ObjectType)
+	if b.options.checkResourceInputs {
+		objectType, ok = resourceInputObjectType(node.InputType)
+	}
+	if ok {
+		var inputDiags hcl.Diagnostics
+
 		attrNames := codegen.StringSet{}
 		for _, attr := range node.Inputs {
 			attrNames.Add(attr.Name)
 
 			if typ, ok := objectType.Properties[attr.Name]; ok {
 				if !typ.ConversionFrom(attr.Value.Type()).Exists() {
-					diagnostics = append(diagnostics, model.ExprNotConvertible(typ, attr.Value))
+					inputDiags = append(inputDiags, exprNotConvertible(typ, attr.Value))
 				}
 			} else {
-				diagnostics = append(diagnostics, unsupportedAttribute(attr.Name, attr.Syntax.NameRange))
+				inputDiags = append(inputDiags, unsupportedAttribute(attr.Name, attr.Syntax.NameRange,
+					codegen.SortedKeys(objectType.Properties)))
 			}
 		}
 
 		for _, k := range codegen.SortedKeys(objectType.Properties) {
 			if !model.IsOptionalType(objectType.Properties[k]) && !attrNames.Has(k) {
-				diagnostics = append(diagnostics,
+				inputDiags = append(inputDiags,
 					missingRequiredAttribute(k, node.Definition.Body.Syntax.MissingItemRange()))
 			}
 		}
+
+		if b.options.checkResourceInputs {
+			for _, d := range inputDiags {
+				d.Severity = hcl.DiagWarning
+			}
+		}
+		diagnostics = append(diagnostics, inputDiags...)
 	}
 
 	// Typecheck the options block.
@@ -314,11 +348,12 @@ func (b *binder) bindResourceBody(node *Resource) hcl.Diagnostics {
 					t = model.NewListType(ResourcePropertyType)
 					resourceOptions.IgnoreChanges = item.Value
 				default:
-					diagnostics = append(diagnostics, unsupportedAttribute(item.Name, item.Syntax.NameRange))
+					diagnostics = append(diagnostics, unsupportedAttribute(item.Name, item.Syntax.NameRange,
+						resourceOptionNames))
 					continue
 				}
 				if model.InputType(t).ConversionFrom(item.Value.Type()) == model.NoConversion {
-					diagnostics = append(diagnostics, model.ExprNotConvertible(model.InputType(t), item.Value))
+					diagnostics = append(diagnostics, exprNotConvertible(model.InputType(t), item.Value))
 				}
 			case *model.Block:
 				diagnostics = append(diagnostics, unsupportedBlock(item.Type, item.Syntax.TypeRange))
@@ -25,6 +25,7 @@ import (
 	"github.com/pulumi/pulumi/pkg/v2/codegen/hcl2/model"
 	"github.com/pulumi/pulumi/pkg/v2/codegen/schema"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
+	"golang.org/x/sync/errgroup"
 )
 
 type packageSchema struct {
@@ -100,45 +101,57 @@ func canonicalizeToken(tok string, pkg *schema.Package) string {
 	return fmt.Sprintf("%s:%s:%s", pkg.Name, pkg.TokenToModule(tok), member)
 }
 
-// loadReferencedPackageSchemas loads the schemas for any pacakges referenced by a given node.
-func (b *binder) loadReferencedPackageSchemas(n Node) error {
+// loadReferencedPackageSchemas loads the schemas for any packages referenced by the given nodes. The schemas are
+// loaded concurrently.
+func (b *binder) loadReferencedPackageSchemas(nodes []Node) error {
 	// TODO: package versions
 	packageNames := codegen.StringSet{}
 
-	if r, ok := n.(*Resource); ok {
-		token, tokenRange := getResourceToken(r)
-		packageName, _, _, _ := DecomposeToken(token, tokenRange)
-		if packageName != "pulumi" {
-			packageNames.Add(packageName)
+	for _, n := range nodes {
+		if r, ok := n.(*Resource); ok {
+			token, tokenRange := getResourceToken(r)
+			packageName, _, _, _ := DecomposeToken(token, tokenRange)
+			if packageName != "pulumi" {
+				packageNames.Add(packageName)
+			}
 		}
-	}
 
-	diags := hclsyntax.VisitAll(n.SyntaxNode(), func(node hclsyntax.Node) hcl.Diagnostics {
-		call, ok := node.(*hclsyntax.FunctionCallExpr)
-		if !ok {
-			return nil
-		}
-		token, tokenRange, ok := getInvokeToken(call)
-		if !ok {
+		diags := hclsyntax.VisitAll(n.SyntaxNode(), func(node hclsyntax.Node) hcl.Diagnostics {
+			call, ok := node.(*hclsyntax.FunctionCallExpr)
+			if !ok {
+				return nil
+			}
+			token, tokenRange, ok := getInvokeToken(call)
+			if !ok {
+				return nil
+			}
+			packageName, _, _, _ := DecomposeToken(token, tokenRange)
+			if packageName != "pulumi" {
+				packageNames.Add(packageName)
+			}
 			return nil
-		}
-		packageName, _, _, _ := DecomposeToken(token, tokenRange)
-		if packageName != "pulumi" {
-			packageNames.Add(packageName)
-		}
-		return nil
-	})
-	contract.Assert(len(diags) == 0)
+		})
+		contract.Assert(len(diags) == 0)
+	}
 
-	for _, name := range packageNames.SortedValues() {
-		if _, ok := b.referencedPackages[name]; ok {
-			continue
-		}
-		pkg, err := b.options.packageCache.loadPackageSchema(b.options.loader, name)
-		if err != nil {
+	names := packageNames.SortedValues()
+	packages := make([]*packageSchema, len(names))
+
+	var g errgroup.Group
+	for i, name := range names {
+		i, name := i, name
+		g.Go(func() error {
+			pkg, err := b.options.packageCache.loadPackageSchema(b.options.loader, name)
+			packages[i] = pkg
 			return err
-		}
-		b.referencedPackages[name] = pkg.schema
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return err
+	}
+
+	for i, name := range names {
+		b.referencedPackages[name] = packages[i].schema
 	}
 	return nil
 }
@@ -53,8 +53,26 @@ func unsupportedBlock(blockType string, typeRange hcl.Range) *hcl.Diagnostic {
 	return errorf(typeRange, "unsupported block of type '%v'", blockType)
 }
 
-func unsupportedAttribute(attrName string, nameRange hcl.Range) *hcl.Diagnostic {
-	return errorf(nameRange, "unsupported attribute '%v'", attrName)
+func unsupportedAttribute(attrName string, nameRange hcl.Range, candidates []string) *hcl.Diagnostic {
+	diag := errorf(nameRange, "unsupported attribute '%v'", attrName)
+	if suggestion, ok := closestName(attrName, candidates); ok {
+		diag.Detail = fmt.Sprintf("%s; did you mean '%v'?", diag.Summary, suggestion)
+	}
+	return diag
+}
+
+func exprNotConvertible(destType model.Type, expr model.Expression) *hcl.Diagnostic {
+	diag := model.ExprNotConvertible(destType, expr)
+	diag.Detail = diag.Summary
+
+	srcType := model.ResolveOutputs(expr.Type())
+	switch {
+	case isCollectionType(srcType) && destType.ConversionFrom(model.StringType).Exists():
+		diag.Detail += "; use toJSON to serialize the value to a JSON string"
+	case destType.ConversionFrom(model.NewListType(expr.Type())).Exists():
+		diag.Detail += "; wrap the value in a list, e.g. [value]"
+	}
+	return diag
 }
 
 func missingRequiredAttribute(attrName string, missingRange hcl.Range) *hcl.Diagnostic {
@@ -0,0 +1,61 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hcl2
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/pulumi/pulumi/pkg/v2/codegen"
+)
+
+// Lint checks a bound program for problems that do not prevent the program from being converted, but that are
+// likely to be mistakes. Currently, this reports config and local variables that are never referenced. Each problem
+// is reported as a warning whose detail describes how to fix it.
+func Lint(program *Program) hcl.Diagnostics {
+	referenced := codegen.Set{}
+	for _, n := range program.Nodes {
+		for _, d := range n.getDependencies() {
+			if d != n {
+				referenced.Add(d)
+			}
+		}
+	}
+
+	var diagnostics hcl.Diagnostics
+	for _, n := range program.Nodes {
+		if referenced.Has(n) {
+			continue
+		}
+
+		switch n := n.(type) {
+		case *ConfigVariable:
+			nameRange := n.syntax.DefRange()
+			if len(n.syntax.LabelRanges) > 0 {
+				nameRange = n.syntax.LabelRanges[0]
+			}
+			diagnostics = append(diagnostics, unusedVariable("config variable", n.Name(), nameRange))
+		case *LocalVariable:
+			diagnostics = append(diagnostics, unusedVariable("local variable", n.Name(), n.syntax.NameRange))
+		}
+	}
+	return diagnostics
+}
+
+func unusedVariable(kind, name string, nameRange hcl.Range) *hcl.Diagnostic {
+	diag := diagf(hcl.DiagWarning, nameRange, "%s '%s' is declared but never used", kind, name)
+	diag.Detail = fmt.Sprintf("%s; remove the declaration or reference it elsewhere in the program", diag.Summary)
+	return diag
+}
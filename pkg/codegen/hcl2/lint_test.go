@@ -0,0 +1,101 @@
+package hcl2
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/pkg/v2/codegen/hcl2/syntax"
+	"github.com/pulumi/pulumi/pkg/v2/codegen/internal/test"
+)
+
+func bindTestProgram(t *testing.T, source string) (*Program, hcl.Diagnostics) {
+	parser := syntax.NewParser()
+	err := parser.ParseFile(strings.NewReader(source), "main.pp")
+	if err != nil {
+		t.Fatalf("could not read program: %v", err)
+	}
+	if parser.Diagnostics.HasErrors() {
+		t.Fatalf("failed to parse program: %v", parser.Diagnostics)
+	}
+
+	program, diags, err := BindProgram(parser.Files, PluginHost(test.NewHost(testdataPath)), CheckResourceInputs)
+	assert.NoError(t, err)
+	return program, diags
+}
+
+func TestLintUnusedVariables(t *testing.T) {
+	program, diags := bindTestProgram(t, `config usedConfig string {}
+config unusedConfig string {}
+
+usedLocal = "${usedConfig}-suffix"
+unusedLocal = 42
+
+output out {
+	value = usedLocal
+}
+`)
+	assert.Len(t, diags, 0)
+
+	// The parser numbers lines from zero.
+	lintDiags := Lint(program)
+	if assert.Len(t, lintDiags, 2) {
+		assert.Equal(t, hcl.DiagWarning, lintDiags[0].Severity)
+		assert.Equal(t, "config variable 'unusedConfig' is declared but never used", lintDiags[0].Summary)
+		assert.Equal(t, 1, lintDiags[0].Subject.Start.Line)
+
+		assert.Equal(t, hcl.DiagWarning, lintDiags[1].Severity)
+		assert.Equal(t, "local variable 'unusedLocal' is declared but never used", lintDiags[1].Summary)
+		assert.Equal(t, 4, lintDiags[1].Subject.Start.Line)
+	}
+}
+
+func TestUnsupportedAttributeSuggestion(t *testing.T) {
+	_, diags := bindTestProgram(t, `resource pet "random:index/randomPet:RandomPet" {
+	prefx = "doggo"
+	options {
+		protec = true
+	}
+}
+`)
+	if assert.Len(t, diags, 2) {
+		assert.Equal(t, hcl.DiagWarning, diags[0].Severity)
+		assert.Equal(t, "unsupported attribute 'prefx'", diags[0].Summary)
+		assert.Equal(t, "unsupported attribute 'prefx'; did you mean 'prefix'?", diags[0].Detail)
+		assert.Equal(t, hcl.DiagError, diags[1].Severity)
+		assert.Equal(t, "unsupported attribute 'protec'", diags[1].Summary)
+		assert.Equal(t, "unsupported attribute 'protec'; did you mean 'protect'?", diags[1].Detail)
+	}
+}
+
+func TestExprNotConvertibleSuggestion(t *testing.T) {
+	_, diags := bindTestProgram(t, `resource pet "random:index/randomPet:RandomPet" {
+	prefix = { a = "b" }
+	keepers = ["a"]
+}
+`)
+	if assert.Len(t, diags, 2) {
+		assert.Equal(t, hcl.DiagWarning, diags[0].Severity)
+		assert.True(t, strings.HasSuffix(diags[0].Detail, "; use toJSON to serialize the value to a JSON string"))
+
+		assert.Equal(t, hcl.DiagWarning, diags[1].Severity)
+		assert.Equal(t, diags[1].Summary, diags[1].Detail)
+	}
+}
+
+func TestResourceInputsUncheckedByDefault(t *testing.T) {
+	parser := syntax.NewParser()
+	err := parser.ParseFile(strings.NewReader(`resource pet "random:index/randomPet:RandomPet" {
+	prefx = "doggo"
+}
+`), "main.pp")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	_, diags, err := BindProgram(parser.Files, PluginHost(test.NewHost(testdataPath)))
+	assert.NoError(t, err)
+	assert.Len(t, diags, 0)
+}
@@ -0,0 +1,47 @@
+package syntax
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormat(t *testing.T) {
+	src := `# The bucket's name.
+config   bucketName string {
+default="my-bucket" // A default name.
+}
+
+resource bucket "aws:s3:Bucket" {
+  bucket   = bucketName
+    acl = "private"
+}
+`
+	expected := `# The bucket's name.
+config bucketName string {
+  default = "my-bucket" // A default name.
+}
+
+resource bucket "aws:s3:Bucket" {
+  bucket = bucketName
+  acl    = "private"
+}
+`
+
+	formatted, diags := Format([]byte(src), "main.pp")
+	assert.Len(t, diags, 0)
+	assert.Equal(t, expected, string(formatted))
+
+	// Formatting is idempotent.
+	formatted, diags = Format(formatted, "main.pp")
+	assert.Len(t, diags, 0)
+	assert.Equal(t, expected, string(formatted))
+}
+
+func TestFormatInvalidSource(t *testing.T) {
+	src := "resource bucket \"aws:s3:Bucket\" {\n"
+
+	formatted, diags := Format([]byte(src), "main.pp")
+	assert.True(t, diags.HasErrors())
+	assert.Equal(t, src, string(formatted))
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/hcl/v2"
 	"github.com/pulumi/pulumi/pkg/v2/codegen"
 	"github.com/pulumi/pulumi/pkg/v2/codegen/hcl2/model"
+	"github.com/texttheater/golang-levenshtein/levenshtein"
 )
 
 // titleCase replaces the first character in the given string with its upper-case equivalent.
@@ -135,3 +136,29 @@ func Linearize(p *Program) []Node {
 
 	return nodes
 }
+
+// closestName returns the candidate that is closest to the given name by edit distance, if any candidate is close
+// enough to be a plausible misspelling of the name.
+func closestName(name string, candidates []string) (string, bool) {
+	const maxDistance = 2
+
+	closest, closestDistance := "", maxDistance+1
+	for _, c := range candidates {
+		distance := levenshtein.DistanceForStrings([]rune(strings.ToLower(name)), []rune(strings.ToLower(c)),
+			levenshtein.DefaultOptions)
+		if distance < closestDistance || distance == closestDistance && c < closest {
+			closest, closestDistance = c, distance
+		}
+	}
+	return closest, closestDistance <= maxDistance
+}
+
+// isCollectionType returns true if the given type is a list, map, object, or tuple type.
+func isCollectionType(t model.Type) bool {
+	switch t.(type) {
+	case *model.ListType, *model.MapType, *model.ObjectType, *model.TupleType:
+		return true
+	default:
+		return false
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"math"
 	"strings"
 
+	"github.com/hashicorp/hcl/v2"
 	"github.com/pulumi/pulumi/pkg/v2/codegen/hcl2/model"
 	"github.com/pulumi/pulumi/pkg/v2/codegen/hcl2/syntax"
 	"github.com/pulumi/pulumi/pkg/v2/codegen/schema"
@@ -36,6 +37,15 @@ var Null = &model.Variable{
 
 // GenerateHCL2Definition generates a Pulumi HCL2 definition for a given resource.
 func GenerateHCL2Definition(loader schema.Loader, state *resource.State, names NameTable) (*model.Block, error) {
+	return GenerateHCL2DefinitionWithReferences(loader, state, names, nil)
+}
+
+// GenerateHCL2DefinitionWithReferences generates a Pulumi HCL2 definition for a given resource. String input values
+// that match a value in the given reference table are generated as references to the corresponding resource property
+// if the input refers to another resource (see ReferenceTable.lookup).
+func GenerateHCL2DefinitionWithReferences(loader schema.Loader, state *resource.State, names NameTable,
+	refs ReferenceTable) (*model.Block, error) {
+
 	// TODO: pull the package version from the resource's provider
 	pkg, err := loader.LoadPackage(string(state.Type.Package()), nil)
 	if err != nil {
@@ -54,6 +64,9 @@ func GenerateHCL2Definition(loader schema.Loader, state *resource.State, names N
 			return nil, err
 		}
 		if x != nil {
+			if len(refs) != 0 {
+				x = replaceReferences(x, state.URN, p.Name, p.Type, refs)
+			}
 			items = append(items, &model.Attribute{
 				Name:  p.Name,
 				Value: x,
@@ -87,6 +100,64 @@ func newVariableReference(name string) model.Expression {
 	})
 }
 
+// newPropertyReference returns a reference to the given property of the resource with the given name.
+func newPropertyReference(name, property string) model.Expression {
+	x := &model.ScopeTraversalExpression{
+		RootName:  name,
+		Traversal: hcl.Traversal{hcl.TraverseRoot{Name: name}, hcl.TraverseAttr{Name: property}},
+		Parts: []model.Traversable{&model.Variable{
+			Name:         name,
+			VariableType: model.DynamicType,
+		}},
+	}
+	diags := x.Typecheck(false)
+	contract.Assert(len(diags) == 0)
+	return x
+}
+
+// replaceReferences replaces the string literals in the given value of the input property with the given path and type
+// of the resource with the given URN that refer to other resources with references to those resources' properties.
+func replaceReferences(x model.Expression, self resource.URN, path string, typ schema.Type,
+	refs ReferenceTable) model.Expression {
+
+	switch x := x.(type) {
+	case *model.TemplateExpression:
+		if len(x.Parts) != 1 {
+			return x
+		}
+		lit, ok := x.Parts[0].(*model.LiteralValueExpression)
+		if !ok || lit.Value.Type() != cty.String {
+			return x
+		}
+		if ref, ok := refs.lookup(self, path, typ, lit.Value.AsString()); ok {
+			return newPropertyReference(ref.Name, ref.Property)
+		}
+	case *model.TupleConsExpression:
+		if typ, ok := typ.(*schema.ArrayType); ok {
+			for i, e := range x.Expressions {
+				x.Expressions[i] = replaceReferences(e, self, path, typ.ElementType, refs)
+			}
+		}
+	case *model.ObjectConsExpression:
+		if typ, ok := typ.(*schema.ObjectType); ok {
+			for i, item := range x.Items {
+				key, ok := item.Key.(*model.LiteralValueExpression)
+				if !ok || key.Value.Type() != cty.String {
+					continue
+				}
+				if p, ok := typ.Property(key.Value.AsString()); ok {
+					x.Items[i].Value = replaceReferences(item.Value, self, path+"."+p.Name, p.Type, refs)
+				}
+			}
+		}
+	case *model.FunctionCallExpression:
+		if x.Name == "secret" && len(x.Args) == 1 {
+			x.Args[0] = replaceReferences(x.Args[0], self, path, typ, refs)
+		}
+	}
+	return x
+}
+
 func appendResourceOption(block *model.Block, name string, value model.Expression) *model.Block {
 	if block == nil {
 		block = &model.Block{
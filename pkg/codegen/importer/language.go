@@ -18,12 +18,16 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"strings"
 
+	"github.com/blang/semver"
 	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
 
 	"github.com/pulumi/pulumi/pkg/v2/codegen/hcl2"
 	"github.com/pulumi/pulumi/pkg/v2/codegen/hcl2/syntax"
 	"github.com/pulumi/pulumi/pkg/v2/codegen/schema"
+	"github.com/pulumi/pulumi/pkg/v2/resource/deploy/providers"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
 )
@@ -34,6 +38,220 @@ type LanguageGenerator func(w io.Writer, p *hcl2.Program) error
 // A NameTable maps URNs to language-specific variable names.
 type NameTable map[resource.URN]string
 
+// A Reference refers to a property that identifies a resource, such as its ID or ARN.
+type Reference struct {
+	// URN is the URN of the referenced resource.
+	URN resource.URN
+	// Name is the language-specific variable name of the referenced resource.
+	Name string
+	// Property is the name of the referenced property.
+	Property string
+}
+
+// A ReferenceTable maps the values of the properties that identify resources to references to those properties. String
+// input values that match a value in the table may be generated as references to the corresponding property.
+type ReferenceTable map[string]Reference
+
+// referenceOutputs lists the output properties other than the ID that identify a resource and may be referred to by
+// the inputs of other resources. This follows the AWS convention of exposing a resource's ARN as its `arn` output.
+var referenceOutputs = []resource.PropertyKey{"arn"}
+
+// referenceSuffixes lists the suffixes of the names of input properties that hold the IDs or ARNs of other resources.
+// This is a naming heuristic; inputs that refer to other resources under other names are generated as literals.
+var referenceSuffixes = []string{"Id", "Ids", "ID", "IDs", "Arn", "Arns", "ARN", "ARNs"}
+
+// identityProperties lists the paths of the input properties that name or identify the resource that holds them rather
+// than referring to another resource. The values of these properties are never generated as references.
+var identityProperties = map[string]bool{"id": true, "arn": true, "name": true, "metadata.name": true}
+
+// isReferenceName returns true if the given input property name indicates that the property holds the ID or ARN of
+// another resource, e.g. `id`, `vpcId` or `roleArn`.
+func isReferenceName(name string) bool {
+	if name == "id" || name == "arn" {
+		return true
+	}
+	for _, suffix := range referenceSuffixes {
+		if len(name) > len(suffix) && strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// lookup returns the reference for the given string value of the input property with the given path and type of the
+// resource with the given URN. Only string-typed properties that are not identity properties and whose names either
+// indicate that they hold an ID or ARN or name the type of the referenced resource (e.g. a `namespace` property that
+// refers to a `Namespace`) are generated as references. References from a resource to itself are never generated.
+func (refs ReferenceTable) lookup(self resource.URN, path string, typ schema.Type, value string) (Reference, bool) {
+	if typ != schema.StringType || identityProperties[path] {
+		return Reference{}, false
+	}
+	ref, ok := refs[value]
+	if !ok || ref.URN == self {
+		return Reference{}, false
+	}
+	name := path[strings.LastIndex(path, ".")+1:]
+	if !isReferenceName(name) && !strings.EqualFold(name, string(ref.URN.Type().Name())) {
+		return Reference{}, false
+	}
+	return ref, true
+}
+
+// newReferenceTable builds a reference table from the resources being imported and the existing resources in the
+// stack. Each resource is indexed by its ID and by the values of its reference outputs. Resources being imported are
+// referred to by their URN names; existing resources are only included if they are present in the given name table.
+// Values that are shared by more than one resource are omitted, as references to them would be ambiguous.
+func newReferenceTable(states, existing []*resource.State, names NameTable) ReferenceTable {
+	refs, ambiguous := ReferenceTable{}, map[string]bool{}
+	addValue := func(state *resource.State, name, property, value string) {
+		if value == "" {
+			return
+		}
+		if ref, ok := refs[value]; ok {
+			if ref.URN != state.URN {
+				ambiguous[value] = true
+			}
+			return
+		}
+		refs[value] = Reference{URN: state.URN, Name: name, Property: property}
+	}
+	add := func(state *resource.State, name string) {
+		if !state.Custom || providers.IsProviderType(state.Type) || !hclsyntax.ValidIdentifier(name) {
+			return
+		}
+		addValue(state, name, "id", string(state.ID))
+		for _, k := range referenceOutputs {
+			if v, ok := state.Outputs[k]; ok && v.IsString() {
+				addValue(state, name, string(k), v.StringValue())
+			}
+		}
+	}
+
+	imported := map[resource.URN]bool{}
+	for _, state := range states {
+		imported[state.URN] = true
+		add(state, string(state.URN.Name()))
+	}
+	for _, state := range existing {
+		if name, ok := names[state.URN]; ok && !imported[state.URN] && !state.Delete {
+			add(state, name)
+		}
+	}
+
+	for value := range ambiguous {
+		delete(refs, value)
+	}
+	return refs
+}
+
+// findReferences appends the references made by the given value of the input property with the given path and type of
+// the resource with the given URN to the given list.
+func findReferences(self resource.URN, path string, typ schema.Type, v resource.PropertyValue, refs ReferenceTable,
+	found []Reference) []Reference {
+
+	switch {
+	case v.IsString():
+		if ref, ok := refs.lookup(self, path, typ, v.StringValue()); ok {
+			found = append(found, ref)
+		}
+	case v.IsArray():
+		if typ, ok := typ.(*schema.ArrayType); ok {
+			for _, e := range v.ArrayValue() {
+				found = findReferences(self, path, typ.ElementType, e, refs, found)
+			}
+		}
+	case v.IsObject():
+		if typ, ok := typ.(*schema.ObjectType); ok {
+			obj := v.ObjectValue()
+			for _, p := range typ.Properties {
+				if e, ok := obj[resource.PropertyKey(p.Name)]; ok {
+					found = findReferences(self, path+"."+p.Name, p.Type, e, refs, found)
+				}
+			}
+		}
+	case v.IsSecret():
+		found = findReferences(self, path, typ, v.SecretValue().Element, refs, found)
+	}
+	return found
+}
+
+// resourceReferences returns the references made by the inputs of the given resource.
+func resourceReferences(loader schema.Loader, state *resource.State, refs ReferenceTable) ([]Reference, error) {
+	// TODO: pull the package version from the resource's provider
+	pkg, err := loader.LoadPackage(string(state.Type.Package()), nil)
+	if err != nil {
+		return nil, err
+	}
+	r, ok := pkg.GetResource(string(state.Type))
+	if !ok {
+		return nil, fmt.Errorf("unknown resource type '%v'", state.Type)
+	}
+
+	var found []Reference
+	for _, p := range r.InputProperties {
+		if v, ok := state.Inputs[resource.PropertyKey(p.Name)]; ok {
+			found = findReferences(state.URN, p.Name, p.Type, v, refs, found)
+		}
+	}
+	return found, nil
+}
+
+// sortStates orders the given states so that each state follows the imported states it references, keeping the
+// original order as much as possible. References that would introduce a cycle are removed from the reference table
+// returned for the referring state so that they are generated as literals instead.
+func sortStates(states []*resource.State, refs ReferenceTable,
+	references map[*resource.State][]Reference) ([]*resource.State, map[*resource.State]ReferenceTable) {
+
+	byURN := map[resource.URN]*resource.State{}
+	for _, state := range states {
+		byURN[state.URN] = state
+	}
+
+	const visiting, done = 1, 2
+	status := map[*resource.State]int{}
+	stateRefs := map[*resource.State]ReferenceTable{}
+
+	var sorted []*resource.State
+	var visit func(state *resource.State)
+	visit = func(state *resource.State) {
+		status[state] = visiting
+
+		cyclic := map[resource.URN]bool{}
+		for _, ref := range references[state] {
+			dep, ok := byURN[ref.URN]
+			if !ok || dep == state {
+				continue
+			}
+			switch status[dep] {
+			case visiting:
+				cyclic[ref.URN] = true
+			case 0:
+				visit(dep)
+			}
+		}
+
+		stateRefs[state] = refs
+		if len(cyclic) != 0 {
+			acyclic := ReferenceTable{}
+			for value, ref := range refs {
+				if !cyclic[ref.URN] {
+					acyclic[value] = ref
+				}
+			}
+			stateRefs[state] = acyclic
+		}
+
+		status[state] = done
+		sorted = append(sorted, state)
+	}
+	for _, state := range states {
+		if status[state] == 0 {
+			visit(state)
+		}
+	}
+	return sorted, stateRefs
+}
+
 // A DiagnosticsError captures HCL2 diagnostics.
 type DiagnosticsError struct {
 	diagnostics         hcl.Diagnostics
@@ -64,9 +282,41 @@ func (e *DiagnosticsError) String() string {
 func GenerateLanguageDefinitions(w io.Writer, loader schema.Loader, gen LanguageGenerator, states []*resource.State,
 	names NameTable) error {
 
+	return GenerateLanguageDefinitionsWithReferences(w, loader, gen, states, nil, names)
+}
+
+// GenerateLanguageDefinitionsWithReferences generates a list of resource definitions from the given resource states.
+// String input values that match the ID or ARN of another resource in the list or of an existing resource that is
+// present in the name table are generated as references to that resource's property if the input's name indicates
+// that it refers to another resource, and the definitions are ordered so that each resource follows the resources it
+// references. The matching is heuristic: see referenceOutputs, referenceSuffixes and identityProperties.
+func GenerateLanguageDefinitionsWithReferences(w io.Writer, loader schema.Loader, gen LanguageGenerator, states,
+	existing []*resource.State, names NameTable) error {
+
+	// Load the schemas for all of the referenced packages up front so that they are loaded concurrently.
+	// TODO: pull the package versions from the resources' providers
+	packages := map[string]*semver.Version{}
+	for _, state := range states {
+		packages[string(state.Type.Package())] = nil
+	}
+	if _, err := schema.LoadPackages(loader, packages); err != nil {
+		return err
+	}
+
+	refs := newReferenceTable(states, existing, names)
+	references := map[*resource.State][]Reference{}
+	for _, state := range states {
+		found, err := resourceReferences(loader, state, refs)
+		if err != nil {
+			return err
+		}
+		references[state] = found
+	}
+	states, stateRefs := sortStates(states, refs, references)
+
 	var hcl2Text bytes.Buffer
 	for i, state := range states {
-		hcl2Def, err := GenerateHCL2Definition(loader, state, names)
+		hcl2Def, err := GenerateHCL2DefinitionWithReferences(loader, state, names, stateRefs[state])
 		if err != nil {
 			return err
 		}
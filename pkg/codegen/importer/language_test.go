@@ -21,11 +21,13 @@ import (
 	"testing"
 
 	"github.com/pulumi/pulumi/pkg/v2/codegen/hcl2"
+	"github.com/pulumi/pulumi/pkg/v2/codegen/hcl2/model"
 	"github.com/pulumi/pulumi/pkg/v2/codegen/internal/test"
 	"github.com/pulumi/pulumi/pkg/v2/codegen/schema"
 	"github.com/pulumi/pulumi/pkg/v2/resource/stack"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/config"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/tokens"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
 	"github.com/stretchr/testify/assert"
 )
@@ -83,3 +85,178 @@ func TestGenerateLanguageDefinition(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateLanguageDefinitionsReferences(t *testing.T) {
+	loader := schema.NewPluginLoader(test.NewHost(testdataPath))
+
+	newState := func(typ, name, id string, inputs resource.PropertyMap) *resource.State {
+		return &resource.State{
+			Type:   tokens.Type(typ),
+			URN:    resource.NewURN("stack", "project", "", tokens.Type(typ), tokens.QName(name)),
+			Custom: true,
+			ID:     resource.ID(id),
+			Inputs: inputs,
+		}
+	}
+
+	metadata := func(name, namespace string) resource.PropertyMap {
+		meta := resource.PropertyMap{"name": resource.NewStringProperty(name)}
+		if namespace != "" {
+			meta["namespace"] = resource.NewStringProperty(namespace)
+		}
+		return resource.PropertyMap{"metadata": resource.NewObjectProperty(meta)}
+	}
+
+	namespace := newState("kubernetes:core/v1:Namespace", "namespace", "apps", metadata("apps", ""))
+	configMap := newState("kubernetes:core/v1:ConfigMap", "configMap", "apps/config", metadata("config", "apps"))
+	existing := newState("kubernetes:core/v1:Namespace", "existing", "system", metadata("system", ""))
+	secret := newState("kubernetes:core/v1:Secret", "secret", "system/secret", metadata("secret", "system"))
+
+	// The deployment's name matches the namespace's ID, but a resource's own name never refers to another resource.
+	deployment := newState("kubernetes:apps/v1:Deployment", "deployment", "apps/apps", metadata("apps", "apps"))
+
+	existingNames := NameTable{existing.URN: "systemNamespace"}
+	for k, v := range names {
+		existingNames[k] = v
+	}
+
+	// The config map refers to the namespace, so the namespace must be generated first. The secret refers to an
+	// existing resource in the name table.
+	err := GenerateLanguageDefinitionsWithReferences(ioutil.Discard, loader, func(_ io.Writer, p *hcl2.Program) error {
+		if !assert.Len(t, p.Nodes, 4) {
+			t.Fatal()
+		}
+		assert.Equal(t, "namespace", p.Nodes[0].Name())
+		assert.Equal(t, "configMap", p.Nodes[1].Name())
+		assert.Equal(t, "secret", p.Nodes[2].Name())
+		assert.Equal(t, "deployment", p.Nodes[3].Name())
+
+		metadataItem := func(node hcl2.Node, name string) model.Expression {
+			for _, attr := range node.(*hcl2.Resource).Inputs {
+				if attr.Name != "metadata" {
+					continue
+				}
+				for _, item := range attr.Value.(*model.ObjectConsExpression).Items {
+					if key, ok := item.Key.(*model.LiteralValueExpression); ok && key.Value.AsString() == name {
+						return item.Value
+					}
+				}
+			}
+			assert.Failf(t, "missing metadata item", "metadata.%v", name)
+			return nil
+		}
+		assertReference := func(node hcl2.Node, root string) {
+			x, ok := metadataItem(node, "namespace").(*model.ScopeTraversalExpression)
+			if assert.True(t, ok, "expected a reference") {
+				assert.Equal(t, root, x.RootName)
+				assert.Len(t, x.Traversal, 2)
+			}
+		}
+		assertReference(p.Nodes[1], "namespace")
+		assertReference(p.Nodes[2], "systemNamespace")
+		assertReference(p.Nodes[3], "namespace")
+
+		_, isTemplate := metadataItem(p.Nodes[3], "name").(*model.TemplateExpression)
+		assert.True(t, isTemplate, "expected metadata.name to be a literal")
+		return nil
+	}, []*resource.State{configMap, secret, deployment, namespace}, []*resource.State{existing}, existingNames)
+	assert.NoError(t, err)
+}
+
+func TestSortStatesBreaksCycles(t *testing.T) {
+	a := &resource.State{
+		URN:    resource.NewURN("stack", "project", "", "pkg:index:T", "a"),
+		Custom: true,
+		ID:     "id-a",
+		Inputs: resource.PropertyMap{"otherId": resource.NewStringProperty("id-b")},
+	}
+	b := &resource.State{
+		URN:    resource.NewURN("stack", "project", "", "pkg:index:T", "b"),
+		Custom: true,
+		ID:     "id-b",
+		Inputs: resource.PropertyMap{"otherId": resource.NewStringProperty("id-a")},
+	}
+
+	refs := newReferenceTable([]*resource.State{a, b}, nil, nil)
+	refA, refB := refs["id-a"], refs["id-b"]
+	assert.Equal(t, ReferenceTable{"id-a": {URN: a.URN, Name: "a", Property: "id"},
+		"id-b": {URN: b.URN, Name: "b", Property: "id"}}, refs)
+
+	references := map[*resource.State][]Reference{}
+	for _, state := range []*resource.State{a, b} {
+		references[state] = findReferences(state.URN, "otherId", schema.StringType, state.Inputs["otherId"], refs, nil)
+	}
+	assert.Equal(t, []Reference{refB}, references[a])
+	assert.Equal(t, []Reference{refA}, references[b])
+
+	sorted, stateRefs := sortStates([]*resource.State{a, b}, refs, references)
+	assert.Equal(t, []*resource.State{b, a}, sorted)
+
+	// b is visited while a is in progress, so its reference to a is dropped and a keeps its reference to b.
+	assert.Equal(t, ReferenceTable{"id-b": refB}, stateRefs[b])
+	assert.Equal(t, refs, stateRefs[a])
+}
+
+func TestNewReferenceTableAmbiguousIDs(t *testing.T) {
+	a := &resource.State{URN: resource.NewURN("stack", "project", "", "pkg:index:A", "a"), Custom: true, ID: "shared"}
+	b := &resource.State{URN: resource.NewURN("stack", "project", "", "pkg:index:B", "b"), Custom: true, ID: "shared"}
+	c := &resource.State{URN: resource.NewURN("stack", "project", "", "pkg:index:C", "c"), Custom: true, ID: "unique"}
+
+	refs := newReferenceTable([]*resource.State{a, c}, []*resource.State{b}, NameTable{b.URN: "b"})
+	assert.Equal(t, ReferenceTable{"unique": {URN: c.URN, Name: "c", Property: "id"}}, refs)
+}
+
+func TestNewReferenceTableOutputs(t *testing.T) {
+	role := &resource.State{
+		URN:    resource.NewURN("stack", "project", "", "aws:iam/role:Role", "role"),
+		Custom: true,
+		ID:     "role",
+		Outputs: resource.PropertyMap{
+			"arn":  resource.NewStringProperty("arn:aws:iam::123456789012:role/role"),
+			"name": resource.NewStringProperty("role"),
+		},
+	}
+
+	refs := newReferenceTable([]*resource.State{role}, nil, nil)
+	assert.Equal(t, ReferenceTable{
+		"role":                                {URN: role.URN, Name: "role", Property: "id"},
+		"arn:aws:iam::123456789012:role/role": {URN: role.URN, Name: "role", Property: "arn"},
+	}, refs)
+}
+
+func TestReferenceTableLookup(t *testing.T) {
+	self := resource.NewURN("stack", "project", "", "aws:lambda/function:Function", "function")
+	role := resource.NewURN("stack", "project", "", "aws:iam/role:Role", "role")
+	namespace := resource.NewURN("stack", "project", "", "kubernetes:core/v1:Namespace", "namespace")
+
+	const arn = "arn:aws:iam::123456789012:role/role"
+	refs := ReferenceTable{
+		arn:    {URN: role, Name: "role", Property: "arn"},
+		"apps": {URN: namespace, Name: "namespace", Property: "id"},
+		"self": {URN: self, Name: "function", Property: "id"},
+	}
+
+	cases := []struct {
+		path     string
+		typ      schema.Type
+		value    string
+		expected bool
+	}{
+		{"role", schema.StringType, arn, true},
+		{"policy", schema.StringType, arn, false},
+		{"roleArn", schema.StringType, arn, true},
+		{"roleArn", schema.AnyType, arn, false},
+		{"arn", schema.StringType, arn, false},
+		{"config.executionRoleArn", schema.StringType, arn, true},
+		{"namespace", schema.StringType, "apps", true},
+		{"metadata.namespace", schema.StringType, "apps", true},
+		{"metadata.name", schema.StringType, "apps", false},
+		{"name", schema.StringType, "apps", false},
+		{"description", schema.StringType, "apps", false},
+		{"sourceId", schema.StringType, "self", false},
+	}
+	for _, c := range cases {
+		_, ok := refs.lookup(self, c.path, c.typ, c.value)
+		assert.Equal(t, c.expected, ok, "%v = %q", c.path, c.value)
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"encoding/json"
+
+	"github.com/pulumi/pulumi/pkg/v2/codegen/schema"
+)
+
+// TerraformResourceInfo represents the Terraform-specific info for a resource. Providers that are derived from
+// Terraform providers supply this info so that resources managed by Terraform can be imported into Pulumi.
+type TerraformResourceInfo struct {
+	// ResourceType is the Terraform resource type that corresponds to the resource, e.g. aws_s3_bucket.
+	ResourceType string `json:"resourceType,omitempty"`
+	// IDAttribute is the name of the attribute of the Terraform resource that holds the resource's import ID.
+	// Defaults to id.
+	IDAttribute string `json:"idAttribute,omitempty"`
+}
+
+// Importer implements schema.Language for Terraform.
+var Importer schema.Language = importer(0)
+
+type importer int
+
+// ImportDefaultSpec decodes language-specific metadata associated with a DefaultValue.
+func (importer) ImportDefaultSpec(def *schema.DefaultValue, raw json.RawMessage) (interface{}, error) {
+	return raw, nil
+}
+
+// ImportPropertySpec decodes language-specific metadata associated with a Property.
+func (importer) ImportPropertySpec(property *schema.Property, raw json.RawMessage) (interface{}, error) {
+	return raw, nil
+}
+
+// ImportObjectTypeSpec decodes language-specific metadata associated with a ObjectType.
+func (importer) ImportObjectTypeSpec(object *schema.ObjectType, raw json.RawMessage) (interface{}, error) {
+	return raw, nil
+}
+
+// ImportResourceSpec decodes language-specific metadata associated with a Resource.
+func (importer) ImportResourceSpec(resource *schema.Resource, raw json.RawMessage) (interface{}, error) {
+	var info TerraformResourceInfo
+	if err := json.Unmarshal([]byte(raw), &info); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// ImportFunctionSpec decodes language-specific metadata associated with a Function.
+func (importer) ImportFunctionSpec(function *schema.Function, raw json.RawMessage) (interface{}, error) {
+	return raw, nil
+}
+
+// ImportPackageSpec decodes language-specific metadata associated with a Package.
+func (importer) ImportPackageSpec(pkg *schema.Package, raw json.RawMessage) (interface{}, error) {
+	return raw, nil
+}
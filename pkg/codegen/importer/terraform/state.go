@@ -0,0 +1,304 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package terraform maps the resources recorded in a Terraform state file to Pulumi resources that can be imported
+// into a stack.
+package terraform
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/pkg/v2/codegen/schema"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/tokens"
+)
+
+// State is the subset of a Terraform state file that is needed to import the resources it records. Only version 4 of
+// the state format, which is used by Terraform 0.12 and later, is supported.
+type State struct {
+	Version   int             `json:"version"`
+	Resources []StateResource `json:"resources"`
+}
+
+// StateResource is a resource recorded in a Terraform state file. Resources that use count or for_each have one
+// instance per index.
+type StateResource struct {
+	Module    string          `json:"module,omitempty"`
+	Mode      string          `json:"mode"`
+	Type      string          `json:"type"`
+	Name      string          `json:"name"`
+	Provider  string          `json:"provider"`
+	Instances []StateInstance `json:"instances"`
+}
+
+// StateInstance is a single instance of a Terraform resource.
+type StateInstance struct {
+	IndexKey   interface{}            `json:"index_key,omitempty"`
+	Attributes map[string]interface{} `json:"attributes"`
+}
+
+// ReadState reads a Terraform state file. Numeric attribute values are decoded as json.Number so that they keep their
+// original formatting.
+func ReadState(r io.Reader) (*State, error) {
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+
+	var state State
+	if err := decoder.Decode(&state); err != nil {
+		return nil, errors.Wrap(err, "could not decode Terraform state")
+	}
+	if state.Version != 4 {
+		return nil, errors.Errorf("unsupported Terraform state version %v; only version 4 is supported", state.Version)
+	}
+	return &state, nil
+}
+
+// ProviderName returns the name of the Terraform provider that manages the resource, e.g. aws for a resource whose
+// provider is `provider["registry.terraform.io/hashicorp/aws"]`.
+func (r *StateResource) ProviderName() string {
+	provider := r.Provider
+	if i := strings.Index(provider, "provider["); i != -1 {
+		// provider["registry.terraform.io/hashicorp/aws"], optionally followed by an alias.
+		provider = provider[i+len("provider["):]
+		if end := strings.Index(provider, "]"); end != -1 {
+			provider = provider[:end]
+		}
+		if name, err := strconv.Unquote(provider); err == nil {
+			provider = name
+		}
+		return provider[strings.LastIndex(provider, "/")+1:]
+	}
+	if i := strings.Index(provider, "provider."); i != -1 {
+		// The legacy form, provider.aws, optionally followed by an alias.
+		provider = provider[i+len("provider."):]
+		if end := strings.Index(provider, "."); end != -1 {
+			provider = provider[:end]
+		}
+		return provider
+	}
+	// Fall back to the prefix of the resource type.
+	return strings.SplitN(r.Type, "_", 2)[0]
+}
+
+// Address returns the Terraform address of the given instance of the resource, e.g. module.foo.aws_s3_bucket.b[0].
+func (r *StateResource) Address(instance StateInstance) string {
+	address := r.Type + "." + r.Name
+	if r.Mode == "data" {
+		address = "data." + address
+	}
+	if r.Module != "" {
+		address = r.Module + "." + address
+	}
+	switch key := instance.IndexKey.(type) {
+	case nil:
+		return address
+	case string:
+		return fmt.Sprintf("%s[%q]", address, key)
+	default:
+		return fmt.Sprintf("%s[%v]", address, key)
+	}
+}
+
+// Resource is a resource to import that was mapped from a Terraform state file.
+type Resource struct {
+	// Type is the Pulumi type of the resource.
+	Type tokens.Type
+	// Name is the name of the resource. Names are derived from the Terraform module path, resource name and index, and
+	// are unique within a single state file.
+	Name tokens.QName
+	// ID is the import ID of the resource.
+	ID resource.ID
+	// Address is the Terraform address of the resource.
+	Address string
+}
+
+// SkippedResource is a resource in a Terraform state file that could not be mapped to a Pulumi resource.
+type SkippedResource struct {
+	// Address is the Terraform address of the resource.
+	Address string
+	// Reason describes why the resource could not be mapped.
+	Reason string
+}
+
+// DefaultPackageNames maps the names of Terraform providers to the names of the Pulumi packages derived from them
+// where the two differ.
+var DefaultPackageNames = map[string]string{
+	"azurerm":     "azure",
+	"google":      "gcp",
+	"google-beta": "gcp",
+}
+
+// PackageName returns the name of the Pulumi package that corresponds to the named Terraform provider. The mapping in
+// packageNames takes precedence over DefaultPackageNames. Providers that appear in neither correspond to the package
+// with the same name.
+func PackageName(providerName string, packageNames map[string]string) string {
+	if name, ok := packageNames[providerName]; ok {
+		return name
+	}
+	if name, ok := DefaultPackageNames[providerName]; ok {
+		return name
+	}
+	return providerName
+}
+
+// MapState maps the managed resources recorded in a Terraform state to Pulumi resources. The schema for each
+// Terraform provider referenced by the state is loaded from the corresponding Pulumi package, as determined by
+// PackageName using the given mapping from provider names to package names. Each resource in a package's schema that
+// corresponds to a Terraform resource type records that type in its Terraform language metadata, e.g.
+//
+//     "language": {
+//         "terraform": {
+//             "resourceType": "aws_s3_bucket"
+//         }
+//     }
+//
+// Resources that cannot be mapped are returned in the list of skipped resources along with the reason.
+func MapState(state *State, loader schema.Loader,
+	packageNames map[string]string) ([]Resource, []SkippedResource, error) {
+
+	mappings := map[string]*resourceMappings{}
+	names := map[tokens.QName]int{}
+
+	var resources []Resource
+	var skipped []SkippedResource
+	for _, r := range state.Resources {
+		if r.Mode != "managed" {
+			continue
+		}
+
+		packageName := PackageName(r.ProviderName(), packageNames)
+		mapping, ok := mappings[packageName]
+		if !ok {
+			m, err := loadResourceMappings(packageName, loader)
+			if err != nil {
+				return nil, nil, err
+			}
+			mappings[packageName], mapping = m, m
+		}
+
+		for _, instance := range r.Instances {
+			address := r.Address(instance)
+			if mapping.err != nil {
+				skipped = append(skipped, SkippedResource{
+					Address: address,
+					Reason:  fmt.Sprintf("could not load the schema for package '%v': %v", packageName, mapping.err),
+				})
+				continue
+			}
+
+			res, ok := mapping.resources[r.Type]
+			if !ok {
+				skipped = append(skipped, SkippedResource{
+					Address: address,
+					Reason:  fmt.Sprintf("package '%v' has no resource for Terraform type '%v'", packageName, r.Type),
+				})
+				continue
+			}
+
+			idAttribute := res.info.IDAttribute
+			if idAttribute == "" {
+				idAttribute = "id"
+			}
+			id, ok := instance.Attributes[idAttribute]
+			if !ok || id == nil || id == "" {
+				skipped = append(skipped, SkippedResource{
+					Address: address,
+					Reason:  fmt.Sprintf("the resource has no value for its ID attribute '%v'", idAttribute),
+				})
+				continue
+			}
+
+			resources = append(resources, Resource{
+				Type:    tokens.Type(res.resource.Token),
+				Name:    uniqueName(resourceName(&r, instance), names),
+				ID:      resource.ID(formatAttribute(id)),
+				Address: address,
+			})
+		}
+	}
+
+	return resources, skipped, nil
+}
+
+type resourceMapping struct {
+	resource *schema.Resource
+	info     TerraformResourceInfo
+}
+
+type resourceMappings struct {
+	resources map[string]resourceMapping
+	err       error
+}
+
+// loadResourceMappings loads the schema for the named package and indexes its resources by Terraform resource type.
+// A package that cannot be loaded results in a set of mappings that records the error; an error is only returned
+// if the package's Terraform metadata is malformed.
+func loadResourceMappings(name string, loader schema.Loader) (*resourceMappings, error) {
+	pkg, err := loader.LoadPackage(name, nil)
+	if err != nil {
+		return &resourceMappings{err: err}, nil
+	}
+	if err = pkg.ImportLanguages(map[string]schema.Language{"terraform": Importer}); err != nil {
+		return nil, errors.Wrapf(err, "could not read the Terraform metadata for package '%v'", name)
+	}
+
+	mappings := &resourceMappings{resources: map[string]resourceMapping{}}
+	for _, r := range pkg.Resources {
+		if info, ok := r.Language["terraform"].(TerraformResourceInfo); ok && info.ResourceType != "" {
+			mappings.resources[info.ResourceType] = resourceMapping{resource: r, info: info}
+		}
+	}
+	return mappings, nil
+}
+
+// formatAttribute formats the given attribute value as a string. Floating point numbers are formatted without
+// exponents so that large numeric IDs such as 123456789 are not rendered as 1.23456789e+08.
+func formatAttribute(v interface{}) string {
+	if f, ok := v.(float64); ok {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// resourceName returns the Pulumi name for the given instance of a Terraform resource. The name is made up of the
+// module path, the resource's name and the instance's index key, e.g. module.foo.aws_s3_bucket.b[0] is named foo-b-0.
+func resourceName(r *StateResource, instance StateInstance) string {
+	var components []string
+	for _, c := range strings.Split(r.Module, ".") {
+		if c != "" && c != "module" {
+			components = append(components, strings.Trim(c, `[]"`))
+		}
+	}
+	components = append(components, r.Name)
+	if instance.IndexKey != nil {
+		components = append(components, fmt.Sprintf("%v", instance.IndexKey))
+	}
+	return strings.Join(components, "-")
+}
+
+// uniqueName returns the given name if it has not been used, or the name with a numeric suffix otherwise.
+func uniqueName(name string, names map[tokens.QName]int) tokens.QName {
+	n := names[tokens.QName(name)]
+	names[tokens.QName(name)] = n + 1
+	if n == 0 {
+		return tokens.QName(name)
+	}
+	return uniqueName(fmt.Sprintf("%s-%d", name, n+1), names)
+}
@@ -0,0 +1,228 @@
+package terraform
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/blang/semver"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/pkg/v2/codegen/schema"
+)
+
+type testLoader map[string]*schema.Package
+
+func (l testLoader) LoadPackage(name string, version *semver.Version) (*schema.Package, error) {
+	if pkg, ok := l[name]; ok {
+		return pkg, nil
+	}
+	return nil, errors.Errorf("unknown package '%v'", name)
+}
+
+func terraformResource(resourceType string, idAttribute string) schema.ResourceSpec {
+	info, err := json.Marshal(TerraformResourceInfo{ResourceType: resourceType, IDAttribute: idAttribute})
+	if err != nil {
+		panic(err)
+	}
+	return schema.ResourceSpec{
+		Language: map[string]json.RawMessage{"terraform": info},
+	}
+}
+
+func newTestLoader(t *testing.T) testLoader {
+	pkg, err := schema.ImportSpec(schema.PackageSpec{
+		Name: "aws",
+		Resources: map[string]schema.ResourceSpec{
+			"aws:s3/bucket:Bucket":          terraformResource("aws_s3_bucket", ""),
+			"aws:ec2/vpc:Vpc":               terraformResource("aws_vpc", ""),
+			"aws:iam/rolePolicy:RolePolicy": terraformResource("aws_iam_role_policy", "name"),
+			"aws:ec2/instance:Instance":     {},
+		},
+	}, nil)
+	if err != nil {
+		t.Fatalf("could not import the test package: %v", err)
+	}
+	return testLoader{"aws": pkg}
+}
+
+const testState = `{
+  "version": 4,
+  "terraform_version": "0.13.5",
+  "resources": [
+    {
+      "mode": "managed",
+      "type": "aws_s3_bucket",
+      "name": "logs",
+      "provider": "provider[\"registry.terraform.io/hashicorp/aws\"]",
+      "instances": [{"attributes": {"id": "my-logs-bucket"}}]
+    },
+    {
+      "mode": "data",
+      "type": "aws_ami",
+      "name": "ubuntu",
+      "provider": "provider[\"registry.terraform.io/hashicorp/aws\"]",
+      "instances": [{"attributes": {"id": "ami-123"}}]
+    },
+    {
+      "module": "module.network",
+      "mode": "managed",
+      "type": "aws_vpc",
+      "name": "main",
+      "provider": "provider[\"registry.terraform.io/hashicorp/aws\"].west",
+      "instances": [
+        {"index_key": 0, "attributes": {"id": "vpc-0"}},
+        {"index_key": 1, "attributes": {"id": "vpc-1"}}
+      ]
+    },
+    {
+      "mode": "managed",
+      "type": "aws_iam_role_policy",
+      "name": "logs",
+      "provider": "provider.aws",
+      "instances": [{"index_key": "reader", "attributes": {"id": "role:reader", "name": "reader"}}]
+    },
+    {
+      "mode": "managed",
+      "type": "aws_instance",
+      "name": "web",
+      "provider": "provider[\"registry.terraform.io/hashicorp/aws\"]",
+      "instances": [{"attributes": {"id": "i-123"}}]
+    },
+    {
+      "mode": "managed",
+      "type": "google_storage_bucket",
+      "name": "logs",
+      "provider": "provider[\"registry.terraform.io/hashicorp/google\"]",
+      "instances": [{"attributes": {"id": "logs"}}]
+    }
+  ]
+}`
+
+func TestMapState(t *testing.T) {
+	state, err := ReadState(strings.NewReader(testState))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	resources, skipped, err := MapState(state, newTestLoader(t), nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, []Resource{
+		{Type: "aws:s3/bucket:Bucket", Name: "logs", ID: "my-logs-bucket", Address: "aws_s3_bucket.logs"},
+		{Type: "aws:ec2/vpc:Vpc", Name: "network-main-0", ID: "vpc-0", Address: "module.network.aws_vpc.main[0]"},
+		{Type: "aws:ec2/vpc:Vpc", Name: "network-main-1", ID: "vpc-1", Address: "module.network.aws_vpc.main[1]"},
+		{
+			Type:    "aws:iam/rolePolicy:RolePolicy",
+			Name:    "logs-reader",
+			ID:      "reader",
+			Address: `aws_iam_role_policy.logs["reader"]`,
+		},
+	}, resources)
+
+	if assert.Len(t, skipped, 2) {
+		assert.Equal(t, "aws_instance.web", skipped[0].Address)
+		assert.Equal(t, "package 'aws' has no resource for Terraform type 'aws_instance'", skipped[0].Reason)
+		assert.Equal(t, "google_storage_bucket.logs", skipped[1].Address)
+		assert.Contains(t, skipped[1].Reason, "could not load the schema for package 'gcp'")
+	}
+}
+
+func TestMapStatePackageNames(t *testing.T) {
+	state := &State{
+		Version: 4,
+		Resources: []StateResource{
+			{
+				Mode:      "managed",
+				Type:      "awscc_s3_bucket",
+				Name:      "b",
+				Provider:  `provider["registry.terraform.io/hashicorp/awscc"]`,
+				Instances: []StateInstance{{Attributes: map[string]interface{}{"id": "bucket"}}},
+			},
+		},
+	}
+
+	// Without a mapping, the provider's resources are looked up in the package with the same name.
+	resources, skipped, err := MapState(state, newTestLoader(t), nil)
+	assert.NoError(t, err)
+	assert.Len(t, resources, 0)
+	if assert.Len(t, skipped, 1) {
+		assert.Contains(t, skipped[0].Reason, "could not load the schema for package 'awscc'")
+	}
+
+	// With a mapping, they are looked up in the mapped package.
+	resources, skipped, err = MapState(state, newTestLoader(t), map[string]string{"awscc": "aws"})
+	assert.NoError(t, err)
+	assert.Len(t, resources, 0)
+	if assert.Len(t, skipped, 1) {
+		assert.Equal(t, "package 'aws' has no resource for Terraform type 'awscc_s3_bucket'", skipped[0].Reason)
+	}
+}
+
+func TestPackageName(t *testing.T) {
+	assert.Equal(t, "aws", PackageName("aws", nil))
+	assert.Equal(t, "gcp", PackageName("google", nil))
+	assert.Equal(t, "azure", PackageName("azurerm", nil))
+	assert.Equal(t, "google-native", PackageName("google", map[string]string{"google": "google-native"}))
+}
+
+func TestMapStateUniqueNames(t *testing.T) {
+	state := &State{
+		Version: 4,
+		Resources: []StateResource{
+			{Mode: "managed", Type: "aws_s3_bucket", Name: "b", Instances: []StateInstance{
+				{IndexKey: "x", Attributes: map[string]interface{}{"id": "bucket-x"}},
+			}},
+			{Mode: "managed", Type: "aws_s3_bucket", Name: "b-x", Instances: []StateInstance{
+				{Attributes: map[string]interface{}{"id": "bucket-b-x"}},
+			}},
+		},
+	}
+
+	resources, skipped, err := MapState(state, newTestLoader(t), nil)
+	assert.NoError(t, err)
+	assert.Len(t, skipped, 0)
+	if assert.Len(t, resources, 2) {
+		assert.Equal(t, "b-x", string(resources[0].Name))
+		assert.Equal(t, "b-x-2", string(resources[1].Name))
+	}
+}
+
+func TestMapStateNumericIDs(t *testing.T) {
+	state, err := ReadState(strings.NewReader(`{
+  "version": 4,
+  "resources": [{
+    "mode": "managed",
+    "type": "aws_iam_role_policy",
+    "name": "decoded",
+    "provider": "provider[\"registry.terraform.io/hashicorp/aws\"]",
+    "instances": [{"attributes": {"name": 123456789}}]
+  }]
+}`))
+	if !assert.NoError(t, err) {
+		t.Fatal()
+	}
+
+	// States that are built in memory may hold numeric attributes as float64s.
+	state.Resources = append(state.Resources, StateResource{
+		Mode: "managed", Type: "aws_iam_role_policy", Name: "built", Instances: []StateInstance{
+			{Attributes: map[string]interface{}{"name": float64(987654321)}},
+		},
+	})
+
+	resources, skipped, err := MapState(state, newTestLoader(t), nil)
+	assert.NoError(t, err)
+	assert.Len(t, skipped, 0)
+	if assert.Len(t, resources, 2) {
+		assert.Equal(t, "123456789", string(resources[0].ID))
+		assert.Equal(t, "987654321", string(resources[1].ID))
+	}
+}
+
+func TestReadStateUnsupportedVersion(t *testing.T) {
+	_, err := ReadState(strings.NewReader(`{"version": 3, "modules": []}`))
+	assert.EqualError(t, err, "unsupported Terraform state version 3; only version 4 is supported")
+}
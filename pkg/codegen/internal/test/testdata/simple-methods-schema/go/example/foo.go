@@ -0,0 +1,122 @@
+// *** WARNING: this file was generated by test. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package example
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+type Foo struct {
+	pulumi.ResourceState
+}
+
+// NewFoo registers a new resource with the given unique name, arguments, and options.
+func NewFoo(ctx *pulumi.Context,
+	name string, args *FooArgs, opts ...pulumi.ResourceOption) (*Foo, error) {
+	if args == nil {
+		args = &FooArgs{}
+	}
+
+	var resource Foo
+	err := ctx.RegisterRemoteComponentResource("example::Foo", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+type fooArgs struct {
+}
+
+// The set of arguments for constructing a Foo resource.
+type FooArgs struct {
+}
+
+func (FooArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*fooArgs)(nil)).Elem()
+}
+
+type FooInput interface {
+	pulumi.Input
+
+	ToFooOutput() FooOutput
+	ToFooOutputWithContext(ctx context.Context) FooOutput
+}
+
+func (Foo) ElementType() reflect.Type {
+	return reflect.TypeOf((*Foo)(nil)).Elem()
+}
+
+func (i Foo) ToFooOutput() FooOutput {
+	return i.ToFooOutputWithContext(context.Background())
+}
+
+func (i Foo) ToFooOutputWithContext(ctx context.Context) FooOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FooOutput)
+}
+
+type FooOutput struct {
+	*pulumi.OutputState
+}
+
+func (FooOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*FooOutput)(nil)).Elem()
+}
+
+func (o FooOutput) ToFooOutput() FooOutput {
+	return o
+}
+
+func (o FooOutput) ToFooOutputWithContext(ctx context.Context) FooOutput {
+	return o
+}
+
+// Returns a value computed by the component.
+func (r *Foo) Bar(ctx *pulumi.Context, args *FooBarArgs) (FooBarResultOutput, error) {
+	out, err := ctx.Call("example::Foo/bar", args, FooBarResultOutput{}, r)
+	if err != nil {
+		return FooBarResultOutput{}, err
+	}
+	return out.(FooBarResultOutput), nil
+}
+
+type fooBarArgs struct {
+	Prefix *string `pulumi:"prefix"`
+}
+
+// The set of arguments for the Bar method of the Foo resource.
+type FooBarArgs struct {
+	Prefix pulumi.StringPtrInput
+}
+
+func (FooBarArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*fooBarArgs)(nil)).Elem()
+}
+
+type FooBarResult struct {
+	SomeValue string `pulumi:"someValue"`
+}
+
+type FooBarResultOutput struct{ *pulumi.OutputState }
+
+func (FooBarResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*FooBarResult)(nil)).Elem()
+}
+
+func (o FooBarResultOutput) SomeValue() pulumi.StringOutput {
+	return o.ApplyT(func(v FooBarResult) string { return v.SomeValue }).(pulumi.StringOutput)
+}
+
+func (r *Foo) Baz(ctx *pulumi.Context) error {
+	_, err := ctx.Call("example::Foo/baz", nil, nil, r)
+	return err
+}
+
+func init() {
+	pulumi.RegisterOutputType(FooOutput{})
+	pulumi.RegisterOutputType(FooBarResultOutput{})
+}
@@ -0,0 +1,82 @@
+// *** WARNING: this file was generated by test. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+export class Foo extends pulumi.ComponentResource {
+    /** @internal */
+    public static readonly __pulumiType = 'example::Foo';
+
+    /**
+     * Returns true if the given object is an instance of Foo.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is Foo {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === Foo.__pulumiType;
+    }
+
+
+    /**
+     * Create a Foo resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args?: FooArgs, opts?: pulumi.ComponentResourceOptions) {
+        let inputs: pulumi.Inputs = {};
+        if (!(opts && opts.id)) {
+        } else {
+        }
+        if (!opts) {
+            opts = {}
+        }
+
+        if (!opts.version) {
+            opts.version = utilities.getVersion();
+        }
+        super(Foo.__pulumiType, name, inputs, opts, true /*remote*/);
+    }
+
+    /**
+     * Returns a value computed by the component.
+     */
+    bar(args?: Foo.BarArgs): pulumi.Output<Foo.BarResult> {
+        return pulumi.runtime.call("example::Foo/bar", {
+            "__self__": this,
+            "prefix": args ? args.prefix : undefined,
+        }, this);
+    }
+
+    baz(): pulumi.Output<void> {
+        return pulumi.runtime.call("example::Foo/baz", {
+            "__self__": this,
+        }, this);
+    }
+}
+
+/**
+ * The set of arguments for constructing a Foo resource.
+ */
+export interface FooArgs {
+}
+
+export namespace Foo {
+    /**
+     * The set of arguments for the Foo.bar method.
+     */
+    export interface BarArgs {
+        readonly prefix?: pulumi.Input<string>;
+    }
+
+    /**
+     * The results of the Foo.bar method.
+     */
+    export interface BarResult {
+        readonly someValue: string;
+    }
+}
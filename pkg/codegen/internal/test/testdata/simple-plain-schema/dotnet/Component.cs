@@ -0,0 +1,67 @@
+// *** WARNING: this file was generated by test. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Example
+{
+    public partial class Component : Pulumi.ComponentResource
+    {
+        [Output("subnetIds")]
+        public Output<ImmutableArray<string>> SubnetIds { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a Component resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public Component(string name, ComponentArgs args, ComponentResourceOptions? options = null)
+            : base("example::Component", name, args ?? new ComponentArgs(), MakeResourceOptions(options, ""), remote: true)
+        {
+        }
+
+        private static ComponentResourceOptions MakeResourceOptions(ComponentResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new ComponentResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = ComponentResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+    }
+
+    public sealed class ComponentArgs : Pulumi.ResourceArgs
+    {
+        [Input("config")]
+        public Inputs.ConfigArgs? Config { get; set; }
+
+        [Input("name")]
+        public Input<string>? Name { get; set; }
+
+        [Input("replicas")]
+        public int? Replicas { get; set; }
+
+        [Input("subnets", required: true)]
+        private List<string>? _subnets;
+        public List<string> Subnets
+        {
+            get => _subnets ?? (_subnets = new List<string>());
+            set => _subnets = value;
+        }
+
+        public ComponentArgs()
+        {
+            Replicas = 1;
+        }
+    }
+}
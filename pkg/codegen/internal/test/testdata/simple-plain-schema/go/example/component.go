@@ -0,0 +1,98 @@
+// *** WARNING: this file was generated by test. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package example
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+type Component struct {
+	pulumi.ResourceState
+
+	SubnetIds pulumi.StringArrayOutput `pulumi:"subnetIds"`
+}
+
+// NewComponent registers a new resource with the given unique name, arguments, and options.
+func NewComponent(ctx *pulumi.Context,
+	name string, args *ComponentArgs, opts ...pulumi.ResourceOption) (*Component, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.Subnets == nil {
+		return nil, errors.New("invalid value for required argument 'Subnets'")
+	}
+	if args.Replicas == nil {
+		v := int(1)
+		args.Replicas = &v
+	}
+	var resource Component
+	err := ctx.RegisterRemoteComponentResource("example::Component", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+type componentArgs struct {
+	Config   *Config  `pulumi:"config"`
+	Name     *string  `pulumi:"name"`
+	Replicas *int     `pulumi:"replicas"`
+	Subnets  []string `pulumi:"subnets"`
+}
+
+// The set of arguments for constructing a Component resource.
+type ComponentArgs struct {
+	Config   *ConfigArgs
+	Name     pulumi.StringPtrInput
+	Replicas *int
+	Subnets  []string
+}
+
+func (ComponentArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*componentArgs)(nil)).Elem()
+}
+
+type ComponentInput interface {
+	pulumi.Input
+
+	ToComponentOutput() ComponentOutput
+	ToComponentOutputWithContext(ctx context.Context) ComponentOutput
+}
+
+func (Component) ElementType() reflect.Type {
+	return reflect.TypeOf((*Component)(nil)).Elem()
+}
+
+func (i Component) ToComponentOutput() ComponentOutput {
+	return i.ToComponentOutputWithContext(context.Background())
+}
+
+func (i Component) ToComponentOutputWithContext(ctx context.Context) ComponentOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ComponentOutput)
+}
+
+type ComponentOutput struct {
+	*pulumi.OutputState
+}
+
+func (ComponentOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ComponentOutput)(nil)).Elem()
+}
+
+func (o ComponentOutput) ToComponentOutput() ComponentOutput {
+	return o
+}
+
+func (o ComponentOutput) ToComponentOutputWithContext(ctx context.Context) ComponentOutput {
+	return o
+}
+
+func init() {
+	pulumi.RegisterOutputType(ComponentOutput{})
+}
@@ -0,0 +1,157 @@
+// *** WARNING: this file was generated by test. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package example
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+type Config struct {
+	Description *string           `pulumi:"description"`
+	Tags        map[string]string `pulumi:"tags"`
+}
+
+// ConfigInput is an input type that accepts ConfigArgs and ConfigOutput values.
+// You can construct a concrete instance of `ConfigInput` via:
+//
+//	ConfigArgs{...}
+type ConfigInput interface {
+	pulumi.Input
+
+	ToConfigOutput() ConfigOutput
+	ToConfigOutputWithContext(context.Context) ConfigOutput
+}
+
+type ConfigArgs struct {
+	Description pulumi.StringPtrInput `pulumi:"description"`
+	Tags        map[string]string     `pulumi:"tags"`
+}
+
+func (ConfigArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*Config)(nil)).Elem()
+}
+
+func (i ConfigArgs) ToConfigOutput() ConfigOutput {
+	return i.ToConfigOutputWithContext(context.Background())
+}
+
+func (i ConfigArgs) ToConfigOutputWithContext(ctx context.Context) ConfigOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ConfigOutput)
+}
+
+func (i ConfigArgs) ToConfigPtrOutput() ConfigPtrOutput {
+	return i.ToConfigPtrOutputWithContext(context.Background())
+}
+
+func (i ConfigArgs) ToConfigPtrOutputWithContext(ctx context.Context) ConfigPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ConfigOutput).ToConfigPtrOutputWithContext(ctx)
+}
+
+// ConfigPtrInput is an input type that accepts ConfigArgs, ConfigPtr and ConfigPtrOutput values.
+// You can construct a concrete instance of `ConfigPtrInput` via:
+//
+//	        ConfigArgs{...}
+//
+//	or:
+//
+//	        nil
+type ConfigPtrInput interface {
+	pulumi.Input
+
+	ToConfigPtrOutput() ConfigPtrOutput
+	ToConfigPtrOutputWithContext(context.Context) ConfigPtrOutput
+}
+
+type configPtrType ConfigArgs
+
+func ConfigPtr(v *ConfigArgs) ConfigPtrInput {
+	return (*configPtrType)(v)
+}
+
+func (*configPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**Config)(nil)).Elem()
+}
+
+func (i *configPtrType) ToConfigPtrOutput() ConfigPtrOutput {
+	return i.ToConfigPtrOutputWithContext(context.Background())
+}
+
+func (i *configPtrType) ToConfigPtrOutputWithContext(ctx context.Context) ConfigPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ConfigPtrOutput)
+}
+
+type ConfigOutput struct{ *pulumi.OutputState }
+
+func (ConfigOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Config)(nil)).Elem()
+}
+
+func (o ConfigOutput) ToConfigOutput() ConfigOutput {
+	return o
+}
+
+func (o ConfigOutput) ToConfigOutputWithContext(ctx context.Context) ConfigOutput {
+	return o
+}
+
+func (o ConfigOutput) ToConfigPtrOutput() ConfigPtrOutput {
+	return o.ToConfigPtrOutputWithContext(context.Background())
+}
+
+func (o ConfigOutput) ToConfigPtrOutputWithContext(ctx context.Context) ConfigPtrOutput {
+	return o.ApplyT(func(v Config) *Config {
+		return &v
+	}).(ConfigPtrOutput)
+}
+func (o ConfigOutput) Description() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Config) *string { return v.Description }).(pulumi.StringPtrOutput)
+}
+
+func (o ConfigOutput) Tags() pulumi.StringMapOutput {
+	return o.ApplyT(func(v Config) map[string]string { return v.Tags }).(pulumi.StringMapOutput)
+}
+
+type ConfigPtrOutput struct{ *pulumi.OutputState }
+
+func (ConfigPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Config)(nil)).Elem()
+}
+
+func (o ConfigPtrOutput) ToConfigPtrOutput() ConfigPtrOutput {
+	return o
+}
+
+func (o ConfigPtrOutput) ToConfigPtrOutputWithContext(ctx context.Context) ConfigPtrOutput {
+	return o
+}
+
+func (o ConfigPtrOutput) Elem() ConfigOutput {
+	return o.ApplyT(func(v *Config) Config { return *v }).(ConfigOutput)
+}
+
+func (o ConfigPtrOutput) Description() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Config) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Description
+	}).(pulumi.StringPtrOutput)
+}
+
+func (o ConfigPtrOutput) Tags() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *Config) map[string]string {
+		if v == nil {
+			return nil
+		}
+		return v.Tags
+	}).(pulumi.StringMapOutput)
+}
+
+func init() {
+	pulumi.RegisterOutputType(ConfigOutput{})
+	pulumi.RegisterOutputType(ConfigPtrOutput{})
+}
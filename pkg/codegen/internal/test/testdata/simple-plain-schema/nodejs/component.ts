@@ -0,0 +1,65 @@
+// *** WARNING: this file was generated by test. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import { input as inputs, output as outputs } from "./types";
+import * as utilities from "./utilities";
+
+export class Component extends pulumi.ComponentResource {
+    /** @internal */
+    public static readonly __pulumiType = 'example::Component';
+
+    /**
+     * Returns true if the given object is an instance of Component.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is Component {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === Component.__pulumiType;
+    }
+
+    public /*out*/ readonly subnetIds!: pulumi.Output<string[]>;
+
+    /**
+     * Create a Component resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: ComponentArgs, opts?: pulumi.ComponentResourceOptions) {
+        let inputs: pulumi.Inputs = {};
+        if (!(opts && opts.id)) {
+            if ((!args || args.subnets === undefined) && !(opts && opts.urn)) {
+                throw new Error("Missing required property 'subnets'");
+            }
+            inputs["config"] = args ? args.config : undefined;
+            inputs["name"] = args ? args.name : undefined;
+            inputs["replicas"] = (args ? args.replicas : undefined) || 1;
+            inputs["subnets"] = args ? args.subnets : undefined;
+            inputs["subnetIds"] = undefined /*out*/;
+        } else {
+            inputs["subnetIds"] = undefined /*out*/;
+        }
+        if (!opts) {
+            opts = {}
+        }
+
+        if (!opts.version) {
+            opts.version = utilities.getVersion();
+        }
+        super(Component.__pulumiType, name, inputs, opts, true /*remote*/);
+    }
+}
+
+/**
+ * The set of arguments for constructing a Component resource.
+ */
+export interface ComponentArgs {
+    readonly config?: inputs.Config;
+    readonly name?: pulumi.Input<string>;
+    readonly replicas?: number;
+    readonly subnets: string[];
+}
@@ -0,0 +1,10 @@
+// *** WARNING: this file was generated by test. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import { input as inputs, output as outputs } from "./types";
+
+export interface Config {
+    description?: pulumi.Input<string>;
+    tags?: {[key: string]: string};
+}
@@ -284,7 +284,7 @@ func (mod *modContext) genPlainType(w io.Writer, name, comment string, propertie
 			sigil = "?"
 		}
 
-		fmt.Fprintf(w, "%s    %s%s%s: %s;\n", indent, prefix, p.Name, sigil, mod.typeString(p.Type, input, wrapInput, false, p.ConstValue))
+		fmt.Fprintf(w, "%s    %s%s%s: %s;\n", indent, prefix, p.Name, sigil, mod.typeString(p.Type, input, wrapInput && !p.IsPlain, false, p.ConstValue))
 	}
 	fmt.Fprintf(w, "%s}\n", indent)
 }
@@ -671,8 +671,14 @@ func (mod *modContext) genResource(w io.Writer, r *schema.Resource) error {
 		fmt.Fprintf(w, "        super(%s.__pulumiType, name, inputs, opts);\n", name)
 	}
 
-	// Finish the class.
 	fmt.Fprintf(w, "    }\n")
+
+	// Emit the resource's methods.
+	for _, method := range r.Methods {
+		mod.genMethod(w, name, method)
+	}
+
+	// Finish the class.
 	fmt.Fprintf(w, "}\n")
 
 	// Emit the state type for get methods.
@@ -686,9 +692,100 @@ func (mod *modContext) genResource(w io.Writer, r *schema.Resource) error {
 	argsComment := fmt.Sprintf("The set of arguments for constructing a %s resource.", name)
 	mod.genPlainType(w, argsType, argsComment, r.InputProperties, true, true, true, 0)
 
+	// Emit the argument and result types for the resource's methods.
+	if hasMethodTypes(r) {
+		fmt.Fprintf(w, "\n")
+		fmt.Fprintf(w, "export namespace %s {\n", name)
+		first := true
+		for _, method := range r.Methods {
+			methodName := title(method.Name)
+			if args := methodArgs(method.Function); len(args) > 0 {
+				if !first {
+					fmt.Fprintf(w, "\n")
+				}
+				first = false
+				comment := fmt.Sprintf("The set of arguments for the %s.%s method.", name, method.Name)
+				mod.genPlainType(w, methodName+"Args", comment, args, true, true, true, 1)
+			}
+			if method.Function.Outputs != nil {
+				if !first {
+					fmt.Fprintf(w, "\n")
+				}
+				first = false
+				comment := fmt.Sprintf("The results of the %s.%s method.", name, method.Name)
+				mod.genPlainType(w, methodName+"Result", comment, method.Function.Outputs.Properties, false, false,
+					true, 1)
+			}
+		}
+		fmt.Fprintf(w, "}\n")
+	}
+
 	return nil
 }
 
+// methodArgs returns the inputs of a method's function, excluding the `__self__` argument.
+func methodArgs(fun *schema.Function) []*schema.Property {
+	if fun.Inputs == nil {
+		return nil
+	}
+	var args []*schema.Property
+	for _, p := range fun.Inputs.Properties {
+		if p.Name != "__self__" {
+			args = append(args, p)
+		}
+	}
+	return args
+}
+
+// hasMethodTypes returns true if any of the resource's methods require argument or result types.
+func hasMethodTypes(r *schema.Resource) bool {
+	for _, method := range r.Methods {
+		if len(methodArgs(method.Function)) > 0 || method.Function.Outputs != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func (mod *modContext) genMethod(w io.Writer, resourceName string, method *schema.Method) {
+	fun := method.Function
+	methodName := title(method.Name)
+	args := methodArgs(fun)
+
+	fmt.Fprintf(w, "\n")
+	printComment(w, codegen.FilterExamples(fun.Comment, "typescript"), fun.DeprecationMessage, "    ")
+
+	var argsig string
+	if len(args) > 0 {
+		optFlag := "?"
+		for _, p := range args {
+			if p.IsRequired {
+				optFlag = ""
+				break
+			}
+		}
+		argsig = fmt.Sprintf("args%s: %s.%sArgs", optFlag, resourceName, methodName)
+	}
+	retty := "void"
+	if fun.Outputs != nil {
+		retty = fmt.Sprintf("%s.%sResult", resourceName, methodName)
+	}
+	fmt.Fprintf(w, "    %s(%s): pulumi.Output<%s> {\n", method.Name, argsig, retty)
+	if fun.DeprecationMessage != "" && mod.compatibility != kubernetes20 {
+		fmt.Fprintf(w, "        pulumi.log.warn(\"%s.%s is deprecated: %s\")\n", resourceName, method.Name,
+			fun.DeprecationMessage)
+	}
+
+	// Call the provider with this resource as `__self__`, returning the results.
+	fmt.Fprintf(w, "        return pulumi.runtime.call(\"%s\", {\n", fun.Token)
+	fmt.Fprintf(w, "            \"__self__\": this,\n")
+	for _, p := range args {
+		fmt.Fprintf(w, "            \"%[1]s\": args ? args.%[1]s : undefined,\n", p.Name)
+	}
+	fmt.Fprintf(w, "        }, this);\n")
+	fmt.Fprintf(w, "    }\n")
+}
+
 func (mod *modContext) genFunction(w io.Writer, fun *schema.Function) {
 	name := tokenToFunctionName(fun.Token)
 
@@ -888,6 +985,16 @@ func (mod *modContext) getImports(member interface{}, imports map[string]codegen
 		for _, p := range member.InputProperties {
 			needsTypes = mod.getTypeImports(p.Type, false, imports, seen) || needsTypes
 		}
+		for _, method := range member.Methods {
+			for _, p := range methodArgs(method.Function) {
+				needsTypes = mod.getTypeImports(p.Type, false, imports, seen) || needsTypes
+			}
+			if method.Function.Outputs != nil {
+				for _, p := range method.Function.Outputs.Properties {
+					needsTypes = mod.getTypeImports(p.Type, false, imports, seen) || needsTypes
+				}
+			}
+		}
 		return needsTypes
 	case *schema.Function:
 		needsTypes := false
@@ -1733,8 +1840,11 @@ func generateModuleContextMap(tool string, pkg *schema.Package, info NodePackage
 	}
 
 	for _, f := range pkg.Functions {
-		mod := getModFromToken(f.Token)
-		mod.functions = append(mod.functions, f)
+		// Methods are generated as part of the resources that define them.
+		if !f.IsMethod {
+			mod := getModFromToken(f.Token)
+			mod.functions = append(mod.functions, f)
+		}
 		if f.Inputs != nil {
 			visitObjectTypes(f.Inputs, func(t *schema.ObjectType) {
 				types.details(t).inputType = true
@@ -40,6 +40,21 @@ func TestGeneratePackage(t *testing.T) {
 				"types/enums/tree/v1/index.ts",
 			},
 		},
+		{
+			"Simple schema with resource methods",
+			"simple-methods-schema",
+			[]string{
+				"foo.ts",
+			},
+		},
+		{
+			"Simple schema with plain inputs",
+			"simple-plain-schema",
+			[]string{
+				"component.ts",
+				"types/input.ts",
+			},
+		},
 	}
 	testDir := filepath.Join("..", "internal", "test", "testdata")
 	for _, tt := range tests {
@@ -895,6 +895,30 @@ func (mod *modContext) genResource(res *schema.Resource) (string, error) {
 			}
 		})
 	}
+	for _, method := range res.Methods {
+		visitObjectTypesFromProperties(methodArgs(method.Function), inputSeen, func(t interface{}) {
+			switch T := t.(type) {
+			case *schema.ObjectType:
+				imports.addType(mod, T.Token, true /*input*/)
+			case *schema.EnumType:
+				imports.addEnum(mod, T.Token)
+			case *schema.ResourceType:
+				imports.addResource(mod, T.Token)
+			}
+		})
+		if method.Function.Outputs != nil {
+			visitObjectTypesFromProperties(method.Function.Outputs.Properties, outputSeen, func(t interface{}) {
+				switch T := t.(type) {
+				case *schema.ObjectType:
+					imports.addType(mod, T.Token, false /*input*/)
+				case *schema.EnumType:
+					imports.addEnum(mod, T.Token)
+				case *schema.ResourceType:
+					imports.addResource(mod, T.Token)
+				}
+			})
+		}
+	}
 
 	mod.genHeader(w, true /*needsSDK*/, imports)
 
@@ -935,7 +959,7 @@ func (mod *modContext) genResource(res *schema.Resource) (string, error) {
 
 	// If there's an argument type, emit it.
 	for _, prop := range res.InputProperties {
-		ty := mod.typeString(prop.Type, true, true, true /*optional*/, true /*acceptMapping*/)
+		ty := mod.typeString(prop.Type, true, !prop.IsPlain, true /*optional*/, true /*acceptMapping*/)
 		fmt.Fprintf(w, ",\n                 %s: %s = None", InitParamName(prop.Name), ty)
 	}
 
@@ -1128,9 +1152,122 @@ func (mod *modContext) genResource(res *schema.Resource) (string, error) {
 
 `)
 
+	// Write out methods and their result types.
+	for _, method := range res.Methods {
+		mod.genMethod(w, name, method)
+	}
+
 	return w.String(), nil
 }
 
+// methodArgs returns the inputs of a method's function, excluding the `__self__` argument.
+func methodArgs(fun *schema.Function) []*schema.Property {
+	if fun.Inputs == nil {
+		return nil
+	}
+	var args []*schema.Property
+	for _, p := range fun.Inputs.Properties {
+		if p.Name != "__self__" {
+			args = append(args, p)
+		}
+	}
+	return args
+}
+
+func (mod *modContext) genMethod(w io.Writer, resourceName string, method *schema.Method) {
+	fun := method.Function
+	methodName := PyName(method.Name)
+	resultName := title(method.Name) + "Result"
+	args := methodArgs(fun)
+
+	// If there is a return type, emit it as a class nested within the resource.
+	if fun.Outputs != nil {
+		fmt.Fprintf(w, "    @pulumi.output_type\n")
+		fmt.Fprintf(w, "    class %s:\n", resultName)
+		printComment(w, fun.Outputs.Comment, "        ")
+		fmt.Fprintf(w, "        def __init__(__self__")
+		for _, prop := range fun.Outputs.Properties {
+			fmt.Fprintf(w, ", %s=None", PyName(prop.Name))
+		}
+		fmt.Fprintf(w, "):\n")
+		for _, prop := range fun.Outputs.Properties {
+			pname := PyName(prop.Name)
+			ptype := mod.pyType(prop.Type)
+			fmt.Fprintf(w, "            if %s and not isinstance(%s, %s):\n", pname, pname, ptype)
+			fmt.Fprintf(w, "                raise TypeError(\"Expected argument '%s' to be a %s\")\n", pname, ptype)
+			fmt.Fprintf(w, "            pulumi.set(__self__, \"%[1]s\", %[1]s)\n", pname)
+		}
+		fmt.Fprintf(w, "\n")
+
+		props := &bytes.Buffer{}
+		mod.genProperties(props, fun.Outputs.Properties, false /*setters*/, func(prop *schema.Property) string {
+			return mod.typeString(prop.Type, false /*input*/, false /*wrapInput*/, !prop.IsRequired,
+				false /*acceptMapping*/)
+		})
+		for _, line := range strings.SplitAfter(props.String(), "\n") {
+			if strings.TrimSpace(line) != "" {
+				line = "    " + line
+			}
+			fmt.Fprint(w, line)
+		}
+	}
+
+	// Write out the method signature.
+	retty := "None"
+	if fun.Outputs != nil {
+		retty = fmt.Sprintf("pulumi.Output['%s.%s']", resourceName, resultName)
+	}
+	def := fmt.Sprintf("    def %s(", methodName)
+	if len(args) == 0 {
+		fmt.Fprintf(w, "%s__self__) -> %s:\n", def, retty)
+	} else {
+		indent := strings.Repeat(" ", len(def))
+		fmt.Fprintf(w, "%s__self__, *", def)
+		for _, arg := range args {
+			ty := mod.typeString(arg.Type, true, !arg.IsPlain /*wrapInput*/, true /*optional*/, true /*acceptMapping*/)
+			fmt.Fprintf(w, ",\n%s%s: %s = None", indent, PyName(arg.Name), ty)
+		}
+		fmt.Fprintf(w, ") -> %s:\n", retty)
+	}
+
+	docs := &bytes.Buffer{}
+	if fun.Comment != "" {
+		fmt.Fprintln(docs, codegen.FilterExamples(fun.Comment, "python"))
+	}
+	if len(args) > 0 {
+		if fun.Comment != "" {
+			fmt.Fprintln(docs, "")
+		}
+		for _, arg := range args {
+			mod.genPropDocstring(docs, PyName(arg.Name), arg, true /*wrapInputs*/, true /*acceptMapping*/)
+		}
+	}
+	if docs.Len() > 0 {
+		printComment(w, docs.String(), "        ")
+	}
+
+	if fun.DeprecationMessage != "" {
+		fmt.Fprintf(w, "        pulumi.log.warn(\"%s is deprecated: %s\")\n", methodName, fun.DeprecationMessage)
+	}
+
+	// Copy the method arguments into a dictionary, passing the resource itself as `__self__`.
+	fmt.Fprintf(w, "        __args__ = dict()\n")
+	fmt.Fprintf(w, "        __args__['__self__'] = __self__\n")
+	for _, arg := range args {
+		fmt.Fprintf(w, "        __args__['%s'] = %s\n", arg.Name, PyName(arg.Name))
+	}
+
+	// Now call the provider, passing along the result type so its outputs are instantiated by the call.
+	if fun.Outputs != nil {
+		fmt.Fprintf(w, "        __result__ = pulumi.runtime.call('%s', __args__, res=__self__, typ=%s.%s)\n",
+			fun.Token, resourceName, resultName)
+		fmt.Fprintf(w, "        return __result__\n")
+	} else {
+		fmt.Fprintf(w, "        pulumi.runtime.call('%s', __args__, res=__self__)\n", fun.Token)
+	}
+	fmt.Fprintf(w, "\n")
+}
+
 func (mod *modContext) genProperties(w io.Writer, properties []*schema.Property, setters bool,
 	propType func(prop *schema.Property) string) {
 	// Write out Python properties for each property. If there is a property named "property", it will
@@ -1816,7 +1953,7 @@ func (mod *modContext) genPropDocstring(w io.Writer, name string, prop *schema.P
 		return
 	}
 
-	ty := mod.typeString(prop.Type, true, wrapInput, false /*optional*/, acceptMapping)
+	ty := mod.typeString(prop.Type, true, wrapInput && !prop.IsPlain, false /*optional*/, acceptMapping)
 
 	// If this property has some documentation associated with it, we need to split it so that it is indented
 	// in a way that Sphinx can understand.
@@ -2039,7 +2176,7 @@ func (mod *modContext) genType(w io.Writer, obj *schema.ObjectType, input, wrapI
 	}
 	for _, prop := range props {
 		pname := PyName(prop.Name)
-		ty := mod.typeString(prop.Type, input, wrapInput, !prop.IsRequired, false /*acceptMapping*/)
+		ty := mod.typeString(prop.Type, input, wrapInput && !prop.IsPlain, !prop.IsRequired, false /*acceptMapping*/)
 		var defaultValue string
 		if !prop.IsRequired {
 			defaultValue = " = None"
@@ -2096,7 +2233,7 @@ func (mod *modContext) genType(w io.Writer, obj *schema.ObjectType, input, wrapI
 
 	// Generate properties. Input types have getters and setters, output types only have getters.
 	mod.genProperties(w, props, input /*setters*/, func(prop *schema.Property) string {
-		return mod.typeString(prop.Type, input, wrapInput, !prop.IsRequired, false /*acceptMapping*/)
+		return mod.typeString(prop.Type, input, wrapInput && !prop.IsPlain, !prop.IsRequired, false /*acceptMapping*/)
 	})
 
 	if !input && !mod.details(obj).functionType {
@@ -2274,8 +2411,11 @@ func generateModuleContextMap(tool string, pkg *schema.Package, info PackageInfo
 
 	// Find input and output types referenced by functions.
 	for _, f := range pkg.Functions {
-		mod := getModFromToken(f.Token)
-		mod.functions = append(mod.functions, f)
+		// Methods are generated as part of the resources that define them.
+		if !f.IsMethod {
+			mod := getModFromToken(f.Token)
+			mod.functions = append(mod.functions, f)
+		}
 		if f.Inputs != nil {
 			visitObjectTypes(f.Inputs, inputSeen, func(t interface{}) {
 				switch T := t.(type) {
@@ -108,6 +108,21 @@ func TestGeneratePackage(t *testing.T) {
 				filepath.Join("pulumi_plant_provider", "tree", "v1", "rubber_tree.py"),
 			},
 		},
+		{
+			"Simple schema with resource methods",
+			"simple-methods-schema",
+			[]string{
+				filepath.Join("pulumi_example", "foo.py"),
+			},
+		},
+		{
+			"Simple schema with plain inputs",
+			"simple-plain-schema",
+			[]string{
+				filepath.Join("pulumi_example", "component.py"),
+				filepath.Join("pulumi_example", "_inputs.py"),
+			},
+		},
 	}
 
 	testDir := filepath.Join("..", "internal", "test", "testdata")
@@ -0,0 +1,506 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// ChangeKind identifies the kind of package element affected by a Change.
+type ChangeKind string
+
+const (
+	// ConfigChange is a change to a package configuration variable.
+	ConfigChange ChangeKind = "config"
+	// ProviderChange is a change to the package's provider resource.
+	ProviderChange ChangeKind = "provider"
+	// ResourceChange is a change to a resource.
+	ResourceChange ChangeKind = "resource"
+	// FunctionChange is a change to a function.
+	FunctionChange ChangeKind = "function"
+	// TypeChange is a change to an object or enum type.
+	TypeChange ChangeKind = "type"
+)
+
+// Change describes a single difference between two versions of a package.
+type Change struct {
+	// Kind is the kind of the element that changed.
+	Kind ChangeKind `json:"kind"`
+	// Token is the token of the element that changed. For config changes, this is the name of the package.
+	Token string `json:"token"`
+	// Path is the path to the member of the element that changed, e.g. "inputs.name", or the name of the variable
+	// for config changes. The path is empty if the element itself was added or removed.
+	Path string `json:"path,omitempty"`
+	// Message describes the change.
+	Message string `json:"message"`
+	// Breaking is true if the change may break programs or SDKs that were written against the old version.
+	Breaking bool `json:"breaking"`
+}
+
+func (c *Change) String() string {
+	severity := "non-breaking"
+	if c.Breaking {
+		severity = "breaking"
+	}
+	if c.Path == "" {
+		return fmt.Sprintf("[%s] %s %s: %s", severity, c.Kind, c.Token, c.Message)
+	}
+	return fmt.Sprintf("[%s] %s %s: %s: %s", severity, c.Kind, c.Token, c.Path, c.Message)
+}
+
+// HasBreakingChanges returns true if any of the given changes is breaking.
+func HasBreakingChanges(changes []*Change) bool {
+	for _, c := range changes {
+		if c.Breaking {
+			return true
+		}
+	}
+	return false
+}
+
+// DiffPackages compares two versions of a package and returns the changes between them, ordered by kind, token and
+// path.
+//
+// A change is breaking if a program or SDK written against the old version may fail to compile or behave differently
+// against the new version. Removed elements and changed types are always breaking. Inputs that become required are
+// breaking, as are outputs that become optional. Whether a property of an object type is an input, an output or both
+// is determined by where the type is referenced from; types that are not referenced at all are treated as both.
+func DiffPackages(old, new *Package) []*Change {
+	d := &differ{
+		oldUsage: computeTypeUsage(old),
+		newUsage: computeTypeUsage(new),
+	}
+
+	d.diffProperties(ConfigChange, new.Name, "", old.Config, new.Config, true, false)
+
+	if old.Provider != nil && new.Provider != nil {
+		d.diffResource(ProviderChange, old.Provider, new.Provider)
+	}
+
+	d.diffResources(old.Resources, new.Resources)
+	d.diffFunctions(old.Functions, new.Functions)
+	d.diffTypes(old.Types, new.Types)
+
+	kindOrder := map[ChangeKind]int{
+		ConfigChange:   0,
+		ProviderChange: 1,
+		ResourceChange: 2,
+		FunctionChange: 3,
+		TypeChange:     4,
+	}
+	sort.SliceStable(d.changes, func(i, j int) bool {
+		ci, cj := d.changes[i], d.changes[j]
+		if ci.Kind != cj.Kind {
+			return kindOrder[ci.Kind] < kindOrder[cj.Kind]
+		}
+		if ci.Token != cj.Token {
+			return ci.Token < cj.Token
+		}
+		return ci.Path < cj.Path
+	})
+
+	return d.changes
+}
+
+// typeUsage records whether a named type is reachable from the inputs and/or outputs of a package.
+type typeUsage struct {
+	input  bool
+	output bool
+}
+
+type differ struct {
+	oldUsage map[string]*typeUsage
+	newUsage map[string]*typeUsage
+
+	changes []*Change
+}
+
+func (d *differ) add(kind ChangeKind, token, path string, breaking bool, format string, args ...interface{}) {
+	d.changes = append(d.changes, &Change{
+		Kind:     kind,
+		Token:    token,
+		Path:     path,
+		Message:  fmt.Sprintf(format, args...),
+		Breaking: breaking,
+	})
+}
+
+func (d *differ) diffResources(old, new []*Resource) {
+	newResources := map[string]*Resource{}
+	for _, r := range new {
+		newResources[r.Token] = r
+	}
+
+	oldResources := map[string]*Resource{}
+	for _, r := range old {
+		oldResources[r.Token] = r
+
+		nr, ok := newResources[r.Token]
+		if !ok {
+			d.add(ResourceChange, r.Token, "", true, "resource removed")
+			continue
+		}
+		d.diffResource(ResourceChange, r, nr)
+	}
+
+	for _, r := range new {
+		if _, ok := oldResources[r.Token]; !ok {
+			d.add(ResourceChange, r.Token, "", false, "resource added")
+		}
+	}
+}
+
+func (d *differ) diffResource(kind ChangeKind, old, new *Resource) {
+	token := new.Token
+
+	d.diffDeprecation(kind, token, "", old.DeprecationMessage, new.DeprecationMessage)
+	if old.IsComponent != new.IsComponent {
+		if new.IsComponent {
+			d.add(kind, token, "", true, "resource became a component resource")
+		} else {
+			d.add(kind, token, "", true, "resource is no longer a component resource")
+		}
+	}
+
+	d.diffProperties(kind, token, "inputs", old.InputProperties, new.InputProperties, true, false)
+	d.diffProperties(kind, token, "properties", old.Properties, new.Properties, false, true)
+
+	var oldState, newState []*Property
+	if old.StateInputs != nil {
+		oldState = old.StateInputs.Properties
+	}
+	if new.StateInputs != nil {
+		newState = new.StateInputs.Properties
+	}
+	d.diffProperties(kind, token, "stateInputs", oldState, newState, true, false)
+
+	newMethods := map[string]bool{}
+	for _, m := range new.Methods {
+		newMethods[m.Name] = true
+	}
+	oldMethods := map[string]bool{}
+	for _, m := range old.Methods {
+		oldMethods[m.Name] = true
+		if !newMethods[m.Name] {
+			d.add(kind, token, "methods."+m.Name, true, "method removed")
+		}
+	}
+	for _, m := range new.Methods {
+		if !oldMethods[m.Name] {
+			d.add(kind, token, "methods."+m.Name, false, "method added")
+		}
+	}
+}
+
+func (d *differ) diffFunctions(old, new []*Function) {
+	newFunctions := map[string]*Function{}
+	for _, f := range new {
+		newFunctions[f.Token] = f
+	}
+
+	oldFunctions := map[string]*Function{}
+	for _, f := range old {
+		oldFunctions[f.Token] = f
+
+		nf, ok := newFunctions[f.Token]
+		if !ok {
+			d.add(FunctionChange, f.Token, "", true, "function removed")
+			continue
+		}
+
+		d.diffDeprecation(FunctionChange, f.Token, "", f.DeprecationMessage, nf.DeprecationMessage)
+		d.diffProperties(FunctionChange, f.Token, "inputs", objectProperties(f.Inputs), objectProperties(nf.Inputs),
+			true, false)
+		d.diffProperties(FunctionChange, f.Token, "outputs", objectProperties(f.Outputs),
+			objectProperties(nf.Outputs), false, true)
+	}
+
+	for _, f := range new {
+		if _, ok := oldFunctions[f.Token]; !ok {
+			d.add(FunctionChange, f.Token, "", false, "function added")
+		}
+	}
+}
+
+func (d *differ) diffTypes(old, new []Type) {
+	newTypes := map[string]Type{}
+	for _, t := range new {
+		if isNamedType(t) {
+			newTypes[t.String()] = t
+		}
+	}
+
+	oldTypes := map[string]Type{}
+	for _, t := range old {
+		if !isNamedType(t) {
+			continue
+		}
+		token := t.String()
+		oldTypes[token] = t
+
+		nt, ok := newTypes[token]
+		if !ok {
+			d.add(TypeChange, token, "", true, "type removed")
+			continue
+		}
+
+		input, output := d.usage(token)
+		switch t := t.(type) {
+		case *ObjectType:
+			nt, ok := nt.(*ObjectType)
+			if !ok {
+				d.add(TypeChange, token, "", true, "type changed from an object type to an enum type")
+				continue
+			}
+			d.diffProperties(TypeChange, token, "properties", t.Properties, nt.Properties, input, output)
+		case *EnumType:
+			nt, ok := nt.(*EnumType)
+			if !ok {
+				d.add(TypeChange, token, "", true, "type changed from an enum type to an object type")
+				continue
+			}
+			d.diffEnum(token, t, nt)
+		}
+	}
+
+	for _, t := range new {
+		if !isNamedType(t) {
+			continue
+		}
+		if _, ok := oldTypes[t.String()]; !ok {
+			d.add(TypeChange, t.String(), "", false, "type added")
+		}
+	}
+}
+
+func (d *differ) diffEnum(token string, old, new *EnumType) {
+	if old.ElementType.String() != new.ElementType.String() {
+		d.add(TypeChange, token, "", true, "element type changed from %v to %v", old.ElementType, new.ElementType)
+	}
+
+	newValues := map[string]*Enum{}
+	for _, e := range new.Elements {
+		newValues[fmt.Sprint(e.Value)] = e
+	}
+	oldValues := map[string]bool{}
+	for _, e := range old.Elements {
+		value := fmt.Sprint(e.Value)
+		oldValues[value] = true
+
+		path := "values." + value
+		ne, ok := newValues[value]
+		if !ok {
+			d.add(TypeChange, token, path, true, "enum value removed")
+			continue
+		}
+		if e.Name != ne.Name {
+			d.add(TypeChange, token, path, true, "enum value renamed from %q to %q", e.Name, ne.Name)
+		}
+		d.diffDeprecation(TypeChange, token, path, e.DeprecationMessage, ne.DeprecationMessage)
+	}
+	for _, e := range new.Elements {
+		if value := fmt.Sprint(e.Value); !oldValues[value] {
+			d.add(TypeChange, token, "values."+value, false, "enum value added")
+		}
+	}
+}
+
+// diffProperties compares two lists of properties. input and output indicate whether the properties are read by the
+// provider, returned by the provider, or both.
+func (d *differ) diffProperties(kind ChangeKind, token, prefix string, old, new []*Property, input, output bool) {
+	path := func(name string) string {
+		if prefix == "" {
+			return name
+		}
+		return prefix + "." + name
+	}
+
+	newProps := map[string]*Property{}
+	for _, p := range new {
+		newProps[p.Name] = p
+	}
+
+	oldProps := map[string]*Property{}
+	for _, op := range old {
+		oldProps[op.Name] = op
+
+		p := path(op.Name)
+		np, ok := newProps[op.Name]
+		if !ok {
+			d.add(kind, token, p, true, "property removed")
+			continue
+		}
+
+		if ot, nt := op.Type.String(), np.Type.String(); ot != nt {
+			d.add(kind, token, p, true, "type changed from %v to %v", ot, nt)
+		}
+
+		if op.IsRequired != np.IsRequired {
+			if np.IsRequired {
+				d.add(kind, token, p, input && !hasDefault(np), "property became required")
+			} else {
+				d.add(kind, token, p, output, "property became optional")
+			}
+		}
+
+		if !reflect.DeepEqual(op.ConstValue, np.ConstValue) {
+			d.add(kind, token, p, true, "constant value changed from %v to %v", op.ConstValue, np.ConstValue)
+		}
+
+		if op.Secret != np.Secret {
+			if np.Secret {
+				d.add(kind, token, p, false, "property became secret")
+			} else {
+				d.add(kind, token, p, false, "property is no longer secret")
+			}
+		}
+
+		// Changing whether an input is plain changes its type in the generated SDKs, so either direction is breaking.
+		if op.IsPlain != np.IsPlain {
+			if np.IsPlain {
+				d.add(kind, token, p, input, "property became plain")
+			} else {
+				d.add(kind, token, p, input, "property is no longer plain")
+			}
+		}
+
+		d.diffDeprecation(kind, token, p, op.DeprecationMessage, np.DeprecationMessage)
+	}
+
+	for _, np := range new {
+		if _, ok := oldProps[np.Name]; ok {
+			continue
+		}
+		if np.IsRequired && input && !hasDefault(np) {
+			d.add(kind, token, path(np.Name), true, "required property added")
+		} else {
+			d.add(kind, token, path(np.Name), false, "property added")
+		}
+	}
+}
+
+func (d *differ) diffDeprecation(kind ChangeKind, token, path, old, new string) {
+	switch {
+	case old == "" && new != "":
+		d.add(kind, token, path, false, "deprecated: %s", new)
+	case old != "" && new == "":
+		d.add(kind, token, path, false, "no longer deprecated")
+	}
+}
+
+// usage returns whether the named type is used as an input and/or an output in either version of the package. Types
+// that are not used at all are conservatively treated as both.
+func (d *differ) usage(token string) (input, output bool) {
+	for _, usage := range []map[string]*typeUsage{d.oldUsage, d.newUsage} {
+		if u, ok := usage[token]; ok {
+			input, output = input || u.input, output || u.output
+		}
+	}
+	if !input && !output {
+		return true, true
+	}
+	return input, output
+}
+
+// computeTypeUsage determines whether each object and enum type in the package is reachable from the package's
+// inputs, its outputs, or both.
+func computeTypeUsage(pkg *Package) map[string]*typeUsage {
+	usage := map[string]*typeUsage{}
+
+	var visit func(t Type, input bool)
+	visit = func(t Type, input bool) {
+		switch t := t.(type) {
+		case *ArrayType:
+			visit(t.ElementType, input)
+		case *MapType:
+			visit(t.ElementType, input)
+		case *UnionType:
+			for _, e := range t.ElementTypes {
+				visit(e, input)
+			}
+		case *EnumType, *ObjectType:
+			u, ok := usage[t.String()]
+			if !ok {
+				u = &typeUsage{}
+				usage[t.String()] = u
+			}
+			if input && u.input || !input && u.output {
+				return
+			}
+			if input {
+				u.input = true
+			} else {
+				u.output = true
+			}
+			if obj, ok := t.(*ObjectType); ok {
+				for _, p := range obj.Properties {
+					visit(p.Type, input)
+				}
+			}
+		}
+	}
+	visitProperties := func(props []*Property, input bool) {
+		for _, p := range props {
+			visit(p.Type, input)
+		}
+	}
+	visitResource := func(r *Resource) {
+		visitProperties(r.InputProperties, true)
+		visitProperties(r.Properties, false)
+		if r.StateInputs != nil {
+			visitProperties(r.StateInputs.Properties, true)
+		}
+	}
+
+	visitProperties(pkg.Config, true)
+	if pkg.Provider != nil {
+		visitResource(pkg.Provider)
+	}
+	for _, r := range pkg.Resources {
+		visitResource(r)
+	}
+	for _, f := range pkg.Functions {
+		visitProperties(objectProperties(f.Inputs), true)
+		visitProperties(objectProperties(f.Outputs), false)
+	}
+
+	return usage
+}
+
+// isNamedType returns true if the given type is an object or enum type.
+func isNamedType(t Type) bool {
+	switch t.(type) {
+	case *ObjectType, *EnumType:
+		return true
+	default:
+		return false
+	}
+}
+
+// objectProperties returns the properties of the given object type, or nil if the type is nil.
+func objectProperties(t *ObjectType) []*Property {
+	if t == nil {
+		return nil
+	}
+	return t.Properties
+}
+
+// hasDefault returns true if the given property has a default or constant value, in which case callers need not
+// supply it even if it is required.
+func hasDefault(p *Property) bool {
+	return p.DefaultValue != nil || p.ConstValue != nil
+}
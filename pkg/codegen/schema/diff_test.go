@@ -0,0 +1,170 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffPackages(t *testing.T) {
+	str := TypeSpec{Type: "string"}
+	ref := func(token string) TypeSpec {
+		return TypeSpec{Ref: "#/types/" + token}
+	}
+
+	oldSpec := PackageSpec{
+		Name: "example",
+		Config: ConfigSpec{
+			Variables: map[string]PropertySpec{
+				"region": {TypeSpec: str},
+				"token":  {TypeSpec: str},
+			},
+		},
+		Types: map[string]ComplexTypeSpec{
+			"example::Settings": {ObjectTypeSpec: ObjectTypeSpec{
+				Type: "object",
+				Properties: map[string]PropertySpec{
+					"name": {TypeSpec: str},
+					"size": {TypeSpec: TypeSpec{Type: "integer"}},
+				},
+			}},
+			"example::Status": {ObjectTypeSpec: ObjectTypeSpec{
+				Type: "object",
+				Properties: map[string]PropertySpec{
+					"state": {TypeSpec: str},
+				},
+				Required: []string{"state"},
+			}},
+			"example::Color": {
+				ObjectTypeSpec: ObjectTypeSpec{Type: "string"},
+				Enum:           []*EnumValueSpec{{Value: "Red"}, {Value: "Blue"}},
+			},
+		},
+		Resources: map[string]ResourceSpec{
+			"example::Bucket": {
+				ObjectTypeSpec: ObjectTypeSpec{
+					Properties: map[string]PropertySpec{
+						"arn":    {TypeSpec: str},
+						"status": {TypeSpec: ref("example::Status")},
+					},
+					Required: []string{"arn"},
+				},
+				InputProperties: map[string]PropertySpec{
+					"acl":      {TypeSpec: str},
+					"color":    {TypeSpec: ref("example::Color")},
+					"settings": {TypeSpec: ref("example::Settings")},
+					"size":     {TypeSpec: TypeSpec{Type: "integer"}},
+				},
+			},
+			"example::Queue": {},
+		},
+		Functions: map[string]FunctionSpec{
+			"example::getBucket": {
+				Inputs: &ObjectTypeSpec{Properties: map[string]PropertySpec{"name": {TypeSpec: str}}},
+			},
+		},
+	}
+
+	newSpec := PackageSpec{
+		Name: "example",
+		Config: ConfigSpec{
+			Variables: map[string]PropertySpec{
+				"region":  {TypeSpec: str},
+				"profile": {TypeSpec: str},
+			},
+		},
+		Types: map[string]ComplexTypeSpec{
+			"example::Settings": {ObjectTypeSpec: ObjectTypeSpec{
+				Type: "object",
+				Properties: map[string]PropertySpec{
+					"name": {TypeSpec: str},
+					"size": {TypeSpec: TypeSpec{Type: "integer"}},
+				},
+				Required: []string{"name"},
+			}},
+			"example::Status": {ObjectTypeSpec: ObjectTypeSpec{
+				Type: "object",
+				Properties: map[string]PropertySpec{
+					"state":   {TypeSpec: str},
+					"message": {TypeSpec: str},
+				},
+			}},
+			"example::Color": {
+				ObjectTypeSpec: ObjectTypeSpec{Type: "string"},
+				Enum:           []*EnumValueSpec{{Value: "Red"}, {Value: "Green"}},
+			},
+		},
+		Resources: map[string]ResourceSpec{
+			"example::Bucket": {
+				ObjectTypeSpec: ObjectTypeSpec{
+					Properties: map[string]PropertySpec{
+						"arn":    {TypeSpec: str},
+						"status": {TypeSpec: ref("example::Status")},
+						"region": {TypeSpec: str},
+					},
+				},
+				InputProperties: map[string]PropertySpec{
+					"acl":      {TypeSpec: str, DeprecationMessage: "use policy instead"},
+					"color":    {TypeSpec: ref("example::Color")},
+					"settings": {TypeSpec: ref("example::Settings")},
+					"size":     {TypeSpec: TypeSpec{Type: "number"}},
+					"name":     {TypeSpec: str},
+				},
+				RequiredInputs: []string{"name"},
+			},
+			"example::Topic": {},
+		},
+		Functions: map[string]FunctionSpec{
+			"example::getBucket": {
+				Inputs: &ObjectTypeSpec{Properties: map[string]PropertySpec{"name": {TypeSpec: TypeSpec{Type: "string", Plain: true}}}},
+			},
+		},
+	}
+
+	oldPkg, err := ImportSpec(oldSpec, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	newPkg, err := ImportSpec(newSpec, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	var actual []string
+	for _, c := range DiffPackages(oldPkg, newPkg) {
+		actual = append(actual, c.String())
+	}
+	assert.Equal(t, []string{
+		"[non-breaking] config example: profile: property added",
+		"[breaking] config example: token: property removed",
+		"[non-breaking] resource example::Bucket: inputs.acl: deprecated: use policy instead",
+		"[breaking] resource example::Bucket: inputs.name: required property added",
+		"[breaking] resource example::Bucket: inputs.size: type changed from integer to number",
+		"[breaking] resource example::Bucket: properties.arn: property became optional",
+		"[non-breaking] resource example::Bucket: properties.region: property added",
+		"[breaking] resource example::Queue: resource removed",
+		"[non-breaking] resource example::Topic: resource added",
+		"[breaking] function example::getBucket: inputs.name: property became plain",
+		"[breaking] type example::Color: values.Blue: enum value removed",
+		"[non-breaking] type example::Color: values.Green: enum value added",
+		"[breaking] type example::Settings: properties.name: property became required",
+		"[non-breaking] type example::Status: properties.message: property added",
+		"[breaking] type example::Status: properties.state: property became optional",
+	}, actual)
+	assert.True(t, HasBreakingChanges(DiffPackages(oldPkg, newPkg)))
+	assert.Empty(t, DiffPackages(oldPkg, oldPkg))
+}
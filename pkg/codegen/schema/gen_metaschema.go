@@ -0,0 +1,80 @@
+//+build ignore
+
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"strings"
+	"text/template"
+)
+
+const (
+	metaSchemaFileName = "pulumi.json"
+	generatedFileName  = "metaschema.go"
+)
+
+var tmpl = template.Must(template.New("").Parse(`
+	// AUTO-GENERATED FILE! DO NOT EDIT THIS FILE MANUALLY. Edit pulumi.json and run "go generate" instead.
+
+	// Copyright 2016-2020, Pulumi Corporation.
+	//
+	// Licensed under the Apache License, Version 2.0 (the "License");
+	// you may not use this file except in compliance with the License.
+	// You may obtain a copy of the License at
+	//
+	//     http://www.apache.org/licenses/LICENSE-2.0
+	//
+	// Unless required by applicable law or agreed to in writing, software
+	// distributed under the License is distributed on an "AS IS" BASIS,
+	// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	// See the License for the specific language governing permissions and
+	// limitations under the License.
+
+	// nolint: lll
+	package schema
+
+	// MetaSchema is the JSON Schema that describes the serialized form of a Pulumi package schema.
+	const MetaSchema = ` + "`{{ . }}`" + `
+`))
+
+// main reads the metaschema and writes it to a Go source file as a string constant.
+func main() {
+	contents, err := ioutil.ReadFile(metaSchemaFileName)
+	if err != nil {
+		log.Fatalf("Error reading the metaschema: %v", err)
+	}
+	if strings.Contains(string(contents), "`") {
+		log.Fatal("The metaschema must not contain backquotes")
+	}
+
+	builder := &bytes.Buffer{}
+	if err = tmpl.Execute(builder, strings.TrimSpace(string(contents))); err != nil {
+		log.Fatal("Error executing template", err)
+	}
+
+	data, err := format.Source(builder.Bytes())
+	if err != nil {
+		log.Fatal("Error formatting generated code", err)
+	}
+
+	if err = ioutil.WriteFile(generatedFileName, data, 0600); err != nil {
+		log.Fatal("Error writing file", err)
+	}
+}
@@ -1,40 +1,104 @@
 package schema
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
 	"sync"
 
 	"github.com/blang/semver"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/pkg/errors"
+	"golang.org/x/sync/errgroup"
+
 	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/logging"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/workspace"
 )
 
+// schemaCacheDirName is the name of the directory under the Pulumi home directory in which package schemas are cached.
+const schemaCacheDirName = "schemas"
+
 type Loader interface {
 	LoadPackage(pkg string, version *semver.Version) (*Package, error)
 }
 
 type pluginLoader struct {
-	m sync.RWMutex
+	m sync.Mutex
 
 	host    plugin.Host
-	entries map[string]*Package
+	entries map[string]*loaderEntry
+
+	// cacheDir is the directory in which schemas are cached on disk. If cacheDir is empty, schemas are not cached.
+	cacheDir string
+	// pluginPath returns the path to the binary for the given resource plugin, or the empty string if the plugin is
+	// not installed.
+	pluginPath func(pkg string, version *semver.Version) (string, error)
+}
+
+// loaderEntry holds the result of loading a package. done is closed once the result is available.
+type loaderEntry struct {
+	done chan struct{}
+	pkg  *Package
+	err  error
 }
 
+// NewPluginLoader returns a loader that loads package schemas from resource plugins.
 func NewPluginLoader(host plugin.Host) Loader {
+	return newPluginLoader(host, "")
+}
+
+// NewCachingPluginLoader returns a loader that loads package schemas from resource plugins and caches them on disk in
+// ~/.pulumi/schemas. A cached schema is only used if the plugin binary it was read from has not changed since, so
+// reinstalling a plugin invalidates its cached schema. Schemas for plugins that are not installed are never cached.
+func NewCachingPluginLoader(host plugin.Host) Loader {
+	cacheDir, err := workspace.GetPulumiPath(schemaCacheDirName)
+	if err != nil {
+		logging.V(5).Infof("disabling the schema cache: %v", err)
+		cacheDir = ""
+	}
+	return newPluginLoader(host, cacheDir)
+}
+
+func newPluginLoader(host plugin.Host, cacheDir string) *pluginLoader {
 	return &pluginLoader{
-		host:    host,
-		entries: map[string]*Package{},
+		host:       host,
+		entries:    map[string]*loaderEntry{},
+		cacheDir:   cacheDir,
+		pluginPath: resourcePluginPath,
 	}
 }
 
-func (l *pluginLoader) getPackage(key string) (*Package, bool) {
-	l.m.RLock()
-	defer l.m.RUnlock()
+// LoadPackages loads the given packages concurrently. The result maps the name of each package to its schema.
+func LoadPackages(loader Loader, packages map[string]*semver.Version) (map[string]*Package, error) {
+	var m sync.Mutex
+	result := map[string]*Package{}
+
+	var g errgroup.Group
+	for name, version := range packages {
+		name, version := name, version
+		g.Go(func() error {
+			pkg, err := loader.LoadPackage(name, version)
+			if err != nil {
+				return err
+			}
 
-	p, ok := l.entries[key]
-	return p, ok
+			m.Lock()
+			defer m.Unlock()
+			result[name] = pkg
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return result, nil
 }
 
 // ensurePlugin downloads and installs the specified plugin if it does not already exist.
@@ -64,20 +128,67 @@ func (l *pluginLoader) ensurePlugin(pkg string, version *semver.Version) error {
 	return nil
 }
 
+// LoadPackage loads the schema for the given package. Concurrent loads of the same package share a single result.
 func (l *pluginLoader) LoadPackage(pkg string, version *semver.Version) (*Package, error) {
 	key := pkg + "@"
 	if version != nil {
 		key += version.String()
 	}
 
-	if p, ok := l.getPackage(key); ok {
-		return p, nil
+	l.m.Lock()
+	entry, ok := l.entries[key]
+	if !ok {
+		entry = &loaderEntry{done: make(chan struct{})}
+		l.entries[key] = entry
+	}
+	l.m.Unlock()
+
+	if ok {
+		<-entry.done
+		return entry.pkg, entry.err
 	}
 
+	entry.pkg, entry.err = l.loadPackage(pkg, version)
+	if entry.err != nil {
+		// Forget failed loads so that they can be retried.
+		l.m.Lock()
+		delete(l.entries, key)
+		l.m.Unlock()
+	}
+	close(entry.done)
+
+	return entry.pkg, entry.err
+}
+
+func (l *pluginLoader) loadPackage(pkg string, version *semver.Version) (*Package, error) {
 	if err := l.ensurePlugin(pkg, version); err != nil {
 		return nil, err
 	}
 
+	schemaBytes, err := l.loadSchemaBytes(pkg, version)
+	if err != nil {
+		return nil, err
+	}
+
+	var spec PackageSpec
+	if err := jsoniter.Unmarshal(schemaBytes, &spec); err != nil {
+		return nil, err
+	}
+
+	return importSpec(spec, nil, l)
+}
+
+// loadSchemaBytes returns the schema for the given package, reading it from the on-disk cache if possible and from the
+// package's resource plugin otherwise.
+func (l *pluginLoader) loadSchemaBytes(pkg string, version *semver.Version) ([]byte, error) {
+	schemaPath, checksum := l.cacheEntry(pkg, version)
+	if checksum != "" {
+		if schemaBytes, ok := readCachedSchema(schemaPath, checksum); ok {
+			logging.V(5).Infof("using cached schema %s", schemaPath)
+			return schemaBytes, nil
+		}
+	}
+
 	provider, err := l.host.Provider(tokens.Package(pkg), version)
 	if err != nil {
 		return nil, err
@@ -89,23 +200,112 @@ func (l *pluginLoader) LoadPackage(pkg string, version *semver.Version) (*Packag
 		return nil, err
 	}
 
-	var spec PackageSpec
-	if err := jsoniter.Unmarshal(schemaBytes, &spec); err != nil {
-		return nil, err
+	if checksum != "" {
+		// Failing to cache the schema is not fatal: the next load will just be slower.
+		if err := writeCachedSchema(schemaPath, checksum, schemaBytes); err != nil {
+			logging.V(5).Infof("failed to cache schema %s: %v", schemaPath, err)
+		}
 	}
 
-	p, err := importSpec(spec, nil, l)
+	return schemaBytes, nil
+}
+
+// cacheEntry returns the path of the cached schema for the given package and the checksum of the plugin binary that
+// the schema must have been read from to be used. If the schema cannot be cached, the checksum is empty.
+func (l *pluginLoader) cacheEntry(pkg string, version *semver.Version) (string, string) {
+	if l.cacheDir == "" {
+		return "", ""
+	}
+
+	binary, err := l.pluginPath(pkg, version)
+	if err != nil || binary == "" {
+		return "", ""
+	}
+	checksum, err := fileChecksum(binary)
 	if err != nil {
-		return nil, err
+		logging.V(5).Infof("failed to compute the checksum of %s: %v", binary, err)
+		return "", ""
 	}
 
-	l.m.Lock()
-	defer l.m.Unlock()
+	name := pkg
+	if version != nil {
+		name += "-" + version.String()
+	}
+	return filepath.Join(l.cacheDir, name+".json"), checksum
+}
+
+// resourcePluginPath returns the path to the binary for the given resource plugin, or the empty string if the plugin is
+// not installed.
+func resourcePluginPath(pkg string, version *semver.Version) (string, error) {
+	_, path, err := workspace.GetPluginPath(workspace.ResourcePlugin, pkg, version)
+	return path, err
+}
+
+// fileChecksum returns the hex-encoded SHA-256 hash of the contents of the given file.
+func fileChecksum(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer contract.IgnoreClose(f)
 
-	if p, ok := l.entries[pkg]; ok {
-		return p, nil
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return "", err
 	}
-	l.entries[key] = p
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// checksumPath returns the path of the file that records the checksum of the plugin a cached schema was read from.
+func checksumPath(schemaPath string) string {
+	return strings.TrimSuffix(schemaPath, ".json") + ".sha256"
+}
+
+// readCachedSchema reads the cached schema at the given path if it was read from a plugin with the given checksum.
+func readCachedSchema(schemaPath, checksum string) ([]byte, bool) {
+	cached, err := ioutil.ReadFile(checksumPath(schemaPath))
+	if err != nil || strings.TrimSpace(string(cached)) != checksum {
+		return nil, false
+	}
+	schemaBytes, err := ioutil.ReadFile(schemaPath)
+	if err != nil {
+		return nil, false
+	}
+	return schemaBytes, true
+}
+
+// writeCachedSchema caches a schema that was read from a plugin with the given checksum. The old checksum is removed
+// before the schema is replaced and the new checksum is only written once the schema is in place, so concurrent
+// readers never pair a checksum with the wrong schema.
+func writeCachedSchema(schemaPath, checksum string, schemaBytes []byte) error {
+	if err := os.MkdirAll(filepath.Dir(schemaPath), 0700); err != nil {
+		return err
+	}
+	if err := os.Remove(checksumPath(schemaPath)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if err := writeFileAtomic(schemaPath, schemaBytes); err != nil {
+		return err
+	}
+	return writeFileAtomic(checksumPath(schemaPath), []byte(checksum))
+}
 
-	return p, nil
+// writeFileAtomic writes a file by writing to a temporary file and moving it into place.
+func writeFileAtomic(path string, contents []byte) error {
+	tmp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.Remove(tmp.Name())
+	}()
+
+	if _, err = tmp.Write(contents); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
 }
@@ -0,0 +1,122 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/blang/semver"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/pkg/v2/resource/deploy/deploytest"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+)
+
+func TestPluginLoaderCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "schema-cache")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	cacheDir := filepath.Join(dir, "schemas")
+	binary := filepath.Join(dir, "pulumi-resource-example")
+	if !assert.NoError(t, ioutil.WriteFile(binary, []byte("v1"), 0600)) {
+		return
+	}
+
+	var calls int32
+	host := deploytest.NewPluginHost(nil, nil, nil,
+		deploytest.NewProviderLoader("example", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				GetSchemaF: func(version int) ([]byte, error) {
+					atomic.AddInt32(&calls, 1)
+					return []byte(`{"name": "example", "version": "1.0.0"}`), nil
+				},
+			}, nil
+		}))
+
+	newLoader := func() *pluginLoader {
+		l := newPluginLoader(host, cacheDir)
+		l.pluginPath = func(pkg string, version *semver.Version) (string, error) {
+			return binary, nil
+		}
+		return l
+	}
+
+	// The first load reads the schema from the plugin and caches it.
+	pkg, err := newLoader().LoadPackage("example", nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "example", pkg.Name)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	assert.FileExists(t, filepath.Join(cacheDir, "example.json"))
+	assert.FileExists(t, filepath.Join(cacheDir, "example.sha256"))
+
+	// Concurrent loads by a new loader share the cached schema.
+	packages, err := LoadPackages(newLoader(), map[string]*semver.Version{"example": nil})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "example", packages["example"].Name)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	// Reinstalling the plugin invalidates the cached schema.
+	if !assert.NoError(t, ioutil.WriteFile(binary, []byte("v2"), 0600)) {
+		return
+	}
+	_, err = newLoader().LoadPackage("example", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+
+	// Loaders without a cache directory always read the schema from the plugin.
+	_, err = NewPluginLoader(host).LoadPackage("example", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+}
+
+func TestPluginLoaderConcurrentLoads(t *testing.T) {
+	var calls int32
+	host := deploytest.NewPluginHost(nil, nil, nil,
+		deploytest.NewProviderLoader("example", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				GetSchemaF: func(version int) ([]byte, error) {
+					atomic.AddInt32(&calls, 1)
+					return []byte(`{"name": "example", "version": "1.0.0"}`), nil
+				},
+			}, nil
+		}))
+
+	loader := NewPluginLoader(host)
+	done := make(chan *Package)
+	for i := 0; i < 8; i++ {
+		go func() {
+			pkg, err := loader.LoadPackage("example", nil)
+			assert.NoError(t, err)
+			done <- pkg
+		}()
+	}
+
+	first := <-done
+	for i := 1; i < 8; i++ {
+		assert.Same(t, first, <-done)
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
@@ -279,6 +279,8 @@ type Property struct {
 	Language map[string]interface{}
 	// Secret is true if the property is secret (default false).
 	Secret bool
+	// IsPlain is true if the property's value must be a plain value rather than an Output when used as an input.
+	IsPlain bool
 }
 
 // Alias describes an alias for a Pulumi resource.
@@ -315,6 +317,17 @@ type Resource struct {
 	Language map[string]interface{}
 	// IsComponent indicates whether the resource is a ComponentResource.
 	IsComponent bool
+	// Methods is the list of methods for the resource.
+	Methods []*Method
+}
+
+// Method describes a method on a resource.
+type Method struct {
+	// Name is the name of the method.
+	Name string
+	// Function is the function definition for the method. Its inputs include the `__self__` argument, which refers to
+	// the resource on which the method is called.
+	Function *Function
 }
 
 // Function describes a Pulumi function.
@@ -333,6 +346,8 @@ type Function struct {
 	DeprecationMessage string
 	// Language specifies additional language-specific data about the function.
 	Language map[string]interface{}
+	// IsMethod indicates whether the function is a method of a resource.
+	IsMethod bool
 }
 
 // Package describes a Pulumi package.
@@ -650,6 +665,9 @@ type TypeSpec struct {
 	Items *TypeSpec `json:"items,omitempty"`
 	// OneOf indicates that values of the type may be one of any of the listed types.
 	OneOf []TypeSpec `json:"oneOf,omitempty"`
+	// Plain indicates that when used as an input, values of the type must be plain values rather than Outputs
+	// (default false).
+	Plain bool `json:"plain,omitempty"`
 }
 
 // DefaultSpec is the serializable form of extra information about the default value for a property.
@@ -746,6 +764,8 @@ type ResourceSpec struct {
 	Language map[string]json.RawMessage `json:"language,omitempty"`
 	// IsComponent indicates whether the resource is a ComponentResource.
 	IsComponent bool `json:"isComponent,omitempty"`
+	// Methods maps method names to functions in this schema.
+	Methods map[string]string `json:"methods,omitempty"`
 }
 
 // FunctionSpec is the serializable form of a function description.
@@ -859,7 +879,7 @@ func importSpec(spec PackageSpec, languages map[string]Language, loader Loader)
 		}
 		defer contract.IgnoreClose(ctx)
 
-		loader = NewPluginLoader(ctx.Host)
+		loader = NewCachingPluginLoader(ctx.Host)
 	}
 
 	types, err := bindTypes(pkg, spec.Types, loader)
@@ -887,6 +907,15 @@ func importSpec(spec PackageSpec, languages map[string]Language, loader Loader)
 		return nil, errors.Wrap(err, "binding functions")
 	}
 
+	if err := bindMethods(provider, spec.Provider, functionTable); err != nil {
+		return nil, errors.Wrap(err, "binding provider methods")
+	}
+	for token, res := range resourceTable {
+		if err := bindMethods(res, spec.Resources[token], functionTable); err != nil {
+			return nil, errors.Wrapf(err, "binding methods for resource %v", token)
+		}
+	}
+
 	// Build the type list.
 	var typeList []Type
 	for _, t := range types.resources {
@@ -949,8 +978,13 @@ func importSpec(spec PackageSpec, languages map[string]Language, loader Loader)
 
 }
 
-// ImportSpec converts a serializable PackageSpec into a Package.
+// ImportSpec converts a serializable PackageSpec into a Package. The spec is validated using ValidateSpec before it is
+// bound.
 func ImportSpec(spec PackageSpec, languages map[string]Language) (*Package, error) {
+	if err := ValidateSpec(spec); err != nil {
+		return nil, err
+	}
+
 	// Call the internal implementation that includes a loader parameter.
 	return importSpec(spec, languages, nil)
 }
@@ -1322,6 +1356,24 @@ func bindDefaultValue(value interface{}, spec *DefaultSpec, typ Type) (*DefaultV
 
 // bindProperties binds the map of property specs and list of required properties into a sorted list of properties and
 // a lookup table.
+// hasPlainElementType returns true if any of the element types of the given type are marked plain. The bound type
+// model does not track plainness per element, so plain element types are only accepted inside plain properties, where
+// the entire value is already plain.
+func hasPlainElementType(spec TypeSpec) bool {
+	switch {
+	case spec.Items != nil:
+		return spec.Items.Plain || hasPlainElementType(*spec.Items)
+	case spec.AdditionalProperties != nil:
+		return spec.AdditionalProperties.Plain || hasPlainElementType(*spec.AdditionalProperties)
+	}
+	for _, t := range spec.OneOf {
+		if t.Plain || hasPlainElementType(t) {
+			return true
+		}
+	}
+	return false
+}
+
 func (t *types) bindProperties(properties map[string]PropertySpec,
 	required []string) ([]*Property, map[string]*Property, error) {
 
@@ -1333,6 +1385,9 @@ func (t *types) bindProperties(properties map[string]PropertySpec,
 		if err != nil {
 			return nil, nil, errors.Wrapf(err, "error binding type for property %q", name)
 		}
+		if !spec.Plain && hasPlainElementType(spec.TypeSpec) {
+			return nil, nil, errors.Errorf("property %q has a plain element type but is not plain", name)
+		}
 
 		cv, err := bindConstValue(spec.Const, typ)
 		if err != nil {
@@ -1358,6 +1413,7 @@ func (t *types) bindProperties(properties map[string]PropertySpec,
 			DeprecationMessage: spec.DeprecationMessage,
 			Language:           language,
 			Secret:             spec.Secret,
+			IsPlain:            spec.Plain,
 		}
 
 		propertyMap[name], result = p, append(result, p)
@@ -1687,3 +1743,47 @@ func bindFunctions(specs map[string]FunctionSpec, types *types) ([]*Function, ma
 
 	return functions, functionTable, nil
 }
+
+// bindMethods binds the methods of a resource to the functions that implement them. Each function must accept a
+// `__self__` input that refers to the resource on which the method is called.
+func bindMethods(res *Resource, spec ResourceSpec, functionTable map[string]*Function) error {
+	names := make([]string, 0, len(spec.Methods))
+	for name := range spec.Methods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		token := spec.Methods[name]
+		function, ok := functionTable[token]
+		if !ok {
+			return errors.Errorf("unknown function %v for method %v", token, name)
+		}
+		if function.IsMethod {
+			return errors.Errorf("function %v for method %v is already a method", token, name)
+		}
+		if !hasSelfInput(function) {
+			return errors.Errorf("function %v for method %v must have a __self__ input", token, name)
+		}
+		for _, p := range res.Properties {
+			if p.Name == name {
+				return errors.Errorf("method %v conflicts with a property of the same name", name)
+			}
+		}
+		function.IsMethod = true
+		res.Methods = append(res.Methods, &Method{Name: name, Function: function})
+	}
+	return nil
+}
+
+func hasSelfInput(function *Function) bool {
+	if function.Inputs == nil {
+		return false
+	}
+	for _, p := range function.Inputs.Properties {
+		if p.Name == "__self__" {
+			return true
+		}
+	}
+	return false
+}
@@ -124,6 +124,54 @@ func TestEnums(t *testing.T) {
 	}
 }
 
+func TestMethods(t *testing.T) {
+	pkgSpec := readSchemaFile(filepath.Join("simple-methods-schema", "schema.json"))
+
+	pkg, err := ImportSpec(pkgSpec, nil)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	res, ok := pkg.GetResource("example::Foo")
+	assert.True(t, ok)
+	if assert.Len(t, res.Methods, 2) {
+		assert.Equal(t, "bar", res.Methods[0].Name)
+		assert.Equal(t, "example::Foo/bar", res.Methods[0].Function.Token)
+		assert.True(t, res.Methods[0].Function.IsMethod)
+		assert.Equal(t, "baz", res.Methods[1].Name)
+	}
+
+	// A method must refer to a function that accepts `__self__`.
+	bar := pkgSpec.Functions["example::Foo/bar"]
+	delete(bar.Inputs.Properties, "__self__")
+	_, err = ImportSpec(pkgSpec, nil)
+	assert.Error(t, err)
+}
+
+func TestPlainProperties(t *testing.T) {
+	pkgSpec := readSchemaFile(filepath.Join("simple-plain-schema", "schema.json"))
+
+	pkg, err := ImportSpec(pkgSpec, nil)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	res, ok := pkg.GetResource("example::Component")
+	assert.True(t, ok)
+	plain := map[string]bool{}
+	for _, p := range res.InputProperties {
+		plain[p.Name] = p.IsPlain
+	}
+	assert.Equal(t, map[string]bool{"subnets": true, "replicas": true, "config": true, "name": false}, plain)
+
+	// A plain element type is only allowed within a plain property.
+	subnets := pkgSpec.Resources["example::Component"].InputProperties["subnets"]
+	subnets.Plain = false
+	pkgSpec.Resources["example::Component"].InputProperties["subnets"] = subnets
+	_, err = ImportSpec(pkgSpec, nil)
+	assert.Error(t, err)
+}
+
 func TestImportResourceRef(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -0,0 +1,492 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate go run gen_metaschema.go
+
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/url"
+	"path"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/blang/semver"
+	"github.com/pkg/errors"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// ValidationError describes a problem with a package schema.
+type ValidationError struct {
+	// Pointer is a JSON pointer (RFC 6901) to the location of the problem within the schema.
+	Pointer string
+	// Message describes the problem.
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	if e.Pointer == "" {
+		return e.Message
+	}
+	return fmt.Sprintf("%s: %s", e.Pointer, e.Message)
+}
+
+// ValidationErrors is the list of problems found when validating a package schema.
+type ValidationErrors []*ValidationError
+
+func (errs ValidationErrors) Error() string {
+	messages := make([]string, len(errs))
+	for i, e := range errs {
+		messages[i] = e.Error()
+	}
+	return fmt.Sprintf("%d schema validation errors:\n%s", len(errs), strings.Join(messages, "\n"))
+}
+
+var (
+	metaSchemaOnce sync.Once
+	metaSchema     *gojsonschema.Schema
+	metaSchemaErr  error
+)
+
+// loadMetaSchema compiles the package metaschema.
+func loadMetaSchema() (*gojsonschema.Schema, error) {
+	metaSchemaOnce.Do(func() {
+		metaSchema, metaSchemaErr = gojsonschema.NewSchema(gojsonschema.NewStringLoader(MetaSchema))
+	})
+	return metaSchema, metaSchemaErr
+}
+
+// ValidateJSON validates the JSON encoding of a package schema against the package metaschema and checks the
+// references, tokens, enum values and module format of the schema. Unlike ValidateSpec, ValidateJSON reports unknown
+// and duplicate keys. If the schema is invalid, the returned error is a ValidationErrors value.
+func ValidateJSON(data []byte) error {
+	if !json.Valid(data) {
+		var v interface{}
+		return errors.Wrap(json.Unmarshal(data, &v), "invalid JSON")
+	}
+
+	v := &validator{}
+	v.checkDuplicateKeys(data)
+	duplicates := len(v.errors)
+	if err := v.checkMetaSchema(gojsonschema.NewBytesLoader(data)); err != nil {
+		return err
+	}
+
+	// If the document does not match the metaschema, it may not be decodable into a PackageSpec.
+	if len(v.errors) == duplicates {
+		var spec PackageSpec
+		if err := json.Unmarshal(data, &spec); err != nil {
+			return errors.Wrap(err, "decoding schema")
+		}
+		v.checkSpec(spec)
+	}
+	return v.result()
+}
+
+// ValidateSpec validates a package spec against the package metaschema and checks the references, tokens, enum values
+// and module format of the schema. If the schema is invalid, the returned error is a ValidationErrors value.
+func ValidateSpec(spec PackageSpec) error {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return errors.Wrap(err, "encoding schema")
+	}
+
+	v := &validator{}
+	if err := v.checkMetaSchema(gojsonschema.NewBytesLoader(data)); err != nil {
+		return err
+	}
+	v.checkSpec(spec)
+	return v.result()
+}
+
+type validator struct {
+	errors ValidationErrors
+}
+
+func (v *validator) errorf(pointer, format string, args ...interface{}) {
+	v.errors = append(v.errors, &ValidationError{Pointer: pointer, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) result() error {
+	if len(v.errors) == 0 {
+		return nil
+	}
+
+	// Order the errors by location, removing duplicates.
+	sort.SliceStable(v.errors, func(i, j int) bool {
+		return v.errors[i].Pointer < v.errors[j].Pointer
+	})
+	var errs ValidationErrors
+	for i, e := range v.errors {
+		if i == 0 || *e != *v.errors[i-1] {
+			errs = append(errs, e)
+		}
+	}
+	return errs
+}
+
+// pointer returns a JSON pointer to the location identified by the given reference tokens.
+func pointer(tokens ...string) string {
+	var b strings.Builder
+	for _, t := range tokens {
+		b.WriteByte('/')
+		b.WriteString(strings.ReplaceAll(strings.ReplaceAll(t, "~", "~0"), "/", "~1"))
+	}
+	return b.String()
+}
+
+// checkMetaSchema validates the given document against the metaschema.
+func (v *validator) checkMetaSchema(document gojsonschema.JSONLoader) error {
+	metaSchema, err := loadMetaSchema()
+	if err != nil {
+		return errors.Wrap(err, "loading metaschema")
+	}
+
+	result, err := metaSchema.Validate(document)
+	if err != nil {
+		return errors.Wrap(err, "validating schema")
+	}
+
+	for _, e := range result.Errors() {
+		// Skip errors that only summarize the errors of nested schemas.
+		if e.Type() == "number_any_of" || e.Type() == "number_one_of" {
+			continue
+		}
+
+		// The context is of the form "(root).a.b", but the keys may themselves contain periods, so split the context
+		// on a delimiter that cannot appear in a key instead.
+		tokens := strings.Split(e.Context().String("\x00"), "\x00")[1:]
+		message := e.Description()
+		if e.Type() == "additional_property_not_allowed" {
+			tokens = append(tokens, fmt.Sprint(e.Details()["property"]))
+			message = "unknown property"
+		}
+		v.errorf(pointer(tokens...), "%s", message)
+	}
+	return nil
+}
+
+// checkDuplicateKeys reports any object keys that appear more than once in the given JSON document. Duplicate keys
+// are otherwise silently ignored by the decoder, which keeps only the last value.
+func (v *validator) checkDuplicateKeys(data []byte) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var walk func(tokens []string) error
+	walk = func(tokens []string) error {
+		t, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+
+		switch t {
+		case json.Delim('{'):
+			seen := map[string]bool{}
+			for decoder.More() {
+				k, err := decoder.Token()
+				if err != nil {
+					return err
+				}
+				key := k.(string)
+				if seen[key] {
+					v.errorf(pointer(append(tokens, key)...), "duplicate key %q", key)
+				}
+				seen[key] = true
+
+				if err = walk(append(tokens, key)); err != nil {
+					return err
+				}
+			}
+		case json.Delim('['):
+			for i := 0; decoder.More(); i++ {
+				if err = walk(append(tokens, strconv.Itoa(i))); err != nil {
+					return err
+				}
+			}
+		default:
+			return nil
+		}
+
+		// Consume the closing delimiter.
+		_, err = decoder.Token()
+		return err
+	}
+
+	if err := walk(nil); err != nil && err != io.EOF {
+		v.errorf("", "%v", err)
+	}
+}
+
+// checkSpec checks the parts of a package spec that cannot be described by the metaschema.
+func (v *validator) checkSpec(spec PackageSpec) {
+	if spec.Version != "" {
+		if _, err := semver.ParseTolerant(spec.Version); err != nil {
+			v.errorf(pointer("version"), "invalid version: %v", err)
+		}
+	}
+
+	if spec.Meta != nil && spec.Meta.ModuleFormat != "" {
+		// Module formats that fail to compile are reported by the metaschema.
+		if re, err := regexp.Compile(spec.Meta.ModuleFormat); err == nil && re.NumSubexp() < 1 {
+			v.errorf(pointer("meta", "moduleFormat"), "the module format must define a capturing group")
+		}
+	}
+
+	v.checkProperties(spec, []string{"config", "variables"}, spec.Config.Variables)
+	v.checkRequired([]string{"config", "defaults"}, spec.Config.Required, spec.Config.Variables)
+
+	v.checkResource(spec, []string{"provider"}, spec.Provider)
+
+	// Resources and functions share a namespace, as do tokens that differ only in case: the code generators derive file
+	// names from tokens, and those may be case-insensitive. Object types may share a token with a resource in order to
+	// describe the resource's shape.
+	defined := map[string]map[string]string{}
+	checkToken := func(section, token string) {
+		p := pointer(section, token)
+
+		components := strings.Split(token, ":")
+		switch {
+		case len(components) != 3:
+			v.errorf(p, "invalid token %q: tokens must be of the form package:module:member", token)
+		case spec.Name != "" && components[0] != spec.Name:
+			v.errorf(p, "invalid token %q: the package component must be %q", token, spec.Name)
+		}
+
+		namespace := section
+		if section == "functions" {
+			namespace = "resources"
+		}
+		if defined[namespace] == nil {
+			defined[namespace] = map[string]string{}
+		}
+		key := strings.ToLower(token)
+		if other, ok := defined[namespace][key]; ok {
+			v.errorf(p, "duplicate token %q: conflicts with %s", token, other)
+		} else {
+			defined[namespace][key] = pointer(section, token)
+		}
+	}
+
+	for _, token := range sortedKeys(spec.Types) {
+		checkToken("types", token)
+		v.checkType(spec, token, spec.Types[token])
+	}
+	for _, token := range sortedKeys(spec.Resources) {
+		checkToken("resources", token)
+		v.checkResource(spec, []string{"resources", token}, spec.Resources[token])
+	}
+	for _, token := range sortedKeys(spec.Functions) {
+		checkToken("functions", token)
+
+		f := spec.Functions[token]
+		if f.Inputs != nil {
+			v.checkObjectType(spec, []string{"functions", token, "inputs"}, *f.Inputs)
+		}
+		if f.Outputs != nil {
+			v.checkObjectType(spec, []string{"functions", token, "outputs"}, *f.Outputs)
+		}
+	}
+}
+
+func (v *validator) checkType(spec PackageSpec, token string, t ComplexTypeSpec) {
+	location := []string{"types", token}
+
+	if len(t.Enum) == 0 {
+		if t.Type != "object" {
+			v.errorf(pointer(append(location, "type")...),
+				"type must be \"object\" unless the type is an enum, but is %q", t.Type)
+		}
+		v.checkObjectType(spec, location, t.ObjectTypeSpec)
+		return
+	}
+
+	if t.Type == "object" {
+		v.errorf(pointer(append(location, "type")...), "enums must have a primitive type")
+		return
+	}
+	for i, e := range t.Enum {
+		if e == nil {
+			continue
+		}
+		if !enumValueHasType(e.Value, t.Type) {
+			v.errorf(pointer(append(location, "enum", strconv.Itoa(i), "value")...),
+				"enum value %#v is not of type %q", e.Value, t.Type)
+		}
+	}
+}
+
+// enumValueHasType returns true if the given enum value is of the given primitive type.
+func enumValueHasType(value interface{}, typ string) bool {
+	switch typ {
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "integer", "number":
+		var f float64
+		switch value := value.(type) {
+		case int:
+			return true
+		case int32:
+			return true
+		case int64:
+			return true
+		case float32:
+			f = float64(value)
+		case float64:
+			f = value
+		case json.Number:
+			n, err := value.Float64()
+			if err != nil {
+				return false
+			}
+			f = n
+		default:
+			return false
+		}
+		return typ == "number" || f == math.Trunc(f)
+	default:
+		return false
+	}
+}
+
+func (v *validator) checkResource(spec PackageSpec, location []string, r ResourceSpec) {
+	v.checkObjectType(spec, location, r.ObjectTypeSpec)
+	v.checkProperties(spec, append(location, "inputProperties"), r.InputProperties)
+	v.checkRequired(append(location, "requiredInputs"), r.RequiredInputs, r.InputProperties)
+	if r.StateInputs != nil {
+		v.checkObjectType(spec, append(location, "stateInputs"), *r.StateInputs)
+	}
+	for _, name := range sortedKeys(r.Methods) {
+		if _, ok := spec.Functions[r.Methods[name]]; !ok {
+			v.errorf(pointer(append(location, "methods", name)...), "function %q is not defined", r.Methods[name])
+		}
+	}
+}
+
+func (v *validator) checkObjectType(spec PackageSpec, location []string, t ObjectTypeSpec) {
+	v.checkProperties(spec, append(location, "properties"), t.Properties)
+	v.checkRequired(append(location, "required"), t.Required, t.Properties)
+}
+
+func (v *validator) checkProperties(spec PackageSpec, location []string, properties map[string]PropertySpec) {
+	for _, name := range sortedKeys(properties) {
+		v.checkTypeSpec(spec, append(location, name), properties[name].TypeSpec)
+	}
+}
+
+// checkRequired checks that each of the given required property names refers to a property.
+func (v *validator) checkRequired(location []string, required []string, properties map[string]PropertySpec) {
+	for i, name := range required {
+		if _, ok := properties[name]; !ok {
+			v.errorf(pointer(append(location, strconv.Itoa(i))...), "unknown required property %q", name)
+		}
+	}
+}
+
+// checkTypeSpec checks the type references within the given type.
+func (v *validator) checkTypeSpec(spec PackageSpec, location []string, t TypeSpec) {
+	// Copy the location so that appends to it from sibling types do not interfere with each other.
+	location = append([]string{}, location...)
+
+	if t.Ref != "" {
+		if message := checkRef(spec, t); message != "" {
+			v.errorf(pointer(append(location, "$ref")...), "%s", message)
+		}
+	}
+	if t.Items != nil {
+		v.checkTypeSpec(spec, append(location, "items"), *t.Items)
+	}
+	if t.AdditionalProperties != nil {
+		v.checkTypeSpec(spec, append(location, "additionalProperties"), *t.AdditionalProperties)
+	}
+	for i, e := range t.OneOf {
+		v.checkTypeSpec(spec, append(location, "oneOf", strconv.Itoa(i)), e)
+	}
+}
+
+// checkRef checks that the given type reference is well-formed and, if it refers to this package, that the type or
+// resource it refers to is defined. References to other packages are not resolved. Returns a description of the
+// problem with the reference, if any.
+func checkRef(spec PackageSpec, t TypeSpec) string {
+	switch t.Ref {
+	case "pulumi.json#/Archive", "pulumi.json#/Asset", "pulumi.json#/Json", "pulumi.json#/Any":
+		return ""
+	}
+
+	ref, err := url.Parse(t.Ref)
+	if err != nil {
+		return fmt.Sprintf("invalid type reference %q: %v", t.Ref, err)
+	}
+	if ref.Path != "" {
+		if p, err := url.PathUnescape(ref.Path); err != nil || !refPathRegex.MatchString(p) {
+			return fmt.Sprintf("invalid type reference %q: references to other packages must be of the form "+
+				"/package/vX.Y.Z/schema.json#/types/token", t.Ref)
+		}
+		return ""
+	}
+
+	fragment := path.Clean(ref.EscapedFragment())
+	fragment = strings.TrimPrefix(fragment, "/")
+	kind, token := fragment, ""
+	if slash := strings.Index(fragment, "/"); slash != -1 {
+		kind, token = fragment[:slash], fragment[slash+1:]
+	}
+	if token, err = url.PathUnescape(token); err != nil {
+		return fmt.Sprintf("invalid type reference %q: %v", t.Ref, err)
+	}
+
+	switch {
+	case kind == "provider" && token == "":
+		return ""
+	case kind == "types" && token != "":
+		// References to undefined types with a primitive type are permitted; they are treated as opaque tokens.
+		if _, ok := spec.Types[token]; !ok && t.Type == "" {
+			return fmt.Sprintf("type %q is not defined", token)
+		}
+		return ""
+	case kind == "resources" && token != "":
+		if _, ok := spec.Resources[token]; !ok {
+			return fmt.Sprintf("resource %q is not defined", token)
+		}
+		return ""
+	default:
+		return fmt.Sprintf("invalid type reference %q: references must be of the form #/types/token, "+
+			"#/resources/token or #/provider", t.Ref)
+	}
+}
+
+// sortedKeys returns the sorted keys of the given map. The map's key type must be of kind string.
+func sortedKeys(m interface{}) []string {
+	mv := reflect.ValueOf(m)
+
+	keys := make([]string, mv.Len())
+	for i, k := range mv.MapKeys() {
+		keys[i] = k.String()
+	}
+	sort.Strings(keys)
+
+	return keys
+}
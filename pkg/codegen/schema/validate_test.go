@@ -0,0 +1,217 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetaSchemaIsUpToDate(t *testing.T) {
+	contents, err := ioutil.ReadFile("pulumi.json")
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.JSONEq(t, string(contents), MetaSchema, "metaschema.go is out of date; run `go generate`")
+}
+
+func TestValidateTestdata(t *testing.T) {
+	files := []string{"kubernetes.json", "random.json"}
+	for _, dir := range []string{"external-resource-schema", "simple-enum-schema", "simple-methods-schema",
+		"simple-resource-schema"} {
+		files = append(files, filepath.Join(dir, "schema.json"))
+	}
+	for _, file := range files {
+		contents, err := ioutil.ReadFile(filepath.Join("..", "internal", "test", "testdata", file))
+		if !assert.NoError(t, err) {
+			continue
+		}
+		assert.NoError(t, ValidateJSON(contents), file)
+	}
+}
+
+func TestValidateJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		schema   string
+		expected []string
+	}{
+		{
+			name:     "missing name",
+			schema:   `{"version": "1.0.0"}`,
+			expected: []string{"name is required"},
+		},
+		{
+			name: "unknown keys",
+			schema: `{
+				"name": "example",
+				"versoin": "1.0.0",
+				"resources": {
+					"example:index:Resource": {"inputProperties": {"a": {"type": "string", "requried": true}}}
+				}
+			}`,
+			expected: []string{
+				"/resources/example:index:Resource/inputProperties/a/requried: unknown property",
+				"/versoin: unknown property",
+			},
+		},
+		{
+			name: "bad references",
+			schema: `{
+				"name": "example",
+				"types": {
+					"example:index:Obj": {
+						"type": "object",
+						"properties": {
+							"a": {"$ref": "#/types/example:index:Missing"},
+							"b": {"type": "array", "items": {"$ref": "#/resources/example:index:Missing"}},
+							"c": {"$ref": "#/things/example:index:Obj"},
+							"d": {"$ref": "/other/schema.json#/types/other:index:Obj"},
+							"e": {"$ref": "#/types/example:index:Token", "type": "string"},
+							"f": {"$ref": "/other/v1.0.0/schema.json#/types/other:index:Obj"}
+						},
+						"required": ["g"]
+					}
+				}
+			}`,
+			expected: []string{
+				`/types/example:index:Obj/properties/a/$ref: type "example:index:Missing" is not defined`,
+				`/types/example:index:Obj/properties/b/items/$ref: resource "example:index:Missing" is not defined`,
+				`/types/example:index:Obj/properties/c/$ref: invalid type reference "#/things/example:index:Obj": ` +
+					`references must be of the form #/types/token, #/resources/token or #/provider`,
+				`/types/example:index:Obj/properties/d/$ref: invalid type reference ` +
+					`"/other/schema.json#/types/other:index:Obj": references to other packages must be of the form ` +
+					`/package/vX.Y.Z/schema.json#/types/token`,
+				`/types/example:index:Obj/required/0: unknown required property "g"`,
+			},
+		},
+		{
+			name: "duplicate tokens",
+			schema: `{
+				"name": "example",
+				"resources": {
+					"example:index:Thing": {},
+					"example:index:thing": {},
+					"example:index:Other": {},
+					"example:index:Other": {}
+				},
+				"functions": {
+					"example:index:Thing": {}
+				},
+				"types": {
+					"example:index:Thing": {"type": "object"}
+				}
+			}`,
+			expected: []string{
+				`/functions/example:index:Thing: duplicate token "example:index:Thing": conflicts with ` +
+					`/resources/example:index:Thing`,
+				`/resources/example:index:Other: duplicate key "example:index:Other"`,
+				`/resources/example:index:thing: duplicate token "example:index:thing": conflicts with ` +
+					`/resources/example:index:Thing`,
+			},
+		},
+		{
+			name: "invalid tokens",
+			schema: `{
+				"name": "example",
+				"resources": {
+					"example:Resource": {},
+					"other:index:Resource": {}
+				}
+			}`,
+			expected: []string{
+				`/resources/example:Resource: invalid token "example:Resource": tokens must be of the form ` +
+					`package:module:member`,
+				`/resources/other:index:Resource: invalid token "other:index:Resource": the package component must ` +
+					`be "example"`,
+			},
+		},
+		{
+			name: "enum values",
+			schema: `{
+				"name": "example",
+				"types": {
+					"example:index:Size": {"type": "integer", "enum": [{"value": 1}, {"value": 1.5}, {"value": "2"}]},
+					"example:index:Color": {"type": "string", "enum": [{"value": "red"}, {"value": true}]},
+					"example:index:Bad": {"type": "object", "enum": [{"value": "a"}]},
+					"example:index:None": {"type": "string"}
+				}
+			}`,
+			expected: []string{
+				`/types/example:index:Bad/type: enums must have a primitive type`,
+				`/types/example:index:Color/enum/1/value: enum value true is not of type "string"`,
+				`/types/example:index:None/type: type must be "object" unless the type is an enum, but is "string"`,
+				`/types/example:index:Size/enum/1/value: enum value 1.5 is not of type "integer"`,
+				`/types/example:index:Size/enum/2/value: enum value "2" is not of type "integer"`,
+			},
+		},
+		{
+			name: "module formats",
+			schema: `{
+				"name": "example",
+				"meta": {"moduleFormat": ".*"}
+			}`,
+			expected: []string{
+				"/meta/moduleFormat: the module format must define a capturing group",
+			},
+		},
+		{
+			name: "invalid module format",
+			schema: `{
+				"name": "example",
+				"meta": {"moduleFormat": "(.*"}
+			}`,
+			expected: []string{
+				"/meta/moduleFormat: Does not match format 'regex'",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateJSON([]byte(tt.schema))
+			if !assert.Error(t, err) {
+				return
+			}
+			errs, ok := err.(ValidationErrors)
+			if !assert.True(t, ok, "expected ValidationErrors, got %v", err) {
+				return
+			}
+
+			var actual []string
+			for _, e := range errs {
+				actual = append(actual, e.Error())
+			}
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestImportSpecValidates(t *testing.T) {
+	_, err := ImportSpec(PackageSpec{
+		Name: "example",
+		Resources: map[string]ResourceSpec{
+			"example:index:Resource": {
+				InputProperties: map[string]PropertySpec{
+					"a": {TypeSpec: TypeSpec{Ref: "#/types/example:index:Missing"}},
+				},
+			},
+		},
+	}, nil)
+	assert.EqualError(t, err, "1 schema validation errors:\n"+
+		`/resources/example:index:Resource/inputProperties/a/$ref: type "example:index:Missing" is not defined`)
+}
@@ -352,11 +352,16 @@ func GetResourceOutputsPropertiesString(
 	}
 	op := step.Op
 
+	// Don't display the types recorded for the stack's typed outputs.
+	isStackOutputTypes := func(k resource.PropertyKey) bool {
+		return step.URN.Type() == resource.RootStackType && k == resource.StackOutputTypesKey
+	}
+
 	// If there was an old state associated with this step, we may have old outputs. If we do, and if they differ from
 	// the new outputs, we want to print the diffs.
 	var outputDiff *resource.ObjectDiff
 	if step.Old != nil && step.Old.Outputs != nil {
-		outputDiff = step.Old.Outputs.Diff(outs, resource.IsInternalPropertyKey)
+		outputDiff = step.Old.Outputs.Diff(outs, resource.IsInternalPropertyKey, isStackOutputTypes)
 
 		// If this is the root stack type, we want to strip out any nested resource outputs that are not known if
 		// they have no corresponding output in the old state.
@@ -382,6 +387,9 @@ func GetResourceOutputsPropertiesString(
 
 	// Now sort the keys and enumerate each output property in a deterministic order.
 	for _, k := range keys {
+		if isStackOutputTypes(k) {
+			continue
+		}
 		out := outs[k]
 
 		// Print this property if it is printable and if any of the following are true:
@@ -204,6 +204,15 @@ func (p *builtinProvider) StreamInvoke(
 	return nil, fmt.Errorf("the builtin provider does not implement streaming invokes")
 }
 
+func (p *builtinProvider) Call(tok tokens.ModuleMember, args resource.PropertyMap, info plugin.CallInfo,
+	options plugin.CallOptions) (plugin.CallResult, error) {
+	return plugin.CallResult{}, errors.New("the builtin provider does not implement call")
+}
+
+func (p *builtinProvider) List(typ tokens.Type, filters map[string]string) ([]resource.ID, error) {
+	return nil, errors.New("the builtin provider does not implement list")
+}
+
 func (p *builtinProvider) GetPluginInfo() (workspace.PluginInfo, error) {
 	// return an error: this should not be called for the builtin provider
 	return workspace.PluginInfo{}, errors.New("the builtin provider does not report plugin info")
@@ -228,6 +237,14 @@ func (p *builtinProvider) readStackReference(inputs resource.PropertyMap) (resou
 		return nil, err
 	}
 
+	// If the referenced stack recorded the types of its outputs, return them separately from the outputs
+	// themselves.
+	var outputTypes resource.PropertyValue
+	if types, ok := outputs[resource.StackOutputTypesKey]; ok {
+		outputTypes, outputs = types, outputs.Copy()
+		delete(outputs, resource.StackOutputTypesKey)
+	}
+
 	secretOutputs := make([]resource.PropertyValue, 0)
 	for k, v := range outputs {
 		if v.ContainsSecrets() {
@@ -240,11 +257,15 @@ func (p *builtinProvider) readStackReference(inputs resource.PropertyMap) (resou
 		return secretOutputs[i].String() < secretOutputs[j].String()
 	})
 
-	return resource.PropertyMap{
+	result := resource.PropertyMap{
 		"name":              name,
 		"outputs":           resource.NewObjectProperty(outputs),
 		"secretOutputNames": resource.NewArrayProperty(secretOutputs),
-	}, nil
+	}
+	if outputTypes.IsObject() {
+		result["outputTypes"] = outputTypes
+	}
+	return result, nil
 }
 
 func (p *builtinProvider) readStackResourceOutputs(inputs resource.PropertyMap) (resource.PropertyMap, error) {
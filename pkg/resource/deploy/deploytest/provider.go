@@ -61,6 +61,11 @@ type Provider struct {
 	InvokeF func(tok tokens.ModuleMember,
 		inputs resource.PropertyMap) (resource.PropertyMap, []plugin.CheckFailure, error)
 
+	CallF func(monitor *ResourceMonitor, tok tokens.ModuleMember, args resource.PropertyMap,
+		info plugin.CallInfo, options plugin.CallOptions) (plugin.CallResult, error)
+
+	ListF func(typ tokens.Type, filters map[string]string) ([]resource.ID, error)
+
 	CancelF func() error
 }
 
@@ -197,3 +202,22 @@ func (prov *Provider) StreamInvoke(
 
 	return nil, fmt.Errorf("not implemented")
 }
+
+func (prov *Provider) Call(tok tokens.ModuleMember, args resource.PropertyMap, info plugin.CallInfo,
+	options plugin.CallOptions) (plugin.CallResult, error) {
+	if prov.CallF == nil {
+		return plugin.CallResult{}, nil
+	}
+	monitor, err := dialMonitor(info.MonitorAddress)
+	if err != nil {
+		return plugin.CallResult{}, err
+	}
+	return prov.CallF(monitor, tok, args, info, options)
+}
+
+func (prov *Provider) List(typ tokens.Type, filters map[string]string) ([]resource.ID, error) {
+	if prov.ListF == nil {
+		return nil, nil
+	}
+	return prov.ListF(typ, filters)
+}
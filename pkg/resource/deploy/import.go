@@ -89,7 +89,7 @@ func NewImportDeployment(ctx *plugin.Context, target *Target, projectName tokens
 		olds:         olds,
 		imports:      imports,
 		isImport:     true,
-		schemaLoader: schema.NewPluginLoader(ctx.Host),
+		schemaLoader: schema.NewCachingPluginLoader(ctx.Host),
 		source:       NewErrorSource(projectName),
 		preview:      preview,
 		providers:    reg,
@@ -128,6 +128,57 @@ func (m Map) Get(k Key, path bool) (Value, bool, error) {
 	return NewObjectValue(string(json)), true, nil
 }
 
+// GetObject gets the value for a given key as a decrypted object, preserving the types of numbers and booleans
+// inside object values. If path is true, the key's name portion is treated as a path.
+func (m Map) GetObject(k Key, path bool, decrypter Decrypter) (interface{}, bool, error) {
+	// Without a path, the key's name is the only path segment.
+	p, configKey := resource.PropertyPath{k.Name()}, k
+	if path {
+		var err error
+		if p, configKey, err = parseKeyPath(k); err != nil {
+			return nil, false, err
+		}
+	}
+
+	val, ok := m[configKey]
+	if !ok {
+		return nil, false, nil
+	}
+	obj, err := val.ToObject()
+	if err != nil {
+		return nil, false, err
+	}
+	if val.Secure() && !val.Object() {
+		obj = map[string]interface{}{"secure": obj}
+	}
+
+	// Get the value within the object.
+	root := map[string]interface{}{configKey.Name(): obj}
+	_, v, ok := getValueForPath(root, p)
+	if !ok {
+		return nil, false, nil
+	}
+
+	if is, s := isSecureValue(v); is {
+		if decrypter == nil {
+			return nil, false, errors.New("non-nil decrypter required for secret")
+		}
+		plaintext, err := decrypter.DecryptValue(s)
+		if err != nil {
+			return nil, false, err
+		}
+		return plaintext, true, nil
+	}
+	if hasSecureValue(v) && decrypter == nil {
+		return nil, false, errors.New("non-nil decrypter required for secret")
+	}
+	decrypted, err := decryptObject(v, decrypter)
+	if err != nil {
+		return nil, false, err
+	}
+	return decrypted, true, nil
+}
+
 // Remove removes the value for a given key. If path is true, the key's name portion is treated as a path.
 func (m Map) Remove(k Key, path bool) error {
 	// If the key isn't a path, go ahead and delete it and return.
@@ -506,15 +557,13 @@ func setValue(container, key, value, containerParent, containerParentKey interfa
 
 // adjustObjectValue returns a more suitable value for objects:
 func adjustObjectValue(v Value, path bool) interface{} {
-	contract.Assertf(!v.Object(), "v must not be an Object")
-
 	// If the path flag isn't set, just return the value as-is.
 	if !path {
 		return v
 	}
 
-	// If it's a secure value, return as-is.
-	if v.Secure() {
+	// If it's a secure or object value, return as-is; it will be marshaled as the appropriate object.
+	if v.Secure() || v.Object() {
 		return v
 	}
 
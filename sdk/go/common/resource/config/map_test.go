@@ -464,6 +464,51 @@ func TestGetFail(t *testing.T) {
 	}
 }
 
+func TestGetObject(t *testing.T) {
+	config := Map{
+		MustMakeKey("my", "plain"):  NewValue("value"),
+		MustMakeKey("my", "secret"): NewSecureValue("stackAvalue"),
+		MustMakeKey("my", "obj"): NewSecureObjectValue(
+			`{"a":[1,true,{"secure":"stackAb"}],"c":{"d":{"secure":"stackAe"}}}`),
+	}
+
+	tests := []struct {
+		Key            string
+		Path           bool
+		Expected       interface{}
+		ExpectNotFound bool
+	}{
+		{Key: "my:plain", Expected: "value"},
+		{Key: "my:secret", Expected: "value"},
+		{Key: "my:secret", Path: true, Expected: "value"},
+		{
+			Key: "my:obj",
+			Expected: map[string]interface{}{
+				"a": []interface{}{1.0, true, "b"},
+				"c": map[string]interface{}{"d": "e"},
+			},
+		},
+		{Key: "my:obj.a[0]", Path: true, Expected: 1.0},
+		{Key: "my:obj.a[1]", Path: true, Expected: true},
+		{Key: "my:obj.a[2]", Path: true, Expected: "b"},
+		{Key: "my:obj.c", Path: true, Expected: map[string]interface{}{"d": "e"}},
+		{Key: "my:obj.a[3]", Path: true, ExpectNotFound: true},
+		{Key: "my:missing", ExpectNotFound: true},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%v", test), func(t *testing.T) {
+			key, err := ParseKey(test.Key)
+			assert.NoError(t, err)
+
+			actual, found, err := config.GetObject(key, test.Path, newPrefixCrypter("stackA"))
+			assert.NoError(t, err)
+			assert.Equal(t, !test.ExpectNotFound, found)
+			assert.Equal(t, test.Expected, actual)
+		})
+	}
+}
+
 func TestRemoveSuccess(t *testing.T) {
 	tests := []struct {
 		Key      string
@@ -1069,6 +1114,25 @@ func TestSetSuccess(t *testing.T) {
 				MustMakeKey("my", "testKey"): NewObjectValue(`["0123456"]`),
 			},
 		},
+		{
+			Key:   `my:outer.inner`,
+			Path:  true,
+			Value: NewObjectValue(`{"foo":[1,"bar"]}`),
+			Config: Map{
+				MustMakeKey("my", "outer"): NewObjectValue(`{"baz":true}`),
+			},
+			Expected: Map{
+				MustMakeKey("my", "outer"): NewObjectValue(`{"baz":true,"inner":{"foo":[1,"bar"]}}`),
+			},
+		},
+		{
+			Key:   `my:outer[0]`,
+			Path:  true,
+			Value: NewSecureObjectValue(`{"foo":{"secure":"bar"}}`),
+			Expected: Map{
+				MustMakeKey("my", "outer"): NewSecureObjectValue(`[{"foo":{"secure":"bar"}}]`),
+			},
+		},
 		{
 			Key:   `my:key.secure`,
 			Path:  true,
@@ -17,8 +17,10 @@ package config
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 
 	"github.com/pkg/errors"
+	yaml "gopkg.in/yaml.v2"
 )
 
 // Value is a single config value.
@@ -44,6 +46,90 @@ func NewObjectValue(v string) Value {
 	return Value{value: v, secure: false, object: true}
 }
 
+// ParseObjectValue parses a JSON (or YAML) document into a configuration value. Scalars become simple values and
+// maps and arrays become object values. Leaves of the form `{"secure": "<plaintext>"}` are encrypted individually
+// using encrypter; if secret is true, every scalar leaf in the document is encrypted this way.
+func ParseObjectValue(doc string, secret bool, encrypter Encrypter) (Value, error) {
+	var obj interface{}
+	if err := yaml.Unmarshal([]byte(doc), &obj); err != nil {
+		return Value{}, errors.Wrap(err, "malformed config value")
+	}
+	return NewValueFromObject(interfaceMapToStringMap(obj), secret, encrypter)
+}
+
+// ParseObjectValues parses a JSON (or YAML) document holding a map from configuration keys to values, and returns the
+// configuration value for each key. Values are treated as in ParseObjectValue.
+func ParseObjectValues(doc string, secret bool, encrypter Encrypter) (map[string]Value, error) {
+	var obj interface{}
+	if err := yaml.Unmarshal([]byte(doc), &obj); err != nil {
+		return nil, errors.Wrap(err, "malformed config document")
+	}
+	m, ok := interfaceMapToStringMap(obj).(map[string]interface{})
+	if !ok {
+		return nil, errors.New("config document must be a map from keys to values")
+	}
+
+	values := make(map[string]Value, len(m))
+	for key, val := range m {
+		v, err := NewValueFromObject(val, secret, encrypter)
+		if err != nil {
+			return nil, errors.Wrapf(err, "config key %q", key)
+		}
+		values[key] = v
+	}
+	return values, nil
+}
+
+// NewValueFromObject creates a configuration value from an already-decoded JSON object. See ParseObjectValue for
+// the treatment of secure leaves.
+func NewValueFromObject(obj interface{}, secret bool, encrypter Encrypter) (Value, error) {
+	if err := validateObject(obj, ""); err != nil {
+		return Value{}, err
+	}
+	if secret {
+		obj = secureObject(obj)
+	}
+
+	if is, plaintext := isSecureValue(obj); is {
+		if encrypter == nil {
+			return Value{}, errors.New("non-nil encrypter required for secret")
+		}
+		enc, err := encrypter.EncryptValue(plaintext)
+		if err != nil {
+			return Value{}, err
+		}
+		return NewSecureValue(enc), nil
+	}
+
+	switch obj.(type) {
+	case map[string]interface{}, []interface{}:
+		// Handled below.
+	default:
+		return NewValue(formatScalar(obj)), nil
+	}
+
+	if !hasSecureValue(obj) {
+		json, err := json.Marshal(obj)
+		if err != nil {
+			return Value{}, err
+		}
+		return NewObjectValue(string(json)), nil
+	}
+
+	if encrypter == nil {
+		return Value{}, errors.New("non-nil encrypter required for secret")
+	}
+	encryptedObj, err := reencryptObject(obj, NopDecrypter, encrypter)
+	if err != nil {
+		return Value{}, err
+	}
+	json, err := json.Marshal(encryptedObj)
+	if err != nil {
+		return Value{}, err
+	}
+	return NewSecureObjectValue(string(json)), nil
+}
+
 // Value fetches the value of this configuration entry, using decrypter to decrypt if necessary.  If the value
 // is a secret and decrypter is nil, or if decryption fails for any reason, a non-nil error is returned.
 func (c Value) Value(decrypter Decrypter) (string, error) {
@@ -245,6 +331,81 @@ func interfaceMapToStringMap(v interface{}) interface{} {
 	return v
 }
 
+// validateObject checks that the object only contains values that can be stored in configuration: maps with string
+// keys, arrays, strings, numbers and booleans. Secure leaves must hold string values. Errors are reported using the
+// path of the offending value.
+func validateObject(v interface{}, path string) error {
+	describe := func() string {
+		if path == "" {
+			return "config value"
+		}
+		return fmt.Sprintf("config value at %s", path)
+	}
+
+	switch t := v.(type) {
+	case nil:
+		return errors.Errorf("%s must not be null", describe())
+	case string, bool, int, int64, uint64, float64:
+		return nil
+	case map[string]interface{}:
+		if len(t) == 1 {
+			if s, hasSecureKey := t["secure"]; hasSecureKey {
+				if _, isString := s.(string); !isString {
+					return errors.Errorf("secure %s must be a string", describe())
+				}
+				return nil
+			}
+		}
+		for key, val := range t {
+			if err := validateObject(val, fmt.Sprintf("%s[%q]", path, key)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case []interface{}:
+		for i, val := range t {
+			if err := validateObject(val, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return errors.Errorf("%s has unsupported type %T", describe(), v)
+	}
+}
+
+// secureObject returns a new object with every scalar leaf replaced by a `{"secure": "<plaintext>"}` map.
+func secureObject(v interface{}) interface{} {
+	if is, _ := isSecureValue(v); is {
+		return v
+	}
+
+	switch t := v.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{})
+		for key, val := range t {
+			m[key] = secureObject(val)
+		}
+		return m
+	case []interface{}:
+		a := make([]interface{}, len(t))
+		for i, val := range t {
+			a[i] = secureObject(val)
+		}
+		return a
+	}
+	return map[string]interface{}{"secure": formatScalar(v)}
+}
+
+// formatScalar formats a scalar leaf of a structured value as a string. Numbers are formatted without an exponent so
+// that large values are stored as the digits that were written rather than as e.g. "1e+20".
+func formatScalar(v interface{}) string {
+	if f, ok := v.(float64); ok {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	return fmt.Sprintf("%v", v)
+}
+
 // hasSecureValue returns true if the object contains a value that's a `map[string]string` of
 // length one with a "secure" key.
 func hasSecureValue(v interface{}) bool {
@@ -282,6 +282,102 @@ func TestCopyValue(t *testing.T) {
 	}
 }
 
+func TestParseObjectValue(t *testing.T) {
+	tests := []struct {
+		Doc      string
+		Secret   bool
+		Expected Value
+	}{
+		{
+			Doc:      `"value"`,
+			Expected: NewValue("value"),
+		},
+		{
+			Doc:      `42`,
+			Expected: NewValue("42"),
+		},
+		{
+			Doc:      `42`,
+			Secret:   true,
+			Expected: NewSecureValue("encrypted42"),
+		},
+		{
+			Doc:      `100000000000000000000`,
+			Expected: NewValue("100000000000000000000"),
+		},
+		{
+			Doc:      `0.000001`,
+			Secret:   true,
+			Expected: NewSecureValue("encrypted0.000001"),
+		},
+		{
+			Doc:      `{"foo":"bar","baz":[1,true]}`,
+			Expected: NewObjectValue(`{"baz":[1,true],"foo":"bar"}`),
+		},
+		{
+			Doc:      "foo: bar\nbaz:\n- 1\n- true\n",
+			Expected: NewObjectValue(`{"baz":[1,true],"foo":"bar"}`),
+		},
+		{
+			Doc:      `{"secure":"value"}`,
+			Expected: NewSecureValue("encryptedvalue"),
+		},
+		{
+			Doc:      `{"foo":{"secure":"bar"},"baz":["qux"]}`,
+			Expected: NewSecureObjectValue(`{"baz":["qux"],"foo":{"secure":"encryptedbar"}}`),
+		},
+		{
+			Doc:    `{"foo":"bar","baz":[1,{"secure":"qux"}]}`,
+			Secret: true,
+			Expected: NewSecureObjectValue(
+				`{"baz":[{"secure":"encrypted1"},{"secure":"encryptedqux"}],"foo":{"secure":"encryptedbar"}}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Doc, func(t *testing.T) {
+			actual, err := ParseObjectValue(test.Doc, test.Secret, newPrefixCrypter("encrypted"))
+			assert.NoError(t, err)
+			assert.Equal(t, test.Expected, actual)
+		})
+	}
+}
+
+func TestParseObjectValueFail(t *testing.T) {
+	tests := []string{
+		`{"foo":`,
+		`null`,
+		`{"foo":null}`,
+		`{"foo":{"secure":1}}`,
+		`[{"secure":["a"]}]`,
+	}
+
+	for _, doc := range tests {
+		t.Run(doc, func(t *testing.T) {
+			_, err := ParseObjectValue(doc, false, newPrefixCrypter("encrypted"))
+			assert.Error(t, err)
+		})
+	}
+
+	// A secure leaf without an encrypter is an error.
+	_, err := ParseObjectValue(`{"foo":{"secure":"bar"}}`, false, nil)
+	assert.Error(t, err)
+}
+
+func TestParseObjectValues(t *testing.T) {
+	doc := "name: value\nport: 8080\nserver:\n  hosts: [a, b]\n  key:\n    secure: k\n"
+	actual, err := ParseObjectValues(doc, false, newPrefixCrypter("encrypted"))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]Value{
+		"name":   NewValue("value"),
+		"port":   NewValue("8080"),
+		"server": NewSecureObjectValue(`{"hosts":["a","b"],"key":{"secure":"encryptedk"}}`),
+	}, actual)
+
+	_, err = ParseObjectValues(`["a", "b"]`, false, newPrefixCrypter("encrypted"))
+	assert.Error(t, err)
+}
+
 func roundtripValueYAML(v Value) (Value, error) {
 	return roundtripValue(v, yaml.Marshal, yaml.Unmarshal)
 }
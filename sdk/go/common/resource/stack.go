@@ -25,3 +25,7 @@ const RootStackType tokens.Type = "pulumi:pulumi:Stack"
 func DefaultRootStackURN(stack tokens.QName, proj tokens.PackageName) URN {
 	return NewURN(stack, proj, "", RootStackType, tokens.QName(string(proj)+"-"+string(stack)))
 }
+
+// StackOutputTypesKey is the name of the reserved stack output that records the types of outputs exported from
+// typed Go structs. Its value is an object mapping each such output's name to a schema type spec.
+const StackOutputTypesKey PropertyKey = "__outputTypes"
@@ -39,6 +39,8 @@ const (
 	GitDir = ".git"
 	// HistoryDir is the name of the directory that holds historical information for projects.
 	HistoryDir = "history"
+	// JournalDir is the name of the directory that holds the checkpoint journals of in-progress updates.
+	JournalDir = "journals"
 	// PluginDir is the name of the directory containing plugins.
 	PluginDir = "plugins"
 	// PolicyDir is the name of the directory that holds policy packs.
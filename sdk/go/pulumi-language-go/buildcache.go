@@ -0,0 +1,332 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/logging"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/workspace"
+)
+
+// buildCacheDirName is the name of the directory under the Pulumi home directory in which built programs are cached.
+const buildCacheDirName = "go-build-cache"
+
+// buildCacheLatest is the name of the file that records the key of the most recently built program in a program's
+// cache directory.
+const buildCacheLatest = "latest"
+
+// buildCacheEnvVars are the environment variables that affect the output of `go build`.
+var buildCacheEnvVars = []string{
+	"CGO_ENABLED", "GOARCH", "GOARM", "GOEXPERIMENT", "GOFLAGS", "GOOS", "GOPATH", "GO111MODULE",
+}
+
+// buildCacheKeep is the number of programs that are kept in a program's cache directory. Keeping the previously
+// used program as well as the current one ensures that a concurrent run never has its program removed.
+const buildCacheKeep = 2
+
+// findOrBuildProgram returns the path to a binary built from the Go program in dir. Binaries are cached in the
+// Pulumi home directory, keyed by the Go version, the build environment, the program's go.mod and go.sum, and the
+// packages the program imports. If skipBuild is true, the most recently built binary for the program is returned
+// without checking whether it is up to date.
+func findOrBuildProgram(gobin, dir string, skipBuild bool) (string, error) {
+	cacheDir, err := programCacheDir(dir)
+	if err != nil {
+		return "", err
+	}
+
+	if skipBuild {
+		if key, err := ioutil.ReadFile(filepath.Join(cacheDir, buildCacheLatest)); err == nil {
+			program := filepath.Join(cacheDir, strings.TrimSpace(string(key)), programName())
+			if _, err := os.Stat(program); err == nil {
+				logging.V(5).Infof("Skipping build, using cached program %s", program)
+				markProgramUsed(program)
+				return program, nil
+			}
+		}
+		logging.V(5).Infof("No cached program found for %s, building", dir)
+	}
+
+	key, err := buildCacheKey(gobin, dir)
+	if err != nil {
+		return "", errors.Wrap(err, "computing build cache key")
+	}
+
+	program := filepath.Join(cacheDir, key, programName())
+	if _, err := os.Stat(program); err == nil {
+		logging.V(5).Infof("Using cached program %s", program)
+		markProgramUsed(program)
+		return program, recordLatestProgram(cacheDir, key)
+	}
+
+	if err = os.MkdirAll(filepath.Dir(program), 0700); err != nil {
+		return "", errors.Wrap(err, "creating build cache directory")
+	}
+
+	// Build into a temporary file and move it into place so that concurrent runs never observe a partial binary.
+	tmp, err := ioutil.TempFile(cacheDir, "program-")
+	if err != nil {
+		return "", errors.Wrap(err, "creating temporary program file")
+	}
+	tmpPath := tmp.Name()
+	if err = tmp.Close(); err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = os.Remove(tmpPath)
+	}()
+
+	logging.V(5).Infof("Building program %s into %s", dir, program)
+	cmd := exec.Command(gobin, "build", "-o", tmpPath, dir)
+	cmd.Dir = dir
+	cmd.Stdout, cmd.Stderr = os.Stderr, os.Stderr
+	if err = cmd.Run(); err != nil {
+		return "", errors.Wrap(err, "unable to build program")
+	}
+	if err = os.Rename(tmpPath, program); err != nil {
+		return "", errors.Wrap(err, "caching program")
+	}
+
+	if err = recordLatestProgram(cacheDir, key); err != nil {
+		return "", err
+	}
+	pruneProgramCache(cacheDir, key)
+	return program, nil
+}
+
+// programCacheDir returns the directory in which binaries built from the program in dir are cached.
+func programCacheDir(dir string) (string, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256([]byte(abs))
+	return workspace.GetPulumiPath(buildCacheDirName, hex.EncodeToString(sum[:])[:16])
+}
+
+// programName returns the file name of a cached program binary.
+func programName() string {
+	if runtime.GOOS == "windows" {
+		return "program.exe"
+	}
+	return "program"
+}
+
+// recordLatestProgram records the key of the most recently used program in the given cache directory.
+func recordLatestProgram(cacheDir, key string) error {
+	err := ioutil.WriteFile(filepath.Join(cacheDir, buildCacheLatest), []byte(key), 0600)
+	return errors.Wrap(err, "recording latest program")
+}
+
+// markProgramUsed updates the modification time of the directory containing the given cached program, which
+// records when the program was last used. Errors are ignored, as they only affect which programs are pruned.
+func markProgramUsed(program string) {
+	now := time.Now()
+	_ = os.Chtimes(filepath.Dir(program), now, now)
+}
+
+// pruneProgramCache removes all of the programs in the given cache directory except for the one with the given key
+// and the most recently used others, keeping buildCacheKeep programs in total. Errors are ignored, as another process
+// may be using the cache concurrently.
+func pruneProgramCache(cacheDir, key string) {
+	entries, err := ioutil.ReadDir(cacheDir)
+	if err != nil {
+		return
+	}
+
+	var others []os.FileInfo
+	for _, entry := range entries {
+		if entry.IsDir() && entry.Name() != key {
+			others = append(others, entry)
+		}
+	}
+	sort.Slice(others, func(i, j int) bool {
+		return others[i].ModTime().After(others[j].ModTime())
+	})
+
+	for i, entry := range others {
+		if i+1 >= buildCacheKeep {
+			_ = os.RemoveAll(filepath.Join(cacheDir, entry.Name()))
+		}
+	}
+}
+
+// buildCacheKey computes the key for the binary built from the program in dir.
+func buildCacheKey(gobin, dir string) (string, error) {
+	hash := sha256.New()
+
+	goVersion, err := exec.Command(gobin, "version").Output()
+	if err != nil {
+		return "", errors.Wrap(err, "determining go version")
+	}
+	fmt.Fprintf(hash, "version %s\n", strings.TrimSpace(string(goVersion)))
+
+	for _, name := range buildCacheEnvVars {
+		fmt.Fprintf(hash, "env %s=%s\n", name, os.Getenv(name))
+	}
+
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	fmt.Fprintf(hash, "dir %s\n", abs)
+
+	// Hash the program's go.mod and go.sum, which determine the versions of its dependencies.
+	root := findModuleRoot(abs)
+	for _, name := range []string{"go.mod", "go.sum"} {
+		if err := hashFile(hash, root, name); err != nil && !os.IsNotExist(err) {
+			return "", err
+		}
+	}
+
+	// Hash the packages that the program imports.
+	pkgs, err := listPackageDeps(gobin, abs)
+	if err != nil {
+		return "", err
+	}
+	for _, pkg := range pkgs {
+		if err := hashPackage(hash, pkg); err != nil {
+			return "", err
+		}
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))[:32], nil
+}
+
+// findModuleRoot returns the directory containing the go.mod file for the program in dir, or dir itself if the
+// program is not part of a module.
+func findModuleRoot(dir string) string {
+	for d := dir; ; {
+		if _, err := os.Stat(filepath.Join(d, "go.mod")); err == nil {
+			return d
+		}
+		parent := filepath.Dir(d)
+		if parent == d {
+			return dir
+		}
+		d = parent
+	}
+}
+
+// goModule is the useful portion of a module in the output of `go list -json`.
+type goModule struct {
+	Path    string
+	Version string
+	Replace *goModule
+}
+
+// goPackage is the useful portion of the output from `go list -deps -json`.
+type goPackage struct {
+	Dir        string
+	ImportPath string
+	Standard   bool
+	Module     *goModule
+
+	GoFiles   []string
+	CgoFiles  []string
+	CFiles    []string
+	CXXFiles  []string
+	HFiles    []string
+	SFiles    []string
+	SysoFiles []string
+}
+
+// listPackageDeps returns the program in dir and all of the packages it imports, directly or indirectly.
+func listPackageDeps(gobin, dir string) ([]goPackage, error) {
+	cmd := exec.Command(gobin, "list", "-deps", "-json", ".")
+	cmd.Dir = dir
+	stdout, err := cmd.Output()
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			return nil, errors.Errorf("listing program dependencies: %s", strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return nil, errors.Wrap(err, "listing program dependencies")
+	}
+
+	var pkgs []goPackage
+	for dec := json.NewDecoder(bytes.NewReader(stdout)); dec.More(); {
+		var pkg goPackage
+		if err := dec.Decode(&pkg); err != nil {
+			return nil, errors.Wrap(err, "listing program dependencies")
+		}
+		pkgs = append(pkgs, pkg)
+	}
+	return pkgs, nil
+}
+
+// hashPackage writes a description of the given package to the given hash. Packages in the standard library are
+// identified by the Go version and packages in versioned modules by their module's version, as neither can change
+// without that version changing. The source files of all other packages, such as those in the program's module or in
+// modules replaced by local directories, are hashed.
+func hashPackage(hash io.Writer, pkg goPackage) error {
+	if pkg.Standard {
+		return nil
+	}
+
+	if mod := pkg.Module; mod != nil {
+		if mod.Replace != nil {
+			mod = mod.Replace
+		}
+		if mod.Version != "" {
+			fmt.Fprintf(hash, "package %s %s@%s\n", pkg.ImportPath, mod.Path, mod.Version)
+			return nil
+		}
+	}
+
+	fmt.Fprintf(hash, "package %s %s\n", pkg.ImportPath, pkg.Dir)
+	for _, files := range [][]string{
+		pkg.GoFiles, pkg.CgoFiles, pkg.CFiles, pkg.CXXFiles, pkg.HFiles, pkg.SFiles, pkg.SysoFiles,
+	} {
+		for _, name := range files {
+			if err := hashFile(hash, pkg.Dir, name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// hashFile writes the name and contents of the named file in dir to the given hash.
+func hashFile(hash io.Writer, dir, name string) error {
+	f, err := os.Open(filepath.Join(dir, name))
+	if err != nil {
+		return err
+	}
+	defer contract.IgnoreClose(f)
+
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(hash, "file %s %s %d\n", dir, name, info.Size())
+	_, err = io.Copy(hash, f)
+	return err
+}
@@ -0,0 +1,114 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/workspace"
+)
+
+func writeProgram(t *testing.T, dir, message string) {
+	goMod := "module example.com/program\n\ngo 1.14\n"
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "go.mod"), []byte(goMod), 0600))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "main.go"), []byte(`package main
+
+import "fmt"
+
+func main() {
+	fmt.Print("`+message+`")
+}
+`), 0600))
+}
+
+func runProgram(t *testing.T, program string) string {
+	out, err := exec.Command(program).Output()
+	require.NoError(t, err)
+	return string(out)
+}
+
+func TestFindOrBuildProgram(t *testing.T) {
+	gobin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go is not available")
+	}
+
+	home, err := ioutil.TempDir("", "pulumi-home")
+	require.NoError(t, err)
+	defer os.RemoveAll(home)
+	defer os.Setenv(workspace.PulumiHomeEnvVar, os.Getenv(workspace.PulumiHomeEnvVar))
+	require.NoError(t, os.Setenv(workspace.PulumiHomeEnvVar, home))
+
+	dir, err := ioutil.TempDir("", "program")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// The first run builds the program.
+	writeProgram(t, dir, "hello")
+	program, err := findOrBuildProgram(gobin, dir, false)
+	require.NoError(t, err)
+	assert.Equal(t, "hello", runProgram(t, program))
+
+	// The second run reuses the cached program.
+	info, err := os.Stat(program)
+	require.NoError(t, err)
+	cached, err := findOrBuildProgram(gobin, dir, false)
+	require.NoError(t, err)
+	assert.Equal(t, program, cached)
+	cachedInfo, err := os.Stat(cached)
+	require.NoError(t, err)
+	assert.Equal(t, info.ModTime(), cachedInfo.ModTime())
+
+	// Skipping the build reuses the most recent program even if the source has changed.
+	writeProgram(t, dir, "goodbye")
+	skipped, err := findOrBuildProgram(gobin, dir, true)
+	require.NoError(t, err)
+	assert.Equal(t, program, skipped)
+	assert.Equal(t, "hello", runProgram(t, skipped))
+
+	// Otherwise, changes to the source cause the program to be rebuilt. The previous program is kept, as it may
+	// still be in use.
+	rebuilt, err := findOrBuildProgram(gobin, dir, false)
+	require.NoError(t, err)
+	assert.NotEqual(t, program, rebuilt)
+	assert.Equal(t, "goodbye", runProgram(t, rebuilt))
+	_, err = os.Stat(program)
+	assert.NoError(t, err)
+
+	// Changes to packages that the program does not import do not cause it to be rebuilt.
+	unused := filepath.Join(dir, "unused")
+	require.NoError(t, os.Mkdir(unused, 0700))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(unused, "unused.go"), []byte("package unused\n"), 0600))
+	cached, err = findOrBuildProgram(gobin, dir, false)
+	require.NoError(t, err)
+	assert.Equal(t, rebuilt, cached)
+
+	// Once there are more programs than the cache keeps, the least recently used ones are removed.
+	writeProgram(t, dir, "again")
+	again, err := findOrBuildProgram(gobin, dir, false)
+	require.NoError(t, err)
+	assert.Equal(t, "again", runProgram(t, again))
+	_, err = os.Stat(program)
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Stat(rebuilt)
+	assert.NoError(t, err)
+}
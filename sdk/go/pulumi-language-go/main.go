@@ -43,8 +43,8 @@ import (
 	pulumirpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
 )
 
-func findProgram(binary string) (*exec.Cmd, error) {
-	// we default to execution via `go run`
+func findProgram(binary string, skipBuild bool) (*exec.Cmd, error) {
+	// we default to building the program into a cache directory and running the cached binary
 	// the user can explicitly opt in to using a binary executable by specifying
 	// runtime.options.binary in the Pulumi.yaml
 	if binary != "" {
@@ -55,9 +55,9 @@ func findProgram(binary string) (*exec.Cmd, error) {
 		return exec.Command(program), nil
 	}
 
-	// Fall back to 'go run' style executions
-	logging.V(5).Infof("No prebuilt executable specified, attempting invocation via 'go run'")
-	program, err := executable.FindExecutable("go")
+	// Fall back to building the program ourselves
+	logging.V(5).Infof("No prebuilt executable specified, attempting to build the program")
+	gobin, err := executable.FindExecutable("go")
 	if err != nil {
 		return nil, errors.Wrap(err, "problem executing program (could not run language executor)")
 	}
@@ -69,18 +69,24 @@ func findProgram(binary string) (*exec.Cmd, error) {
 
 	goFileSearchPattern := filepath.Join(cwd, "*.go")
 	if matches, err := filepath.Glob(goFileSearchPattern); err != nil || len(matches) == 0 {
-		return nil, errors.Errorf("Failed to find go files for 'go run' matching %s", goFileSearchPattern)
+		return nil, errors.Errorf("Failed to find go files for 'go build' matching %s", goFileSearchPattern)
 	}
 
-	return exec.Command(program, "run", cwd), nil
+	program, err := findOrBuildProgram(gobin, cwd, skipBuild)
+	if err != nil {
+		return nil, err
+	}
+	return exec.Command(program), nil
 }
 
 // Launches the language host, which in turn fires up an RPC server implementing the LanguageRuntimeServer endpoint.
 func main() {
 	var tracing string
 	var binary string
+	var skipBuild bool
 	flag.StringVar(&tracing, "tracing", "", "Emit tracing to a Zipkin-compatible tracing endpoint")
 	flag.StringVar(&binary, "binary", "", "Look on path for a binary executable with this name")
+	flag.BoolVar(&skipBuild, "skipBuild", false, "Run the most recently built program without rebuilding it")
 
 	flag.Parse()
 	args := flag.Args()
@@ -96,7 +102,7 @@ func main() {
 	// Fire up a gRPC server, letting the kernel choose a free port.
 	port, done, err := rpcutil.Serve(0, nil, []func(*grpc.Server) error{
 		func(srv *grpc.Server) error {
-			host := newLanguageHost(engineAddress, tracing, binary)
+			host := newLanguageHost(engineAddress, tracing, binary, skipBuild)
 			pulumirpc.RegisterLanguageRuntimeServer(srv, host)
 			return nil
 		},
@@ -119,13 +125,15 @@ type goLanguageHost struct {
 	engineAddress string
 	tracing       string
 	binary        string
+	skipBuild     bool
 }
 
-func newLanguageHost(engineAddress, tracing, binary string) pulumirpc.LanguageRuntimeServer {
+func newLanguageHost(engineAddress, tracing, binary string, skipBuild bool) pulumirpc.LanguageRuntimeServer {
 	return &goLanguageHost{
 		engineAddress: engineAddress,
 		tracing:       tracing,
 		binary:        binary,
+		skipBuild:     skipBuild,
 	}
 }
 
@@ -246,7 +254,7 @@ func (host *goLanguageHost) Run(ctx context.Context, req *pulumirpc.RunRequest)
 		return nil, errors.Wrap(err, "failed to prepare environment")
 	}
 
-	cmd, err := findProgram(host.binary)
+	cmd, err := findProgram(host.binary, host.skipBuild)
 	if err != nil {
 		return nil, err
 	}
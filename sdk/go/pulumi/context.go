@@ -42,6 +42,7 @@ type Context struct {
 	info          RunInfo
 	stack         Resource
 	exports       map[string]Input
+	exportTypes   map[string]interface{}
 	monitor       pulumirpc.ResourceMonitorClient
 	monitorConn   *grpc.ClientConn
 	engine        pulumirpc.EngineClient
@@ -113,6 +114,7 @@ func NewContext(ctx context.Context, info RunInfo) (*Context, error) {
 		ctx:           ctx,
 		info:          info,
 		exports:       make(map[string]Input),
+		exportTypes:   make(map[string]interface{}),
 		monitorConn:   monitorConn,
 		monitor:       monitor,
 		engineConn:    engineConn,
@@ -261,6 +263,163 @@ func (ctx *Context) Invoke(tok string, args interface{}, result interface{}, opt
 	return nil
 }
 
+// Call will invoke a provider call function, identified by its token tok. self is the resource on which the method is
+// being called; it is passed to the provider as a resource reference in the `__self__` argument. Unlike Invoke, args
+// may contain Outputs: they are awaited before the call is made, and their dependencies are forwarded to the
+// provider.
+//
+// output is used to determine the type of the Output that is returned. Its element type must be a struct whose fields
+// have `pulumi` tags that record the names of the corresponding return values. For example, given a method with a
+// string-typed return value "kubeconfig", one would write:
+//
+//     type getKubeconfigResult struct {
+//         Kubeconfig string `pulumi:"kubeconfig"`
+//     }
+//
+// and pass an Output whose ElementType is getKubeconfigResult. The returned Output is of the same type as output and
+// resolves once the call completes. If the method does not return a value, output may be nil, in which case the
+// returned Output is also nil and any error from the call is reported when the program completes.
+func (ctx *Context) Call(tok string, args Input, output Output, self Resource, opts ...InvokeOption) (Output, error) {
+	if tok == "" {
+		return nil, errors.New("call token must not be empty")
+	}
+	if self == nil {
+		return nil, errors.New("call requires a resource on which to call the method")
+	}
+
+	if output != nil {
+		if et := output.ElementType(); et.Kind() != reflect.Struct {
+			return nil, fmt.Errorf("output must have an element type that is a struct, not %v", et)
+		}
+	}
+
+	options := &invokeOptions{}
+	for _, o := range opts {
+		if o != nil {
+			o.applyInvokeOption(options)
+		}
+	}
+
+	// Default providers for the call are taken from the resource on which the method is called, unless an explicit
+	// parent was supplied.
+	parent := options.Parent
+	if parent == nil {
+		parent = self
+	}
+
+	// Note that we're about to make an outstanding RPC request, so that we can rendezvous during shutdown.
+	if err := ctx.beginRPC(); err != nil {
+		return nil, err
+	}
+
+	var result Output
+	var state *OutputState
+	if output != nil {
+		result = newOutput(reflect.TypeOf(output), self)
+		state = result.getState()
+	}
+
+	go func() {
+		var err error
+		defer func() {
+			if err != nil && state != nil {
+				state.reject(err)
+			}
+			ctx.endRPC(err)
+		}()
+
+		var providerRef string
+		if provider := mergeProviders(tok, parent, options.Provider, nil)[getPackage(tok)]; provider != nil {
+			if providerRef, err = ctx.resolveProviderReference(provider); err != nil {
+				return
+			}
+		}
+
+		// Serialize arguments, first by awaiting them, and then marshaling them to the requisite gRPC values.
+		resolvedArgs, argDeps, _, err := marshalInputs(args)
+		if err != nil {
+			err = fmt.Errorf("marshaling arguments: %w", err)
+			return
+		}
+		selfRef, _, err := marshalInput(self, resourceType, true)
+		if err != nil {
+			err = fmt.Errorf("marshaling __self__: %w", err)
+			return
+		}
+		resolvedArgs["__self__"] = selfRef
+
+		keepUnknowns := ctx.DryRun()
+		rpcArgs, err := plugin.MarshalProperties(
+			resolvedArgs,
+			plugin.MarshalOptions{KeepUnknowns: keepUnknowns, KeepSecrets: true, KeepResources: ctx.keepResources},
+		)
+		if err != nil {
+			err = fmt.Errorf("marshaling arguments: %w", err)
+			return
+		}
+
+		rpcArgDeps := make(map[string]*pulumirpc.CallRequest_ArgumentDependencies)
+		for k, deps := range argDeps {
+			urns := make([]string, len(deps))
+			for i, d := range deps {
+				urns[i] = string(d)
+			}
+			rpcArgDeps[k] = &pulumirpc.CallRequest_ArgumentDependencies{Urns: urns}
+		}
+
+		logging.V(9).Infof("Call(%s, #args=%d): RPC call being made", tok, len(resolvedArgs))
+		resp, err := ctx.monitor.Call(ctx.ctx, &pulumirpc.CallRequest{
+			Tok:             tok,
+			Args:            rpcArgs,
+			ArgDependencies: rpcArgDeps,
+			Provider:        providerRef,
+			Version:         options.Version,
+		})
+		if err != nil {
+			logging.V(9).Infof("Call(%s, ...): error: %v", tok, err)
+			return
+		}
+
+		// If there were any failures from the provider, return them.
+		if len(resp.Failures) > 0 {
+			logging.V(9).Infof("Call(%s, ...): success: w/ %d failures", tok, len(resp.Failures))
+			for _, failure := range resp.Failures {
+				err = multierror.Append(err,
+					fmt.Errorf("%s call failed: %s (%s)", tok, failure.Reason, failure.Property))
+			}
+			return
+		}
+
+		// Otherwise, unmarshal the return values and resolve the result.
+		retProps, err := plugin.UnmarshalProperties(
+			resp.Return,
+			plugin.MarshalOptions{KeepSecrets: true, KeepResources: true, KeepUnknowns: keepUnknowns},
+		)
+		if err != nil || state == nil {
+			return
+		}
+
+		known := !retProps.ContainsUnknowns()
+
+		deps := []Resource{self}
+		for _, returnDeps := range resp.GetReturnDependencies() {
+			for _, urn := range returnDeps.GetUrns() {
+				deps = append(deps, &ResourceState{urn: URNInput(URN(urn)).ToURNOutput()})
+			}
+		}
+
+		dest := reflect.New(output.ElementType()).Elem()
+		secret, err := unmarshalOutput(resource.NewObjectProperty(retProps), dest)
+		if err != nil {
+			return
+		}
+		logging.V(9).Infof("Call(%s, ...): success: w/ %d outs", tok, len(retProps))
+		state.resolveValue(dest, known, secret, deps)
+	}()
+
+	return result, nil
+}
+
 // ReadResource reads an existing custom resource's state from the resource monitor. t is the fully qualified type
 // token and name is the "name" part to use in creating a stable and globally unique URN for the object. id is the ID
 // of the resource to read, and props contains any state necessary to perform the read (typically props will be nil).
@@ -529,6 +688,9 @@ func (ctx *Context) registerResource(
 		if err != nil {
 			return
 		}
+		if ctx.info.warnSecretInputs {
+			ctx.warnSecretInputs(t, name, inputs, resState)
+		}
 
 		var resp *pulumirpc.RegisterResourceResponse
 		if len(options.URN) > 0 {
@@ -595,6 +757,170 @@ func (ctx *Context) RegisterRemoteComponentResource(
 	return ctx.registerResource(t, name, props, resource, true /*remote*/, opts...)
 }
 
+// ComponentConstructor creates the children of a component resource. The parent option parents resources to the
+// component and should be passed to each of the component's children.
+type ComponentConstructor func(ctx *Context, parent ResourceOption) error
+
+// NewComponent registers a component resource, calls construct to create its children, and then registers the
+// component's outputs. The component's args, if any, are recorded as its inputs. args may be an Input, a struct or a
+// pointer to a struct whose `pulumi`-tagged fields hold the inputs, or a map from input names to inputs; fields and
+// elements may be Inputs or plain values, and nil fields are omitted. Each Output-typed field of component that has a
+// `pulumi` tag and is assigned by construct is registered as an output of the component.
+//
+// For example, given a component with a string-typed output "url" and its args:
+//
+//     type WebSite struct {
+//         pulumi.ResourceState
+//
+//         URL pulumi.StringOutput `pulumi:"url"`
+//     }
+//
+//     type WebSiteArgs struct {
+//         IndexDocument pulumi.StringInput `pulumi:"indexDocument"`
+//     }
+//
+// one would write the component's constructor like so:
+//
+//     func NewWebSite(ctx *pulumi.Context, name string, args *WebSiteArgs,
+//         opts ...pulumi.ResourceOption) (*WebSite, error) {
+//
+//         var site WebSite
+//         err := ctx.NewComponent("my:web:WebSite", name, args, &site,
+//             func(ctx *pulumi.Context, parent pulumi.ResourceOption) error {
+//                 bucket, err := s3.NewBucket(ctx, name, &s3.BucketArgs{...}, parent)
+//                 if err != nil {
+//                     return err
+//                 }
+//                 site.URL = bucket.WebsiteEndpoint
+//                 return nil
+//             }, opts...)
+//         if err != nil {
+//             return nil, err
+//         }
+//         return &site, nil
+//     }
+//
+func (ctx *Context) NewComponent(t, name string, args interface{}, component ComponentResource,
+	construct ComponentConstructor, opts ...ResourceOption) error {
+
+	inputs, err := componentInputs(args)
+	if err != nil {
+		return err
+	}
+	if err := ctx.RegisterResource(t, name, inputs, component, opts...); err != nil {
+		return err
+	}
+
+	// Record the output states assigned during registration so that we only register the outputs that are
+	// assigned by the constructor.
+	registered := componentOutputs(component)
+
+	if err := construct(ctx, Parent(component)); err != nil {
+		return err
+	}
+
+	outputs := Map{}
+	for k, v := range componentOutputs(component) {
+		if registered[k] != v {
+			outputs[k] = v
+		}
+	}
+	return ctx.RegisterResourceOutputs(component, outputs)
+}
+
+// componentInputs converts the args of a component to an Input. Args that implement Input are returned as they are.
+// Other args must be a struct, a pointer to a struct or a map with string keys, and are converted to a Map from the
+// `pulumi` tags or keys to the values of the non-nil fields or elements. Values that are not Inputs are converted with
+// ToOutput, so that they are marshaled in the same way as the inputs of RegisterResource.
+func componentInputs(args interface{}) (Input, error) {
+	if args == nil {
+		return nil, nil
+	}
+	if input, ok := args.(Input); ok {
+		return input, nil
+	}
+
+	v := reflect.ValueOf(args)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil, nil
+		}
+		v = v.Elem()
+	}
+
+	inputs := Map{}
+	add := func(key string, value reflect.Value) {
+		switch value.Kind() {
+		case reflect.Interface, reflect.Ptr, reflect.Map, reflect.Slice:
+			if value.IsNil() {
+				return
+			}
+		}
+		if input, ok := value.Interface().(Input); ok {
+			inputs[key] = input
+		} else {
+			inputs[key] = ToOutput(value.Interface())
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		typ := v.Type()
+		for i := 0; i < typ.NumField(); i++ {
+			field := typ.Field(i)
+			tag := strings.Split(field.Tag.Get("pulumi"), ",")[0]
+			if tag == "" || field.PkgPath != "" {
+				continue
+			}
+			add(tag, v.Field(i))
+		}
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("cannot use %v as component args: map keys must be strings", v.Type())
+		}
+		for _, key := range v.MapKeys() {
+			add(key.String(), v.MapIndex(key))
+		}
+	default:
+		return nil, fmt.Errorf("cannot use %v as component args: expected an Input, a struct or a map", v.Type())
+	}
+	return inputs, nil
+}
+
+// componentOutputs returns the Output-typed fields of the given component that have a `pulumi` tag, keyed by the
+// tag.
+func componentOutputs(component ComponentResource) map[string]Output {
+	v := reflect.ValueOf(component)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	outputs := map[string]Output{}
+	typ := v.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		tag := field.Tag.Get("pulumi")
+		if tag == "" || field.PkgPath != "" || !field.Type.Implements(outputType) {
+			continue
+		}
+
+		fieldV := v.Field(i)
+		if fieldV.Kind() == reflect.Interface && fieldV.IsNil() {
+			continue
+		}
+		if output := fieldV.Interface().(Output); output.getState() != nil {
+			outputs[tag] = output
+		}
+	}
+	return outputs
+}
+
 // resourceState contains the results of a resource registration operation.
 type resourceState struct {
 	outputs         map[string]Output
@@ -881,6 +1207,29 @@ type resourceInputs struct {
 	version                 string
 }
 
+// warnSecretInputs logs a warning for each secret input property of the given resource that is known to be non-secret.
+// A property is known to be non-secret if it is one of the resource's outputs and is not listed as an additional secret
+// output: generated SDKs list every output that the provider's schema marks as secret, so such a property holds a
+// plain value once the resource is registered. Input properties that the resource does not declare as outputs may be
+// secret in the provider's schema, so they never produce warnings.
+func (ctx *Context) warnSecretInputs(t, name string, inputs *resourceInputs, state *resourceState) {
+	secretOutputs := make(map[string]bool)
+	for _, k := range inputs.additionalSecretOutputs {
+		secretOutputs[k] = true
+	}
+	for _, k := range inputs.resolvedProps.StableKeys() {
+		if _, isOutput := state.outputs[string(k)]; !isOutput || secretOutputs[string(k)] {
+			continue
+		}
+		if inputs.resolvedProps[k].ContainsSecrets() {
+			// Note that we do not pass the resource itself to the logger: doing so would wait on its URN, which is not
+			// resolved until registration completes.
+			_ = ctx.Log.Warn(fmt.Sprintf("secret value passed to non-secret property %q of %s resource %q",
+				k, t, name), nil)
+		}
+	}
+}
+
 // prepareResourceInputs prepares the inputs for a resource operation, shared between read and register.
 func (ctx *Context) prepareResourceInputs(props Input, t string,
 	opts *resourceOptions, resource *resourceState) (*resourceInputs, error) {
@@ -1146,6 +1495,56 @@ func (ctx *Context) Export(name string, value Input) {
 	ctx.exports[name] = value
 }
 
+// ExportStruct registers each field of the given struct that has a `pulumi` tag as an output of the current
+// context's stack. The tag supplies the name of the output. Fields may be Inputs or plain values; nil fields are not
+// exported. The type of each exported field is recorded alongside the stack's outputs so that consumers can check it
+// using StackReference.GetOutputs.
+//
+// For example, given the following struct:
+//
+//     type NetworkOutputs struct {
+//         VpcID     pulumi.IDOutput          `pulumi:"vpcId"`
+//         SubnetIDs pulumi.StringArrayOutput `pulumi:"subnetIds"`
+//         Region    string                   `pulumi:"region"`
+//     }
+//
+// ExportStruct(&NetworkOutputs{...}) exports the outputs "vpcId", "subnetIds" and "region".
+func (ctx *Context) ExportStruct(v interface{}) error {
+	structV := reflect.ValueOf(v)
+	for structV.Kind() == reflect.Ptr && !structV.IsNil() {
+		structV = structV.Elem()
+	}
+	if structV.Kind() != reflect.Struct {
+		return fmt.Errorf("ExportStruct expects a struct or a pointer to a struct, got %T", v)
+	}
+
+	typ := structV.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		tag := field.Tag.Get("pulumi")
+		if tag == "" || field.PkgPath != "" {
+			continue
+		}
+
+		fieldV := structV.Field(i)
+		if (fieldV.Kind() == reflect.Interface || fieldV.Kind() == reflect.Ptr) && fieldV.IsNil() {
+			continue
+		}
+
+		var value Input
+		var elementType reflect.Type
+		if input, ok := fieldV.Interface().(Input); ok {
+			value, elementType = input, input.ElementType()
+		} else {
+			value, elementType = ToOutput(fieldV.Interface()), field.Type
+		}
+
+		ctx.exports[tag] = value
+		ctx.exportTypes[tag] = outputTypeSpec(elementType)
+	}
+	return nil
+}
+
 // RegisterStackTransformation adds a transformation to all future resources constructed in this Pulumi stack.
 func (ctx *Context) RegisterStackTransformation(t ResourceTransformation) error {
 	ctx.stack.addTransformation(t)
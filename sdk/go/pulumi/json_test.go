@@ -0,0 +1,72 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulumi
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONMarshal(t *testing.T) {
+	out := JSONMarshal(Map{
+		"a": String("foo"),
+		"b": Array{Int(1), Bool(true)},
+	})
+	v, known, secret, _, err := await(out)
+	assert.Nil(t, err)
+	assert.True(t, known)
+	assert.False(t, secret)
+	assert.Equal(t, `{"a":"foo","b":[1,true]}`, v)
+
+	// Secrets nested within the value make the result secret.
+	out = JSONMarshal(Map{
+		"a": String("foo"),
+		"b": ToSecret(String("bar")),
+	})
+	v, known, secret, _, err = await(out)
+	assert.Nil(t, err)
+	assert.True(t, known)
+	assert.True(t, secret)
+	assert.Equal(t, `{"a":"foo","b":"bar"}`, v)
+
+	// Unknowns nested within the value make the result unknown.
+	unknown := StringOutput{newOutputState(reflect.TypeOf(""))}
+	go unknown.resolve("", false, false, nil)
+
+	_, known, _, _, err = await(JSONMarshal(Map{"a": unknown}))
+	assert.Nil(t, err)
+	assert.False(t, known)
+}
+
+func TestJSONUnmarshal(t *testing.T) {
+	out := JSONUnmarshal(String(`{"a":"foo","b":[1,true]}`))
+	v, known, secret, _, err := await(out)
+	assert.Nil(t, err)
+	assert.True(t, known)
+	assert.False(t, secret)
+	assert.Equal(t, map[string]interface{}{"a": "foo", "b": []interface{}{1.0, true}}, v)
+
+	out = JSONUnmarshal(ToSecret(String(`"foo"`)).(StringOutput))
+	v, known, secret, _, err = await(out)
+	assert.Nil(t, err)
+	assert.True(t, known)
+	assert.True(t, secret)
+	assert.Equal(t, "foo", v)
+
+	_, _, _, _, err = await(JSONUnmarshal(String("{")))
+	assert.NotNil(t, err)
+}
@@ -19,6 +19,35 @@ type MockResourceMonitor interface {
 		provider, id string) (string, resource.PropertyMap, error)
 }
 
+// MockResourceRegistration describes a resource that was registered or read by a program running against mocks,
+// along with the state that the mocks returned for it.
+type MockResourceRegistration struct {
+	URN      string
+	Type     string
+	Name     string
+	ID       string
+	Custom   bool
+	Read     bool // true if the resource was read rather than registered.
+	Inputs   resource.PropertyMap
+	Outputs  resource.PropertyMap
+	Parent   string
+	Provider string
+	Protect  bool
+	// Dependencies are the URNs of the resources that the resource depends on, including those given by DependsOn.
+	Dependencies []string
+	// PropertyDependencies are the URNs of the resources that each input property depends on.
+	PropertyDependencies map[string][]string
+}
+
+// MockResourceObserver may be implemented by a MockResourceMonitor to observe every resource registration, including
+// the resource options that are not passed to NewResource. Its methods may be called concurrently.
+type MockResourceObserver interface {
+	// ResourceRegistered is called after a resource has been registered or read.
+	ResourceRegistered(reg MockResourceRegistration)
+	// ResourceOutputsRegistered is called when a component resource or the stack registers its outputs.
+	ResourceOutputsRegistered(urn string, outputs resource.PropertyMap)
+}
+
 func WithMocks(project, stack string, mocks MockResourceMonitor) RunOption {
 	return func(info *RunInfo) {
 		info.Project, info.Stack, info.Mocks = project, stack, mocks
@@ -84,6 +113,37 @@ func (m *mockMonitor) StreamInvoke(ctx context.Context, in *pulumirpc.InvokeRequ
 	panic("not implemented")
 }
 
+func (m *mockMonitor) Call(ctx context.Context, in *pulumirpc.CallRequest,
+	opts ...grpc.CallOption) (*pulumirpc.CallResponse, error) {
+
+	args, err := plugin.UnmarshalProperties(in.GetArgs(), plugin.MarshalOptions{
+		KeepSecrets:   true,
+		KeepResources: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Method calls are mocked in the same way as invokes: the resource on which the method is called is available to
+	// the mocks as the `__self__` argument.
+	resultV, err := m.mocks.Call(in.GetTok(), args, in.GetProvider())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := plugin.MarshalProperties(resultV, plugin.MarshalOptions{
+		KeepSecrets:   true,
+		KeepResources: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pulumirpc.CallResponse{
+		Return: result,
+	}, nil
+}
+
 func (m *mockMonitor) ReadResource(ctx context.Context, in *pulumirpc.ReadResourceRequest,
 	opts ...grpc.CallOption) (*pulumirpc.ReadResourceResponse, error) {
 
@@ -100,6 +160,23 @@ func (m *mockMonitor) ReadResource(ctx context.Context, in *pulumirpc.ReadResour
 		return nil, err
 	}
 
+	urn := m.newURN(in.GetParent(), in.GetType(), in.GetName())
+	if observer, ok := m.mocks.(MockResourceObserver); ok {
+		observer.ResourceRegistered(MockResourceRegistration{
+			URN:          urn,
+			Type:         in.GetType(),
+			Name:         in.GetName(),
+			ID:           in.GetId(),
+			Custom:       true,
+			Read:         true,
+			Inputs:       stateIn,
+			Outputs:      state,
+			Parent:       in.GetParent(),
+			Provider:     in.GetProvider(),
+			Dependencies: in.GetDependencies(),
+		})
+	}
+
 	stateOut, err := plugin.MarshalProperties(state, plugin.MarshalOptions{
 		KeepSecrets:   true,
 		KeepResources: true,
@@ -109,7 +186,7 @@ func (m *mockMonitor) ReadResource(ctx context.Context, in *pulumirpc.ReadResour
 	}
 
 	return &pulumirpc.ReadResourceResponse{
-		Urn:        m.newURN(in.GetParent(), in.GetType(), in.GetName()),
+		Urn:        urn,
 		Properties: stateOut,
 	}, nil
 }
@@ -136,6 +213,28 @@ func (m *mockMonitor) RegisterResource(ctx context.Context, in *pulumirpc.Regist
 		return nil, err
 	}
 
+	urn := m.newURN(in.GetParent(), in.GetType(), in.GetName())
+	if observer, ok := m.mocks.(MockResourceObserver); ok {
+		propertyDeps := make(map[string][]string)
+		for k, v := range in.GetPropertyDependencies() {
+			propertyDeps[k] = v.GetUrns()
+		}
+		observer.ResourceRegistered(MockResourceRegistration{
+			URN:                  urn,
+			Type:                 in.GetType(),
+			Name:                 in.GetName(),
+			ID:                   id,
+			Custom:               in.GetCustom(),
+			Inputs:               inputs,
+			Outputs:              state,
+			Parent:               in.GetParent(),
+			Provider:             in.GetProvider(),
+			Protect:              in.GetProtect(),
+			Dependencies:         in.GetDependencies(),
+			PropertyDependencies: propertyDeps,
+		})
+	}
+
 	stateOut, err := plugin.MarshalProperties(state, plugin.MarshalOptions{
 		KeepSecrets:   true,
 		KeepResources: true,
@@ -145,7 +244,7 @@ func (m *mockMonitor) RegisterResource(ctx context.Context, in *pulumirpc.Regist
 	}
 
 	return &pulumirpc.RegisterResourceResponse{
-		Urn:    m.newURN(in.GetParent(), in.GetType(), in.GetName()),
+		Urn:    urn,
 		Id:     id,
 		Object: stateOut,
 	}, nil
@@ -154,6 +253,17 @@ func (m *mockMonitor) RegisterResource(ctx context.Context, in *pulumirpc.Regist
 func (m *mockMonitor) RegisterResourceOutputs(ctx context.Context, in *pulumirpc.RegisterResourceOutputsRequest,
 	opts ...grpc.CallOption) (*empty.Empty, error) {
 
+	if observer, ok := m.mocks.(MockResourceObserver); ok {
+		outputs, err := plugin.UnmarshalProperties(in.GetOutputs(), plugin.MarshalOptions{
+			KeepSecrets:   true,
+			KeepResources: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+		observer.ResourceOutputsRegistered(in.GetUrn(), outputs)
+	}
+
 	return &empty.Empty{}, nil
 }
 
@@ -0,0 +1,176 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulumitest
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/cmdutil"
+)
+
+// AcceptEnvVar may be set to a truthy value to write the snapshots compared by AssertGolden instead of comparing
+// them, e.g. after an intentional change to a program.
+const AcceptEnvVar = "PULUMI_ACCEPT"
+
+// TestingT is the subset of *testing.T used by the assertions in this package.
+type TestingT interface {
+	Errorf(format string, args ...interface{})
+}
+
+// AssertInput asserts that the given resource has an input property with the given key and value. Values are
+// compared as plain JSON-like values, so numbers of any type compare equal to the same number and secrets compare
+// equal to their plaintext.
+func AssertInput(t TestingT, res *Resource, key string, expected interface{}) bool {
+	if !assert.NotNil(t, res, "resource not found") {
+		return false
+	}
+	return assertProperty(t, res.Inputs, key, expected, "input of "+res.URN)
+}
+
+// AssertOutput asserts that the given resource has an output property with the given key and value. Values are
+// compared as by AssertInput.
+func AssertOutput(t TestingT, res *Resource, key string, expected interface{}) bool {
+	if !assert.NotNil(t, res, "resource not found") {
+		return false
+	}
+	return assertProperty(t, res.Outputs, key, expected, "output of "+res.URN)
+}
+
+// AssertStackOutput asserts that the stack has an output with the given key and value. Values are compared as by
+// AssertInput.
+func AssertStackOutput(t TestingT, result *Result, key string, expected interface{}) bool {
+	return assertProperty(t, result.Outputs, key, expected, "stack output")
+}
+
+func assertProperty(t TestingT, props resource.PropertyMap, key string, expected interface{}, desc string) bool {
+	actual, ok := props[resource.PropertyKey(key)]
+	if !ok {
+		return assert.Fail(t, "missing property", "%s %q is not set", desc, key)
+	}
+	return assert.Equal(t, plainValue(resource.NewPropertyValue(expected), false), plainValue(actual, false),
+		"%s %q", desc, key)
+}
+
+// plainValue converts a property value into a plain JSON-like value. Secrets are replaced by their plaintext, or, if
+// markSecrets is true, by an object with a single "secret" property that holds their plaintext.
+func plainValue(v resource.PropertyValue, markSecrets bool) interface{} {
+	return v.MapRepl(nil, func(v resource.PropertyValue) (interface{}, bool) {
+		switch {
+		case v.IsSecret():
+			plaintext := plainValue(v.SecretValue().Element, markSecrets)
+			if markSecrets {
+				return map[string]interface{}{"secret": plaintext}, true
+			}
+			return plaintext, true
+		case v.IsResourceReference():
+			return string(v.ResourceReferenceValue().URN), true
+		case v.IsComputed() || v.IsOutput():
+			return "<unknown>", true
+		default:
+			return nil, false
+		}
+	})
+}
+
+// snapshotResource is the form in which a resource is written to a snapshot.
+type snapshotResource struct {
+	URN                  string                 `json:"urn"`
+	ID                   string                 `json:"id,omitempty"`
+	Custom               bool                   `json:"custom,omitempty"`
+	Read                 bool                   `json:"read,omitempty"`
+	Parent               string                 `json:"parent,omitempty"`
+	Provider             string                 `json:"provider,omitempty"`
+	Protect              bool                   `json:"protect,omitempty"`
+	Dependencies         []string               `json:"dependencies,omitempty"`
+	PropertyDependencies map[string][]string    `json:"propertyDependencies,omitempty"`
+	Inputs               map[string]interface{} `json:"inputs,omitempty"`
+	Outputs              map[string]interface{} `json:"outputs,omitempty"`
+}
+
+// Snapshot renders the result as deterministic, indented JSON that is suitable for comparison with a golden file.
+func (r *Result) Snapshot() ([]byte, error) {
+	plainMap := func(props resource.PropertyMap) map[string]interface{} {
+		if len(props) == 0 {
+			return nil
+		}
+		return plainValue(resource.NewObjectProperty(props), true).(map[string]interface{})
+	}
+
+	snapshot := struct {
+		Resources []snapshotResource     `json:"resources"`
+		Outputs   map[string]interface{} `json:"outputs,omitempty"`
+	}{
+		Resources: []snapshotResource{},
+		Outputs:   plainMap(r.Outputs),
+	}
+	for _, res := range r.Resources {
+		propertyDeps := make(map[string][]string)
+		for k, urns := range res.PropertyDependencies {
+			if len(urns) != 0 {
+				propertyDeps[k] = urns
+			}
+		}
+		if len(propertyDeps) == 0 {
+			propertyDeps = nil
+		}
+		snapshot.Resources = append(snapshot.Resources, snapshotResource{
+			URN:                  res.URN,
+			ID:                   res.ID,
+			Custom:               res.Custom,
+			Read:                 res.Read,
+			Parent:               res.Parent,
+			Provider:             res.Provider,
+			Protect:              res.Protect,
+			Dependencies:         res.Dependencies,
+			PropertyDependencies: propertyDeps,
+			Inputs:               plainMap(res.Inputs),
+			Outputs:              plainMap(res.Outputs),
+		})
+	}
+
+	byts, err := json.MarshalIndent(snapshot, "", "    ")
+	if err != nil {
+		return nil, err
+	}
+	return append(byts, '\n'), nil
+}
+
+// AssertGolden asserts that the result's snapshot matches the contents of the golden file at the given path. If the
+// PULUMI_ACCEPT environment variable is set, the snapshot is written to the file instead.
+func (r *Result) AssertGolden(t TestingT, path string) bool {
+	actual, err := r.Snapshot()
+	if !assert.NoError(t, err, "rendering snapshot") {
+		return false
+	}
+
+	if cmdutil.IsTruthy(os.Getenv(AcceptEnvVar)) {
+		if err = os.MkdirAll(filepath.Dir(path), 0700); err == nil {
+			err = ioutil.WriteFile(path, actual, 0600)
+		}
+		return assert.NoError(t, err, "writing golden file")
+	}
+
+	expected, err := ioutil.ReadFile(path)
+	if !assert.NoError(t, err, "reading golden file; set %s=true to create it", AcceptEnvVar) {
+		return false
+	}
+	return assert.Equal(t, string(expected), string(actual), "snapshot does not match %s", path)
+}
@@ -0,0 +1,252 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pulumitest provides utilities for unit testing Pulumi programs written in Go. Run executes a program
+// against mocks and records every resource that the program registers, along with its inputs, resource options and
+// outputs, so that tests can query and assert against them without awaiting outputs by hand:
+//
+//	result, err := pulumitest.Run(program)
+//	require.NoError(t, err)
+//	bucket := result.Find("aws:s3/bucket:Bucket", "site")
+//	pulumitest.AssertInput(t, bucket, "acl", "public-read")
+//	result.AssertGolden(t, "testdata/site.json")
+package pulumitest
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+// Resource is a resource that was registered or read by a program.
+type Resource struct {
+	URN      string
+	Type     string
+	Name     string
+	ID       string
+	Custom   bool
+	Read     bool // true if the resource was read rather than registered.
+	Inputs   resource.PropertyMap
+	Outputs  resource.PropertyMap
+	Parent   string
+	Provider string
+	Protect  bool
+	// Dependencies are the URNs of the resources that the resource depends on, including those given by DependsOn.
+	Dependencies []string
+	// PropertyDependencies are the URNs of the resources that each input property depends on.
+	PropertyDependencies map[string][]string
+}
+
+// Result is the record of a program run.
+type Result struct {
+	// Resources are the resources that the program registered or read, sorted by URN.
+	Resources []*Resource
+	// Outputs are the stack's outputs.
+	Outputs resource.PropertyMap
+}
+
+// Find returns the resource with the given type and name, or nil if there is no such resource.
+func (r *Result) Find(typ, name string) *Resource {
+	for _, res := range r.Resources {
+		if res.Type == typ && res.Name == name {
+			return res
+		}
+	}
+	return nil
+}
+
+// FindByType returns the resources with the given type.
+func (r *Result) FindByType(typ string) []*Resource {
+	var resources []*Resource
+	for _, res := range r.Resources {
+		if res.Type == typ {
+			resources = append(resources, res)
+		}
+	}
+	return resources
+}
+
+// FindByURN returns the resource with the given URN, or nil if there is no such resource.
+func (r *Result) FindByURN(urn string) *Resource {
+	for _, res := range r.Resources {
+		if res.URN == urn {
+			return res
+		}
+	}
+	return nil
+}
+
+// Children returns the resources whose parent is the given resource.
+func (r *Result) Children(parent *Resource) []*Resource {
+	var resources []*Resource
+	for _, res := range r.Resources {
+		if res.Parent == parent.URN {
+			resources = append(resources, res)
+		}
+	}
+	return resources
+}
+
+// Option configures a program run.
+type Option func(*options)
+
+type options struct {
+	project string
+	stack   string
+	config  map[string]string
+	mocks   pulumi.MockResourceMonitor
+}
+
+// Project sets the name of the project that the program runs in. Defaults to "project".
+func Project(name string) Option {
+	return func(o *options) {
+		o.project = name
+	}
+}
+
+// Stack sets the name of the stack that the program runs in. Defaults to "stack".
+func Stack(name string) Option {
+	return func(o *options) {
+		o.stack = name
+	}
+}
+
+// Config sets the program's configuration. Keys must be namespaced, e.g. "project:key".
+func Config(config map[string]string) Option {
+	return func(o *options) {
+		o.config = config
+	}
+}
+
+// Mocks sets the mocks that provide the IDs and outputs of resources and the results of invokes. By default,
+// resources echo their inputs as their outputs, and invokes return no results.
+func Mocks(mocks pulumi.MockResourceMonitor) Option {
+	return func(o *options) {
+		o.mocks = mocks
+	}
+}
+
+// Run runs the given program against mocks and returns a record of the resources that it registered. If the program
+// fails, Run returns the error along with the resources registered before it failed.
+func Run(program pulumi.RunFunc, opts ...Option) (*Result, error) {
+	o := options{project: "project", stack: "stack", mocks: defaultMocks{}}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	rec := &recorder{MockResourceMonitor: o.mocks}
+	err := pulumi.RunErr(program, pulumi.WithMocks(o.project, o.stack, rec), func(info *pulumi.RunInfo) {
+		if o.config != nil {
+			info.Config = o.config
+		}
+	})
+	return rec.result(), err
+}
+
+// recorder wraps a program's mocks in order to record the resources that the program registers.
+type recorder struct {
+	pulumi.MockResourceMonitor
+
+	m         sync.Mutex
+	resources []*Resource
+	outputs   map[string]resource.PropertyMap
+}
+
+func (r *recorder) ResourceRegistered(reg pulumi.MockResourceRegistration) {
+	if observer, ok := r.MockResourceMonitor.(pulumi.MockResourceObserver); ok {
+		observer.ResourceRegistered(reg)
+	}
+
+	// Component resources do not have IDs, even if the mocks return them.
+	id := reg.ID
+	if !reg.Custom {
+		id = ""
+	}
+
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	r.resources = append(r.resources, &Resource{
+		URN:                  reg.URN,
+		Type:                 reg.Type,
+		Name:                 reg.Name,
+		ID:                   id,
+		Custom:               reg.Custom,
+		Read:                 reg.Read,
+		Inputs:               reg.Inputs,
+		Outputs:              reg.Outputs,
+		Parent:               reg.Parent,
+		Provider:             reg.Provider,
+		Protect:              reg.Protect,
+		Dependencies:         reg.Dependencies,
+		PropertyDependencies: reg.PropertyDependencies,
+	})
+}
+
+func (r *recorder) ResourceOutputsRegistered(urn string, outputs resource.PropertyMap) {
+	if observer, ok := r.MockResourceMonitor.(pulumi.MockResourceObserver); ok {
+		observer.ResourceOutputsRegistered(urn, outputs)
+	}
+
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	if r.outputs == nil {
+		r.outputs = make(map[string]resource.PropertyMap)
+	}
+	r.outputs[urn] = outputs
+}
+
+func (r *recorder) result() *Result {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	result := &Result{Outputs: resource.PropertyMap{}}
+	for urn, outputs := range r.outputs {
+		if resource.URN(urn).IsValid() && resource.URN(urn).Type() == resource.RootStackType {
+			result.Outputs = outputs
+		}
+	}
+
+	// Resources are registered concurrently, so sort them in order to make the result deterministic. Components
+	// report their outputs separately from their registration.
+	result.Resources = append(result.Resources, r.resources...)
+	sort.Slice(result.Resources, func(i, j int) bool {
+		return result.Resources[i].URN < result.Resources[j].URN
+	})
+	for _, res := range result.Resources {
+		if outputs, ok := r.outputs[res.URN]; ok && !res.Custom {
+			res.Outputs = outputs
+		}
+	}
+	return result
+}
+
+// defaultMocks echoes each resource's inputs as its outputs, and returns no results from invokes.
+type defaultMocks struct{}
+
+func (defaultMocks) NewResource(typeToken, name string, inputs resource.PropertyMap,
+	provider, id string) (string, resource.PropertyMap, error) {
+
+	if id == "" {
+		id = name + "_id"
+	}
+	return id, inputs, nil
+}
+
+func (defaultMocks) Call(token string, args resource.PropertyMap, provider string) (resource.PropertyMap, error) {
+	return resource.PropertyMap{}, nil
+}
@@ -0,0 +1,161 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulumitest
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi/config"
+)
+
+type testResource struct {
+	pulumi.CustomResourceState
+
+	Outputs pulumi.MapOutput `pulumi:""`
+}
+
+type testComponent struct {
+	pulumi.ResourceState
+}
+
+func testProgram(ctx *pulumi.Context) error {
+	var component testComponent
+	err := ctx.RegisterComponentResource("test:index:Component", "site", &component)
+	if err != nil {
+		return err
+	}
+
+	var bucket testResource
+	err = ctx.RegisterResource("test:index:Bucket", "bucket", pulumi.Map{
+		"acl":  pulumi.String(config.Get(ctx, "acl")),
+		"size": pulumi.Int(3),
+	}, &bucket, pulumi.Parent(&component), pulumi.Protect(true))
+	if err != nil {
+		return err
+	}
+
+	var object testResource
+	err = ctx.RegisterResource("test:index:Object", "index", pulumi.Map{
+		"bucket": bucket.ID(),
+		"secret": pulumi.ToSecret(pulumi.String("hunter2")),
+	}, &object, pulumi.Parent(&component), pulumi.DependsOn([]pulumi.Resource{&bucket}))
+	if err != nil {
+		return err
+	}
+
+	if err = ctx.RegisterResourceOutputs(&component, pulumi.Map{"bucket": bucket.ID()}); err != nil {
+		return err
+	}
+	ctx.Export("bucketId", bucket.ID())
+	return nil
+}
+
+func TestRun(t *testing.T) {
+	result, err := Run(testProgram, Config(map[string]string{"project:acl": "private"}))
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	assert.Len(t, result.Resources, 3)
+
+	component := result.Find("test:index:Component", "site")
+	if assert.NotNil(t, component) {
+		assert.False(t, component.Custom)
+		AssertOutput(t, component, "bucket", "bucket_id")
+	}
+
+	bucket := result.Find("test:index:Bucket", "bucket")
+	if assert.NotNil(t, bucket) {
+		assert.Equal(t, "bucket_id", bucket.ID)
+		assert.Equal(t, component.URN, bucket.Parent)
+		assert.True(t, bucket.Protect)
+		AssertInput(t, bucket, "acl", "private")
+		AssertInput(t, bucket, "size", 3)
+		AssertOutput(t, bucket, "size", 3.0)
+		assert.Equal(t, []*Resource{bucket}, result.FindByType("test:index:Bucket"))
+		assert.Equal(t, bucket, result.FindByURN(bucket.URN))
+	}
+
+	object := result.Find("test:index:Object", "index")
+	if assert.NotNil(t, object) {
+		assert.Contains(t, object.Dependencies, bucket.URN)
+		assert.Equal(t, []string{bucket.URN}, object.PropertyDependencies["bucket"])
+		AssertInput(t, object, "bucket", "bucket_id")
+		AssertInput(t, object, "secret", "hunter2")
+		assert.True(t, object.Inputs["secret"].IsSecret())
+	}
+
+	assert.ElementsMatch(t, []*Resource{bucket, object}, result.Children(component))
+	AssertStackOutput(t, result, "bucketId", "bucket_id")
+	assert.Nil(t, result.Find("test:index:Bucket", "missing"))
+
+	result.AssertGolden(t, "testdata/program.json")
+}
+
+type recordingT struct {
+	failures int
+}
+
+func (t *recordingT) Errorf(format string, args ...interface{}) {
+	t.failures++
+}
+
+func TestAssertionsFail(t *testing.T) {
+	result, err := Run(testProgram, Config(map[string]string{"project:acl": "private"}))
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	rt := &recordingT{}
+	assert.False(t, AssertInput(rt, result.Find("test:index:Bucket", "bucket"), "acl", "public"))
+	assert.False(t, AssertInput(rt, result.Find("test:index:Bucket", "bucket"), "missing", "public"))
+	assert.False(t, AssertOutput(rt, result.Find("test:index:Bucket", "missing"), "acl", "private"))
+	assert.False(t, AssertStackOutput(rt, result, "bucketId", "other"))
+	assert.False(t, result.AssertGolden(rt, "testdata/missing.json"))
+	assert.Equal(t, 5, rt.failures)
+}
+
+type failingMocks struct{}
+
+func (failingMocks) NewResource(typeToken, name string, inputs resource.PropertyMap,
+	provider, id string) (string, resource.PropertyMap, error) {
+
+	if typeToken == "test:index:Object" {
+		return "", nil, errors.New("object failed")
+	}
+	return name, inputs, nil
+}
+
+func (failingMocks) Call(token string, args resource.PropertyMap, provider string) (resource.PropertyMap, error) {
+	return nil, nil
+}
+
+func TestRunWithMocks(t *testing.T) {
+	result, err := Run(testProgram, Mocks(failingMocks{}), Project("proj"), Stack("dev"))
+	assert.Error(t, err)
+
+	// The resources registered before the failure are still recorded.
+	bucket := result.Find("test:index:Bucket", "bucket")
+	if assert.NotNil(t, bucket) {
+		assert.Equal(t, "urn:pulumi:dev::proj::test:index:Component$test:index:Bucket::bucket", bucket.URN)
+		assert.Equal(t, "bucket", bucket.ID)
+	}
+	assert.Nil(t, result.Find("test:index:Object", "index"))
+}
@@ -187,6 +187,12 @@ func marshalInputAndDetermineSecret(v interface{},
 	for {
 		valueType := reflect.TypeOf(v)
 
+		// A nil pointer is null. Check for this before treating the value as an Input, as the Input methods of args
+		// structs have value receivers and cannot be called through a nil pointer.
+		if rv := reflect.ValueOf(v); rv.Kind() == reflect.Ptr && rv.IsNil() {
+			return resource.PropertyValue{}, nil, secret, nil
+		}
+
 		// If this is an Input, make sure it is of the proper type and await it if it is an output/
 		var deps []Resource
 		if input, ok := v.(Input); ok {
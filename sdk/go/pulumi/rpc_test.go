@@ -178,6 +178,25 @@ func init() {
 	RegisterOutputType(nestedTypeOutput{})
 }
 
+type plainNestedArgs struct {
+	Nested *nestedType `pulumi:"nested"`
+}
+
+type plainNestedInputs struct {
+	Nested *nestedTypeInputs
+}
+
+func (*plainNestedInputs) ElementType() reflect.Type {
+	return reflect.TypeOf((*plainNestedArgs)(nil))
+}
+
+// Test that a nil pointer to an args struct whose Input methods have value receivers marshals as null.
+func TestMarshalNilPlainArgs(t *testing.T) {
+	pmap, _, _, err := marshalInputs(&plainNestedInputs{})
+	assert.NoError(t, err)
+	assert.Empty(t, pmap)
+}
+
 type testResourceArgs struct {
 	URN URN `pulumi:"urn"`
 	ID  ID  `pulumi:"id"`
@@ -24,6 +24,7 @@ import (
 
 	multierror "github.com/hashicorp/go-multierror"
 
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
 )
 
@@ -47,6 +48,17 @@ func Run(body RunFunc, opts ...RunOption) {
 	}
 }
 
+// WithSecretInputWarnings causes the program to log a warning whenever a secret value is passed as the input to a
+// resource property that is known to be non-secret, which frequently means that a secret is being exposed as a plain
+// output. This is a heuristic: a property is considered non-secret if it is also one of the resource's outputs and is
+// not listed in the resource's AdditionalSecretOutputs, which generated SDKs populate with the outputs that the
+// provider's schema marks as secret. Secret inputs that are not outputs of the resource never produce warnings.
+func WithSecretInputWarnings() RunOption {
+	return func(info *RunInfo) {
+		info.warnSecretInputs = true
+	}
+}
+
 // RunErr executes the body of a Pulumi program, granting it access to a deployment context that it may use
 // to register resources and orchestrate deployment activities.  This connects back to the Pulumi engine using gRPC.
 func RunErr(body RunFunc, opts ...RunOption) error {
@@ -96,13 +108,21 @@ func RunWithContext(ctx *Context, body RunFunc) error {
 	}
 	ctx.stack = &stack
 
+	// Apply any stack defaults supplied through configuration.
+	if err = ctx.registerStackDefaultsFromConfig(); err != nil {
+		return err
+	}
+
 	// Execute the body.
 	var result error
 	if err = body(ctx); err != nil {
 		result = multierror.Append(result, err)
 	}
 
-	// Register all the outputs to the stack object.
+	// Register all the outputs to the stack object, along with the types of any outputs exported from structs.
+	if len(ctx.exportTypes) > 0 {
+		ctx.exports[string(resource.StackOutputTypesKey)] = ToOutput(ctx.exportTypes)
+	}
 	if err = ctx.RegisterResourceOutputs(ctx.stack, Map(ctx.exports)); err != nil {
 		result = multierror.Append(result, err)
 	}
@@ -132,6 +152,8 @@ type RunInfo struct {
 	EngineAddr  string
 	Mocks       MockResourceMonitor
 	getPlugins  bool
+
+	warnSecretInputs bool
 }
 
 // getEnvInfo reads various program information from the process environment.
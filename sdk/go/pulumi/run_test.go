@@ -1,6 +1,9 @@
 package pulumi
 
 import (
+	"bytes"
+	"context"
+	"log"
 	"reflect"
 	"testing"
 
@@ -238,3 +241,169 @@ func TestInvoke(t *testing.T) {
 	}, WithMocks("project", "stack", mocks))
 	assert.NoError(t, err)
 }
+
+type callResult struct {
+	Foo string `pulumi:"foo"`
+}
+
+type callResultOutput struct{ *OutputState }
+
+func (callResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf(callResult{})
+}
+
+func TestCall(t *testing.T) {
+	mocks := &testMonitor{
+		CallF: func(token string, args resource.PropertyMap, provider string) (resource.PropertyMap, error) {
+			assert.Equal(t, "test:resource:type/method", token)
+			assert.Equal(t, "gnab", args["bang"].StringValue())
+
+			self := args["__self__"]
+			assert.True(t, self.IsResourceReference())
+			assert.Equal(t, "resA", string(self.ResourceReferenceValue().URN.Name()))
+
+			return resource.NewPropertyMapFromMap(map[string]interface{}{
+				"foo": "oof",
+			}), nil
+		},
+	}
+
+	err := RunErr(func(ctx *Context) error {
+		var res testResource2
+		err := ctx.RegisterResource("test:resource:type", "resA", &testResource2Inputs{
+			Foo: String("oof"),
+		}, &res)
+		assert.NoError(t, err)
+
+		out, err := ctx.Call("test:resource:type/method", Map{"bang": String("gnab")}, callResultOutput{}, &res)
+		assert.NoError(t, err)
+
+		result, known, secret, deps, err := out.(callResultOutput).await(context.Background())
+		assert.NoError(t, err)
+		assert.True(t, known)
+		assert.False(t, secret)
+		assert.Equal(t, []Resource{&res}, deps)
+		assert.Equal(t, "oof", result.(callResult).Foo)
+
+		return nil
+	}, WithMocks("project", "stack", mocks))
+	assert.NoError(t, err)
+}
+
+type testComponent struct {
+	ResourceState
+
+	Foo   StringOutput `pulumi:"foo"`
+	Child StringOutput `pulumi:"child"`
+	Unset StringOutput `pulumi:"unset"`
+}
+
+func TestNewComponent(t *testing.T) {
+	mocks := &registrationObserver{}
+	mocks.NewResourceF = func(typeToken, name string, inputs resource.PropertyMap,
+		provider, id string) (string, resource.PropertyMap, error) {
+		return name + "_id", resource.PropertyMap{"foo": resource.NewStringProperty("child-foo")}, nil
+	}
+
+	err := RunErr(func(ctx *Context) error {
+		var component testComponent
+		err := ctx.NewComponent("test:index:Component", "comp", &testResource2Inputs{
+			Foo: String("oof"),
+		}, &component, func(ctx *Context, parent ResourceOption) error {
+			var child testResource2
+			if err := ctx.RegisterResource("test:resource:type", "child", nil, &child, parent); err != nil {
+				return err
+			}
+			component.Foo = String("foo").ToStringOutput()
+			component.Child = child.Foo
+			return nil
+		})
+		assert.NoError(t, err)
+		return nil
+	}, WithMocks("project", "stack", mocks))
+	assert.NoError(t, err)
+
+	comp, ok := mocks.registrations["comp"]
+	if !assert.True(t, ok) {
+		return
+	}
+	assert.Equal(t, resource.NewPropertyMapFromMap(map[string]interface{}{"foo": "oof"}), comp.Inputs)
+
+	child := mocks.registrations["child"]
+	assert.Equal(t, comp.URN, child.Parent)
+
+	assert.Equal(t, resource.NewPropertyMapFromMap(map[string]interface{}{
+		"foo":   "foo",
+		"child": "child-foo",
+	}), mocks.outputs[comp.URN])
+}
+
+type testComponentArgs struct {
+	Foo   StringInput `pulumi:"foo"`
+	Count int         `pulumi:"count"`
+	Unset StringInput `pulumi:"unset"`
+	Plain string
+}
+
+func TestNewComponentPlainArgs(t *testing.T) {
+	mocks := &registrationObserver{}
+	construct := func(ctx *Context, parent ResourceOption) error { return nil }
+
+	err := RunErr(func(ctx *Context) error {
+		// A struct of inputs does not need to implement Input.
+		var structComponent testComponent
+		assert.NoError(t, ctx.NewComponent("test:index:Component", "struct", &testComponentArgs{
+			Foo:   ToSecret(String("oof")).(StringOutput),
+			Count: 2,
+			Plain: "untagged",
+		}, &structComponent, construct))
+
+		var mapComponent testComponent
+		assert.NoError(t, ctx.NewComponent("test:index:Component", "map", map[string]interface{}{
+			"foo":   String("oof"),
+			"count": 2,
+		}, &mapComponent, construct))
+
+		var invalidComponent testComponent
+		assert.Error(t, ctx.NewComponent("test:index:Component", "invalid", "args", &invalidComponent, construct))
+		return nil
+	}, WithMocks("project", "stack", mocks))
+	assert.NoError(t, err)
+
+	assert.Equal(t, resource.PropertyMap{
+		"foo":   resource.MakeSecret(resource.NewStringProperty("oof")),
+		"count": resource.NewNumberProperty(2),
+	}, mocks.registrations["struct"].Inputs)
+	assert.Equal(t, resource.NewPropertyMapFromMap(map[string]interface{}{"foo": "oof", "count": 2}),
+		mocks.registrations["map"].Inputs)
+}
+
+func TestSecretInputWarnings(t *testing.T) {
+	var logs bytes.Buffer
+	err := RunErr(func(ctx *Context) error {
+		ctx.engine = &mockEngine{logger: log.New(&logs, "", 0)}
+		ctx.Log = &logState{engine: ctx.engine, ctx: ctx.ctx}
+
+		// foo is a plain output of the resource, so passing a secret to it warns. bar is not an output, so it may be
+		// secret in the provider's schema and does not warn.
+		var res testResource2
+		err := ctx.RegisterResource("test:resource:type", "resA", &testResource2Inputs{
+			Foo: ToSecret(String("oof")).(StringOutput),
+			Bar: ToSecret(String("rab")).(StringOutput),
+		}, &res)
+		assert.NoError(t, err)
+
+		// Outputs listed in AdditionalSecretOutputs do not warn.
+		var secretRes testResource2
+		err = ctx.RegisterResource("test:resource:type", "resB", &testResource2Inputs{
+			Foo: ToSecret(String("oof")).(StringOutput),
+		}, &secretRes, AdditionalSecretOutputs([]string{"foo"}))
+		assert.NoError(t, err)
+		return nil
+	}, WithMocks("project", "stack", &testMonitor{}), WithSecretInputWarnings())
+	assert.NoError(t, err)
+
+	assert.Equal(t,
+		"WARNING: secret value passed to non-secret property \"foo\" of test:resource:type resource \"resA\"\n",
+		logs.String())
+}
@@ -0,0 +1,316 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulumi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+)
+
+// stackDefaultsConfigKey is the configuration key from which stack defaults are read. Its value must be the JSON
+// encoding of a StackDefaults value.
+const stackDefaultsConfigKey = "pulumi:stackDefaults"
+
+// tagsProperty is the name of the input property into which default tags are merged.
+const tagsProperty = "tags"
+
+// StackDefaults describes default properties and resource options that are applied to every matching resource in
+// the stack. Rules are applied in order, so later rules take precedence over earlier rules.
+//
+// Stack defaults may be registered by the program using Context.RegisterStackDefaults or supplied through the
+// `pulumi:stackDefaults` configuration key, for example:
+//
+//     $ pulumi config set --path 'pulumi:stackDefaults.rules[0].type' 'aws:*'
+//     $ pulumi config set --path 'pulumi:stackDefaults.rules[0].tags.team' platform
+//
+type StackDefaults struct {
+	// Rules is the list of rules to apply.
+	Rules []StackDefaultRule `json:"rules"`
+}
+
+// StackDefaultRule describes the defaults that apply to resources whose type matches a pattern.
+type StackDefaultRule struct {
+	// Type is the pattern matched against resource type tokens. `*` matches any sequence of characters, so
+	// "aws:s3/*" matches every resource in the aws:s3 module and "*" matches every resource.
+	Type string `json:"type"`
+	// Tags are merged into the `tags` input of matching resources that have one. Tags set by the program take
+	// precedence over these defaults. Resources without a `tags` input are left unchanged.
+	Tags map[string]string `json:"tags,omitempty"`
+	// Properties are the default values for inputs of matching resources that are not set by the program. Each key is
+	// the name of an input property as it appears in the package schema and in the `pulumi:"..."` tags of the
+	// resource's input type (e.g. "bucketName", not "BucketName"). Keys that do not name an input of a matching
+	// resource are ignored for that resource.
+	Properties map[string]interface{} `json:"properties,omitempty"`
+	// Protect, if set, overrides the protect option of matching resources.
+	Protect *bool `json:"protect,omitempty"`
+	// IgnoreChanges lists additional properties whose changes are ignored for matching resources.
+	IgnoreChanges []string `json:"ignoreChanges,omitempty"`
+	// Provider, if set, is used for matching resources that belong to its package and do not have a provider
+	// specified by the program or inherited from their parent.
+	Provider ProviderResource `json:"-"`
+}
+
+// RegisterStackDefaults registers the given defaults with the current stack. The defaults apply to all resources
+// constructed after this call.
+func (ctx *Context) RegisterStackDefaults(defaults StackDefaults) error {
+	transformation, err := defaults.transformation()
+	if err != nil {
+		return err
+	}
+	return ctx.RegisterStackTransformation(transformation)
+}
+
+// registerStackDefaultsFromConfig registers the stack defaults supplied in the stack's configuration, if any.
+func (ctx *Context) registerStackDefaultsFromConfig() error {
+	v, ok := ctx.GetConfig(stackDefaultsConfigKey)
+	if !ok {
+		return nil
+	}
+
+	var defaults StackDefaults
+	if err := json.Unmarshal([]byte(v), &defaults); err != nil {
+		return fmt.Errorf("invalid value for configuration key %v: %w", stackDefaultsConfigKey, err)
+	}
+	return ctx.RegisterStackDefaults(defaults)
+}
+
+// transformation returns a resource transformation that applies the defaults.
+func (d StackDefaults) transformation() (ResourceTransformation, error) {
+	patterns := make([]*regexp.Regexp, len(d.Rules))
+	for i, rule := range d.Rules {
+		if rule.Type == "" {
+			return nil, fmt.Errorf("stack default rule %d is missing a type pattern", i)
+		}
+		quoted := strings.ReplaceAll(regexp.QuoteMeta(rule.Type), `\*`, `.*`)
+		patterns[i] = regexp.MustCompile("^" + quoted + "$")
+	}
+
+	return func(args *ResourceTransformationArgs) *ResourceTransformationResult {
+		props, opts, matched := args.Props, args.Opts, false
+		for i, rule := range d.Rules {
+			if !patterns[i].MatchString(args.Type) {
+				continue
+			}
+			matched = true
+
+			props = rule.applyToProps(props)
+			opts = rule.applyToOpts(args.Type, opts)
+		}
+		if !matched {
+			return nil
+		}
+		return &ResourceTransformationResult{Props: props, Opts: opts}
+	}, nil
+}
+
+// applyToProps returns a copy of the given properties with the rule's default tags and properties applied.
+func (r StackDefaultRule) applyToProps(props Input) Input {
+	if props == nil || (len(r.Tags) == 0 && len(r.Properties) == 0) {
+		return props
+	}
+
+	pv := reflect.ValueOf(props)
+	isPtr := pv.Kind() == reflect.Ptr
+	if isPtr {
+		if pv.IsNil() {
+			return props
+		}
+		pv = pv.Elem()
+	}
+
+	rt := props.ElementType()
+	if rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+
+	switch pv.Kind() {
+	case reflect.Struct:
+		if rt.Kind() != reflect.Struct {
+			return props
+		}
+
+		result := reflect.New(pv.Type()).Elem()
+		result.Set(pv)
+
+		// Match each input field to its property by the wire name in the `pulumi` tag of the corresponding field of
+		// the resolved type. Input fields without such a field are not properties and are left unchanged.
+		for i := 0; i < result.NumField(); i++ {
+			field := result.Field(i)
+			if !field.CanSet() {
+				continue
+			}
+
+			destField, ok := rt.FieldByName(pv.Type().Field(i).Name)
+			if !ok {
+				continue
+			}
+			tag := strings.Split(destField.Tag.Get("pulumi"), ",")[0]
+			if tag == "" {
+				continue
+			}
+
+			var value Input
+			if tag == tagsProperty && len(r.Tags) > 0 {
+				value = mergeDefaultTags(field.Interface(), r.Tags, destField.Type)
+			} else if v, ok := r.Properties[tag]; ok && field.IsZero() {
+				value = defaultInput(v, destField.Type)
+			}
+			if value != nil && reflect.TypeOf(value).AssignableTo(field.Type()) {
+				field.Set(reflect.ValueOf(value))
+			}
+		}
+
+		if isPtr {
+			return result.Addr().Interface().(Input)
+		}
+		return result.Interface().(Input)
+	case reflect.Map:
+		if pv.Type().Key().Kind() != reflect.String || !inputType.AssignableTo(pv.Type().Elem()) {
+			return props
+		}
+
+		result := reflect.MakeMap(pv.Type())
+		for _, k := range pv.MapKeys() {
+			result.SetMapIndex(k, pv.MapIndex(k))
+		}
+
+		set := func(key string, value Input) {
+			if value != nil {
+				result.SetMapIndex(reflect.ValueOf(key).Convert(pv.Type().Key()), reflect.ValueOf(value))
+			}
+		}
+		for k, v := range r.Properties {
+			if !result.MapIndex(reflect.ValueOf(k).Convert(pv.Type().Key())).IsValid() {
+				set(k, defaultInput(v, rt.Elem()))
+			}
+		}
+		if len(r.Tags) > 0 {
+			var existing interface{}
+			if v := result.MapIndex(reflect.ValueOf(tagsProperty).Convert(pv.Type().Key())); v.IsValid() {
+				existing = v.Interface()
+			}
+			set(tagsProperty, mergeDefaultTags(existing, r.Tags, rt.Elem()))
+		}
+
+		if isPtr {
+			ptr := reflect.New(pv.Type())
+			ptr.Elem().Set(result)
+			return ptr.Interface().(Input)
+		}
+		return result.Interface().(Input)
+	default:
+		return props
+	}
+}
+
+// applyToOpts returns the given resource options with the rule's default options appended.
+func (r StackDefaultRule) applyToOpts(t string, opts []ResourceOption) []ResourceOption {
+	result := append([]ResourceOption{}, opts...)
+	if r.Protect != nil {
+		result = append(result, Protect(*r.Protect))
+	}
+	if len(r.IgnoreChanges) > 0 {
+		result = append(result, IgnoreChanges(r.IgnoreChanges))
+	}
+	if r.Provider != nil {
+		pkg := getPackage(t)
+		if r.Provider.getPackage() == pkg {
+			options := merge(opts...)
+			_, hasProvider := options.Providers[pkg]
+			if !hasProvider && options.Parent != nil {
+				_, hasProvider = options.Parent.getProviders()[pkg]
+			}
+			if !hasProvider {
+				result = append(result, Provider(r.Provider))
+			}
+		}
+	}
+	return result
+}
+
+// defaultInput converts the given default value into an Input that is assignable to an input property whose resolved
+// type is destType. If the value cannot be converted, the returned Input is rejected with an error.
+func defaultInput(v interface{}, destType reflect.Type) Input {
+	element := reflect.New(destType).Elem()
+	if _, err := unmarshalOutput(resource.NewPropertyValue(v), element); err != nil {
+		result := newOutput(outputTypeForElement(destType))
+		result.getState().reject(fmt.Errorf("invalid stack default: %w", err))
+		return result
+	}
+	return ToOutput(element.Interface())
+}
+
+// mergeDefaultTags returns an Input that resolves to the given default tags merged with the existing tags, if any.
+// Existing tags take precedence over the defaults. Returns nil if the tags property is not a map.
+func mergeDefaultTags(existing interface{}, tags map[string]string, destType reflect.Type) Input {
+	tagsType := destType
+	if tagsType.Kind() == reflect.Interface {
+		tagsType = mapType
+	}
+	if tagsType.Kind() != reflect.Map || tagsType.Key().Kind() != reflect.String {
+		return nil
+	}
+
+	defaults := make(map[string]interface{}, len(tags))
+	for k, v := range tags {
+		defaults[k] = v
+	}
+
+	if existing == nil || (reflect.ValueOf(existing).Kind() == reflect.Ptr && reflect.ValueOf(existing).IsNil()) {
+		return defaultInput(defaults, tagsType)
+	}
+
+	defaultsV := reflect.New(tagsType).Elem()
+	if _, err := unmarshalOutput(resource.NewPropertyValue(defaults), defaultsV); err != nil {
+		result := newOutput(outputTypeForElement(tagsType))
+		result.getState().reject(fmt.Errorf("invalid stack default: %w", err))
+		return result
+	}
+
+	result := newOutput(outputTypeForElement(tagsType), gatherDependencies(existing)...)
+	go func() {
+		existingV := reflect.New(tagsType).Elem()
+		known, secret, deps, err := awaitInputs(context.Background(), reflect.ValueOf(existing), existingV)
+		if err != nil || !known {
+			result.getState().fulfill(nil, known, secret, deps, err)
+			return
+		}
+
+		merged := reflect.MakeMap(tagsType)
+		for _, k := range defaultsV.MapKeys() {
+			merged.SetMapIndex(k, defaultsV.MapIndex(k))
+		}
+		for _, k := range existingV.MapKeys() {
+			merged.SetMapIndex(k, existingV.MapIndex(k))
+		}
+		result.getState().resolveValue(merged, true, secret, deps)
+	}()
+	return result
+}
+
+// outputTypeForElement returns the Output type whose element type is the given type, or AnyOutput if there is no
+// such type.
+func outputTypeForElement(t reflect.Type) reflect.Type {
+	if ot, ok := concreteTypeToOutputType.Load(t); ok {
+		return ot.(reflect.Type)
+	}
+	return anyOutputType
+}
@@ -0,0 +1,190 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulumi
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+type taggedResourceArgs struct {
+	Name string            `pulumi:"name"`
+	Size *int              `pulumi:"size"`
+	Tags map[string]string `pulumi:"tags"`
+}
+
+type taggedResourceInputs struct {
+	Name StringInput
+	Size IntPtrInput
+	Tags StringMapInput
+}
+
+func (*taggedResourceInputs) ElementType() reflect.Type {
+	return reflect.TypeOf((*taggedResourceArgs)(nil))
+}
+
+type renamedResourceArgs struct {
+	BucketName string            `pulumi:"bucket"`
+	Labels     map[string]string `pulumi:"tags"`
+}
+
+type renamedResourceInputs struct {
+	BucketName StringInput
+	Labels     StringMapInput
+}
+
+func (*renamedResourceInputs) ElementType() reflect.Type {
+	return reflect.TypeOf((*renamedResourceArgs)(nil))
+}
+
+type registrationObserver struct {
+	testMonitor
+
+	m             sync.Mutex
+	registrations map[string]MockResourceRegistration
+	outputs       map[string]resource.PropertyMap
+}
+
+func (m *registrationObserver) ResourceRegistered(reg MockResourceRegistration) {
+	m.m.Lock()
+	defer m.m.Unlock()
+
+	if m.registrations == nil {
+		m.registrations = map[string]MockResourceRegistration{}
+	}
+	m.registrations[reg.Name] = reg
+}
+
+func (m *registrationObserver) ResourceOutputsRegistered(urn string, outputs resource.PropertyMap) {
+	m.m.Lock()
+	defer m.m.Unlock()
+
+	if m.outputs == nil {
+		m.outputs = map[string]resource.PropertyMap{}
+	}
+	m.outputs[urn] = outputs
+}
+
+func TestStackDefaults(t *testing.T) {
+	protect := true
+	mocks := &registrationObserver{}
+	err := RunErr(func(ctx *Context) error {
+		err := ctx.RegisterStackDefaults(StackDefaults{
+			Rules: []StackDefaultRule{
+				{
+					Type:       "test:*",
+					Tags:       map[string]string{"team": "platform", "env": "dev"},
+					Properties: map[string]interface{}{"size": 3, "name": "default"},
+				},
+				{
+					Type:          "test:storage/*",
+					Protect:       &protect,
+					IgnoreChanges: []string{"size"},
+				},
+			},
+		})
+		assert.NoError(t, err)
+
+		var res1, res2, res3 testResource2
+		err = ctx.RegisterResource("test:storage/bucket:Bucket", "res1", &taggedResourceInputs{
+			Name: String("bucket"),
+			Tags: StringMap{"env": String("prod")},
+		}, &res1)
+		assert.NoError(t, err)
+		err = ctx.RegisterResource("test:compute/instance:Instance", "res2", &taggedResourceInputs{}, &res2)
+		assert.NoError(t, err)
+		err = ctx.RegisterResource("other:compute/instance:Instance", "res3", &taggedResourceInputs{}, &res3)
+		assert.NoError(t, err)
+		return nil
+	}, WithMocks("project", "stack", mocks))
+	assert.NoError(t, err)
+
+	res1 := mocks.registrations["res1"]
+	assert.Equal(t, resource.NewPropertyMapFromMap(map[string]interface{}{
+		"name": "bucket",
+		"size": 3,
+		"tags": map[string]interface{}{"team": "platform", "env": "prod"},
+	}), res1.Inputs)
+	assert.True(t, res1.Protect)
+
+	res2 := mocks.registrations["res2"]
+	assert.Equal(t, resource.NewPropertyMapFromMap(map[string]interface{}{
+		"name": "default",
+		"size": 3,
+		"tags": map[string]interface{}{"team": "platform", "env": "dev"},
+	}), res2.Inputs)
+	assert.False(t, res2.Protect)
+
+	res3 := mocks.registrations["res3"]
+	assert.Equal(t, resource.PropertyMap{}, res3.Inputs)
+}
+
+func TestStackDefaultsFromConfig(t *testing.T) {
+	mocks := &registrationObserver{}
+	config := func(info *RunInfo) {
+		info.Config = map[string]string{
+			"pulumi:stackDefaults": `{"rules":[{"type":"*","tags":{"team":"platform"},"protect":true}]}`,
+		}
+	}
+	err := RunErr(func(ctx *Context) error {
+		var res testResource2
+		return ctx.RegisterResource("test:storage/bucket:Bucket", "res", &taggedResourceInputs{}, &res)
+	}, WithMocks("project", "stack", mocks), config)
+	assert.NoError(t, err)
+
+	res := mocks.registrations["res"]
+	assert.Equal(t, resource.NewPropertyMapFromMap(map[string]interface{}{
+		"tags": map[string]interface{}{"team": "platform"},
+	}), res.Inputs)
+	assert.True(t, res.Protect)
+
+	// Invalid configuration is reported as an error.
+	invalid := func(info *RunInfo) {
+		info.Config = map[string]string{"pulumi:stackDefaults": `{"rules":[{}]}`}
+	}
+	err = RunErr(func(ctx *Context) error {
+		return nil
+	}, WithMocks("project", "stack", &testMonitor{}), invalid)
+	assert.EqualError(t, err, "stack default rule 0 is missing a type pattern")
+}
+
+func TestStackDefaultsWireNames(t *testing.T) {
+	mocks := &registrationObserver{}
+	err := RunErr(func(ctx *Context) error {
+		err := ctx.RegisterStackDefaults(StackDefaults{
+			Rules: []StackDefaultRule{{
+				Type: "test:*",
+				Tags: map[string]string{"team": "platform"},
+				// Properties are matched by wire name, so only "bucket" names an input.
+				Properties: map[string]interface{}{"bucket": "default", "BucketName": "ignored", "extra": "ignored"},
+			}},
+		})
+		assert.NoError(t, err)
+
+		var res testResource2
+		return ctx.RegisterResource("test:storage/bucket:Bucket", "res", &renamedResourceInputs{}, &res)
+	}, WithMocks("project", "stack", mocks))
+	assert.NoError(t, err)
+
+	res := mocks.registrations["res"]
+	assert.Equal(t, resource.NewPropertyMapFromMap(map[string]interface{}{
+		"bucket": "default",
+		"tags":   map[string]interface{}{"team": "platform"},
+	}), res.Inputs)
+}
@@ -1,6 +1,13 @@
 package pulumi
 
-import "reflect"
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+)
 
 // StackReference manages a reference to a Pulumi stack.
 type StackReference struct {
@@ -10,6 +17,8 @@ type StackReference struct {
 	Name StringOutput `pulumi:"name"`
 	// Outputs resolves with exports from the named stack
 	Outputs MapOutput `pulumi:"outputs"`
+	// OutputTypes resolves with the types of any exports from the named stack that were exported using ExportStruct
+	OutputTypes MapOutput `pulumi:"outputTypes"`
 }
 
 // GetOutput returns a stack output keyed by the given name as an AnyOutput
@@ -39,6 +48,188 @@ func (s *StackReference) GetIDOutput(name StringInput) IDOutput {
 	})
 }
 
+// GetOutputs decodes the outputs of the referenced stack into the fields of the struct pointed to by dest. Each field
+// with a `pulumi` tag receives the output with the corresponding name. Fields may be Outputs or plain values. If the
+// referenced stack exported the output using ExportStruct, the type recorded by the producer must be assignable to
+// the type of the field. It is an error for an output to be missing unless the corresponding field is a pointer.
+//
+// If the outputs of the referenced stack are not known, e.g. during a preview, each Output field is set to an unknown
+// Output of the field's type and plain fields are left unchanged.
+//
+// This function blocks until the outputs of the referenced stack are available.
+func (s *StackReference) GetOutputs(dest interface{}) error {
+	destV := reflect.ValueOf(dest)
+	if destV.Kind() != reflect.Ptr || destV.IsNil() || destV.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("GetOutputs expects a pointer to a struct, got %T", dest)
+	}
+	destV = destV.Elem()
+	typ := destV.Type()
+
+	outputsV, known, secret, _, err := s.Outputs.await(context.Background())
+	if err != nil {
+		return err
+	}
+	if !known {
+		for i := 0; i < typ.NumField(); i++ {
+			field := typ.Field(i)
+			if field.Tag.Get("pulumi") == "" || field.PkgPath != "" || !field.Type.Implements(outputType) {
+				continue
+			}
+			output := newOutput(field.Type, s)
+			output.getState().resolve(nil, false, secret, nil)
+			destV.Field(i).Set(reflect.ValueOf(output))
+		}
+		return nil
+	}
+	outputs, _ := outputsV.(map[string]interface{})
+
+	typesV, known, _, _, err := s.OutputTypes.await(context.Background())
+	if err != nil {
+		return err
+	}
+	var types map[string]interface{}
+	if known {
+		types, _ = typesV.(map[string]interface{})
+	}
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		tag := field.Tag.Get("pulumi")
+		if tag == "" || field.PkgPath != "" {
+			continue
+		}
+
+		elementType, isOutput := field.Type, field.Type.Implements(outputType)
+		if isOutput {
+			elementType = reflect.Zero(field.Type).Interface().(Output).ElementType()
+		}
+
+		raw, ok := outputs[tag]
+		if !ok {
+			if elementType.Kind() == reflect.Ptr {
+				continue
+			}
+			return fmt.Errorf("stack output %q is not defined", tag)
+		}
+
+		if spec, ok := types[tag]; ok {
+			expected := outputTypeSpec(elementType)
+			if !typeSpecAssignable(spec, expected) {
+				actualJSON, _ := json.Marshal(spec)
+				expectedJSON, _ := json.Marshal(expected)
+				return fmt.Errorf("stack output %q has type %s, which is not assignable to field %s of type %s",
+					tag, actualJSON, field.Name, expectedJSON)
+			}
+		}
+
+		value := reflect.New(elementType).Elem()
+		if _, err := unmarshalOutput(resource.NewPropertyValue(raw), value); err != nil {
+			return fmt.Errorf("decoding stack output %q: %v", tag, err)
+		}
+
+		if isOutput {
+			output := newOutput(field.Type, s)
+			output.getState().resolve(value.Interface(), true, secret, nil)
+			value = reflect.ValueOf(output)
+		}
+		destV.Field(i).Set(value)
+	}
+	return nil
+}
+
+// outputTypeSpec returns the schema type spec that describes values of the given type. The result is encoded as a
+// generic JSON value so that it can be recorded in and compared with a stack's outputs.
+func outputTypeSpec(t reflect.Type) interface{} {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch {
+	case t == assetType || t == assetOrArchiveType:
+		return map[string]interface{}{"$ref": "pulumi.json#/Asset"}
+	case t == archiveType:
+		return map[string]interface{}{"$ref": "pulumi.json#/Archive"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		return map[string]interface{}{"type": "array", "items": outputTypeSpec(t.Elem())}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": outputTypeSpec(t.Elem())}
+	case reflect.Struct:
+		properties := map[string]interface{}{}
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if tag := field.Tag.Get("pulumi"); tag != "" && field.PkgPath == "" {
+				properties[tag] = outputTypeSpec(field.Type)
+			}
+		}
+		return map[string]interface{}{"type": "object", "properties": properties}
+	default:
+		return map[string]interface{}{"$ref": "pulumi.json#/Any"}
+	}
+}
+
+// typeSpecAssignable returns true if values described by the type spec from are assignable to values described by
+// the type spec to. Integers are assignable to numbers, and objects are assignable to object types that declare a
+// subset of their properties.
+func typeSpecAssignable(from, to interface{}) bool {
+	fromSpec, ok := from.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	toSpec, ok := to.(map[string]interface{})
+	if !ok {
+		return false
+	}
+
+	if toSpec["$ref"] == "pulumi.json#/Any" {
+		return true
+	}
+	if ref, ok := toSpec["$ref"]; ok {
+		return fromSpec["$ref"] == ref
+	}
+
+	fromType, toType := fromSpec["type"], toSpec["type"]
+	switch {
+	case fromType == toType:
+		// Check the element types below.
+	case fromType == "integer" && toType == "number":
+		return true
+	default:
+		return false
+	}
+
+	switch toType {
+	case "array":
+		return typeSpecAssignable(fromSpec["items"], toSpec["items"])
+	case "object":
+		if additionalProperties, ok := toSpec["additionalProperties"]; ok {
+			return typeSpecAssignable(fromSpec["additionalProperties"], additionalProperties)
+		}
+		fromProperties, _ := fromSpec["properties"].(map[string]interface{})
+		toProperties, _ := toSpec["properties"].(map[string]interface{})
+		for name, property := range toProperties {
+			fromProperty, ok := fromProperties[name]
+			if !ok || !typeSpecAssignable(fromProperty, property) {
+				return false
+			}
+		}
+		return true
+	default:
+		return true
+	}
+}
+
 type stackReferenceArgs struct {
 	Name string `pulumi:"name"`
 }
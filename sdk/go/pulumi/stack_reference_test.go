@@ -57,3 +57,139 @@ func TestStackReference(t *testing.T) {
 	}, WithMocks("project", "stack", mocks))
 	assert.NoError(t, err)
 }
+
+type stackOutputsObserver struct {
+	testMonitor
+
+	outputs resource.PropertyMap
+}
+
+func (m *stackOutputsObserver) ResourceRegistered(reg MockResourceRegistration) {}
+
+func (m *stackOutputsObserver) ResourceOutputsRegistered(urn string, outputs resource.PropertyMap) {
+	m.outputs = outputs
+}
+
+type networkOutputs struct {
+	VpcID     StringOutput      `pulumi:"vpcId"`
+	SubnetIDs StringArrayOutput `pulumi:"subnetIds"`
+	Tags      map[string]string `pulumi:"tags"`
+	Count     int               `pulumi:"count"`
+}
+
+type networkOutputsConsumer struct {
+	VpcID     StringOutput      `pulumi:"vpcId"`
+	SubnetIDs []string          `pulumi:"subnetIds"`
+	Tags      map[string]string `pulumi:"tags"`
+	Count     float64           `pulumi:"count"`
+	Zone      *string           `pulumi:"zone"`
+}
+
+type networkOutputsMismatch struct {
+	VpcID int `pulumi:"vpcId"`
+}
+
+func TestStackReferenceGetOutputs(t *testing.T) {
+	// Run a producer program that exports typed outputs.
+	producer := &stackOutputsObserver{}
+	err := RunErr(func(ctx *Context) error {
+		return ctx.ExportStruct(&networkOutputs{
+			VpcID:     String("vpc-1").ToStringOutput(),
+			SubnetIDs: StringArray{String("subnet-1"), String("subnet-2")}.ToStringArrayOutput(),
+			Tags:      map[string]string{"env": "dev"},
+			Count:     2,
+		})
+	}, WithMocks("project", "producer", producer))
+	assert.NoError(t, err)
+
+	types, ok := producer.outputs[resource.StackOutputTypesKey]
+	if !assert.True(t, ok) {
+		return
+	}
+	assert.Equal(t, resource.NewPropertyValue(map[string]interface{}{
+		"vpcId":     map[string]interface{}{"type": "string"},
+		"subnetIds": map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": "string"}},
+		"tags": map[string]interface{}{
+			"type":                 "object",
+			"additionalProperties": map[string]interface{}{"type": "string"},
+		},
+		"count": map[string]interface{}{"type": "integer"},
+	}), types)
+
+	// Run a consumer program that reads the outputs through a stack reference.
+	outputs := producer.outputs.Copy()
+	delete(outputs, resource.StackOutputTypesKey)
+	consumer := &testMonitor{
+		NewResourceF: func(typeToken, name string, inputs resource.PropertyMap,
+			provider, id string) (string, resource.PropertyMap, error) {
+			return id, resource.PropertyMap{
+				"name":        inputs["name"],
+				"outputs":     resource.NewObjectProperty(outputs),
+				"outputTypes": types,
+			}, nil
+		},
+	}
+	err = RunErr(func(ctx *Context) error {
+		ref, err := NewStackReference(ctx, "producer", nil)
+		assert.NoError(t, err)
+
+		var outs networkOutputsConsumer
+		err = ref.GetOutputs(&outs)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"subnet-1", "subnet-2"}, outs.SubnetIDs)
+		assert.Equal(t, map[string]string{"env": "dev"}, outs.Tags)
+		assert.Equal(t, 2.0, outs.Count)
+		assert.Nil(t, outs.Zone)
+
+		vpcID, known, _, deps, err := await(outs.VpcID)
+		assert.NoError(t, err)
+		assert.True(t, known)
+		assert.Equal(t, "vpc-1", vpcID)
+		assert.Equal(t, []Resource{ref}, deps)
+
+		var mismatch networkOutputsMismatch
+		err = ref.GetOutputs(&mismatch)
+		assert.EqualError(t, err, `stack output "vpcId" has type {"type":"string"}, `+
+			`which is not assignable to field VpcID of type {"type":"integer"}`)
+
+		var missing struct {
+			Region string `pulumi:"region"`
+		}
+		err = ref.GetOutputs(&missing)
+		assert.EqualError(t, err, `stack output "region" is not defined`)
+		return nil
+	}, WithMocks("project", "consumer", consumer))
+	assert.NoError(t, err)
+}
+
+func TestStackReferenceGetOutputsUnknown(t *testing.T) {
+	mocks := &testMonitor{
+		NewResourceF: func(typeToken, name string, inputs resource.PropertyMap,
+			provider, id string) (string, resource.PropertyMap, error) {
+			return id, resource.PropertyMap{
+				"name":    inputs["name"],
+				"outputs": resource.MakeComputed(resource.NewObjectProperty(resource.PropertyMap{})),
+			}, nil
+		},
+	}
+	err := RunErr(func(ctx *Context) error {
+		ref, err := NewStackReference(ctx, "producer", nil)
+		assert.NoError(t, err)
+
+		var outs networkOutputsConsumer
+		err = ref.GetOutputs(&outs)
+		assert.NoError(t, err)
+		assert.Nil(t, outs.SubnetIDs)
+		assert.Nil(t, outs.Zone)
+
+		if !assert.NotNil(t, outs.VpcID) {
+			return nil
+		}
+		_, known, _, deps, err := await(outs.VpcID)
+		assert.NoError(t, err)
+		assert.False(t, known)
+		assert.Equal(t, []Resource{ref}, deps)
+		return nil
+	}, WithMocks("project", "consumer", mocks), func(info *RunInfo) { info.DryRun = true })
+	assert.NoError(t, err)
+}
@@ -439,6 +439,41 @@ func ToSecretWithContext(ctx context.Context, input interface{}) Output {
 	return o
 }
 
+// IsSecret returns true if the given Output's value is secret. This function blocks until the Output has resolved.
+func IsSecret(o Output) bool {
+	return IsSecretWithContext(context.Background(), o)
+}
+
+// IsSecretWithContext returns true if the given Output's value is secret. This function blocks until the Output has
+// resolved or the provided context is canceled.
+func IsSecretWithContext(ctx context.Context, o Output) bool {
+	_, _, secret, _, _ := o.getState().await(ctx)
+	return secret
+}
+
+// Unsecret returns an Output of the same type as the given Output that resolves to the same value, but is not marked
+// as secret. Unsecret should be used with care: the value of the returned Output will be stored in plaintext.
+func Unsecret(o Output) Output {
+	return UnsecretWithContext(context.Background(), o)
+}
+
+// UnsecretWithContext returns an Output of the same type as the given Output that resolves to the same value, but is
+// not marked as secret. The provided context can be used to reject the output as canceled.
+func UnsecretWithContext(ctx context.Context, o Output) Output {
+	state := o.getState()
+
+	result := newOutput(reflect.TypeOf(o), state.dependencies()...)
+	go func() {
+		v, known, _, deps, err := state.await(ctx)
+		if err != nil || !known {
+			result.fulfill(nil, known, false, deps, err)
+			return
+		}
+		result.fulfill(v, true, false, deps, nil)
+	}()
+	return result
+}
+
 // All returns an ArrayOutput that will resolve when all of the provided inputs will resolve. Each element of the
 // array will contain the resolved value of the corresponding output. The output will be rejected if any of the inputs
 // is rejected.
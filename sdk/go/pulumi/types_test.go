@@ -435,6 +435,41 @@ func TestSecrets(t *testing.T) {
 
 }
 
+func TestIsSecret(t *testing.T) {
+	assert.True(t, IsSecret(ToSecret(String("foo"))))
+	assert.False(t, IsSecret(String("foo").ToStringOutput()))
+
+	// Secretness is carried through applies.
+	s := ToSecret(String("foo")).ApplyT(func(v interface{}) (string, error) {
+		return v.(string) + "bar", nil
+	})
+	assert.True(t, IsSecret(s))
+}
+
+func TestUnsecret(t *testing.T) {
+	s := ToSecret(String("foo")).ApplyT(func(v interface{}) (string, error) {
+		return v.(string), nil
+	})
+
+	u := Unsecret(s)
+	assert.IsType(t, StringOutput{}, u)
+
+	v, known, secret, _, err := await(u)
+	assert.Nil(t, err)
+	assert.True(t, known)
+	assert.False(t, secret)
+	assert.Equal(t, "foo", v)
+
+	// Unknown values remain unknown.
+	unknown := StringOutput{newOutputState(reflect.TypeOf(""))}
+	go unknown.resolve("", false, true, nil)
+
+	_, known, secret, _, err = await(Unsecret(unknown))
+	assert.Nil(t, err)
+	assert.False(t, known)
+	assert.False(t, secret)
+}
+
 // Test that secretness is properly bubbled up with all/apply.
 func TestSecretApply(t *testing.T) {
 	s1 := ToSecret(String("foo"))
@@ -18,8 +18,14 @@ import (
 	"bytes"
 	"context"
 	"io"
+	"io/ioutil"
 	"os"
 	"os/exec"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
 )
 
 const unknownErrorCode = -2
@@ -51,3 +57,45 @@ func runPulumiCommandSync(
 	}
 	return stdout.String(), stderr.String(), code, err
 }
+
+var (
+	eventLogSupportLock sync.Mutex
+	eventLogSupport     = map[string]bool{} // whether each CLI command accepts --event-log, once it is known.
+)
+
+// supportsEventLog returns true if the given command of the CLI accepts the `--event-log` flag. Released 2.15.x CLIs
+// only register the flag when debug commands are enabled, so rather than comparing versions, the command is run with
+// the flag and `--help`: the CLI prints its usage if the flag is registered and fails with an unknown flag error if it
+// is not. The result is cached for each CLI executable, command and environment. If support cannot be determined,
+// supportsEventLog returns false, so that only flags supported by every version of the CLI are passed.
+func supportsEventLog(ctx context.Context, workdir string, additionalEnv []string, command string) bool {
+	path, err := exec.LookPath("pulumi")
+	if err != nil {
+		return false
+	}
+	env := append([]string(nil), additionalEnv...)
+	sort.Strings(env)
+	key := strings.Join(append([]string{path, command}, env...), "\x00")
+
+	eventLogSupportLock.Lock()
+	defer eventLogSupportLock.Unlock()
+
+	supported, ok := eventLogSupport[key]
+	if !ok {
+		eventLog, err := ioutil.TempFile("", "automation-events-probe-")
+		if err != nil {
+			return false
+		}
+		contract.IgnoreClose(eventLog)
+		defer func() { contract.IgnoreError(os.Remove(eventLog.Name())) }()
+
+		_, _, _, err = runPulumiCommandSync(ctx, workdir, nil, additionalEnv,
+			command, "--event-log="+eventLog.Name(), "--help")
+		if ctx.Err() != nil {
+			return false
+		}
+		supported = err == nil
+		eventLogSupport[key] = supported
+	}
+	return supported
+}
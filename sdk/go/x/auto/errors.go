@@ -41,9 +41,21 @@ func (ae autoError) Error() string {
 	return errors.Wrapf(ae.err, "code: %d\n, stdout: %s\n, stderr: %s\n", ae.code, ae.stdout, ae.stderr).Error()
 }
 
+// asAutoError returns the autoError underlying the given error, if any.
+func asAutoError(e error) (autoError, bool) {
+	switch e := e.(type) {
+	case autoError:
+		return e, true
+	case OperationError:
+		return e.autoError, true
+	default:
+		return autoError{}, false
+	}
+}
+
 // IsConcurrentUpdateError returns true if the error was a result of a conflicting update locking the stack.
 func IsConcurrentUpdateError(e error) bool {
-	ae, ok := e.(autoError)
+	ae, ok := asAutoError(e)
 	if !ok {
 		return false
 	}
@@ -53,7 +65,7 @@ func IsConcurrentUpdateError(e error) bool {
 
 // IsSelectStack404Error returns true if the error was a result of selecting a stack that does not exist.
 func IsSelectStack404Error(e error) bool {
-	ae, ok := e.(autoError)
+	ae, ok := asAutoError(e)
 	if !ok {
 		return false
 	}
@@ -64,7 +76,7 @@ func IsSelectStack404Error(e error) bool {
 
 // IsCreateStack409Error returns true if the error was a result of creating a stack that already exists.
 func IsCreateStack409Error(e error) bool {
-	ae, ok := e.(autoError)
+	ae, ok := asAutoError(e)
 	if !ok {
 		return false
 	}
@@ -75,7 +87,7 @@ func IsCreateStack409Error(e error) bool {
 
 // IsCompilationError returns true if the program failed at the build/run step (only Typescript, Go, .NET)
 func IsCompilationError(e error) bool {
-	as, ok := e.(autoError)
+	as, ok := asAutoError(e)
 	if !ok {
 		return false
 	}
@@ -105,7 +117,7 @@ func IsCompilationError(e error) bool {
 
 // IsRuntimeError returns true if there was an error in the user program at during execution.
 func IsRuntimeError(e error) bool {
-	as, ok := e.(autoError)
+	as, ok := asAutoError(e)
 	if !ok {
 		return false
 	}
@@ -137,7 +149,7 @@ func IsRuntimeError(e error) bool {
 // IsUnexpectedEngineError returns true if the pulumi core engine encountered an error (most likely a bug).
 func IsUnexpectedEngineError(e error) bool {
 	// TODO: figure out how to write a test for this
-	as, ok := e.(autoError)
+	as, ok := asAutoError(e)
 	if !ok {
 		return false
 	}
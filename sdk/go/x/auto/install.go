@@ -0,0 +1,152 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auto
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/executable"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/goversion"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/workspace"
+	"github.com/pulumi/pulumi/sdk/v2/go/x/auto/optinstall"
+	"github.com/pulumi/pulumi/sdk/v2/nodejs/npm"
+	"github.com/pulumi/pulumi/sdk/v2/python"
+)
+
+// defaultVirtualEnv is the directory, relative to the project root, in which a virtual environment is created for
+// Python projects that do not already configure one.
+const defaultVirtualEnv = "venv"
+
+// Install installs the project's language dependencies based on its runtime, and then installs every plugin that the
+// language host reports the program requires. Workspaces with an inline program have nothing to install.
+func (l *LocalWorkspace) Install(ctx context.Context, opts ...optinstall.Option) error {
+	installOpts := &optinstall.Options{}
+	for _, o := range opts {
+		o.ApplyOption(installOpts)
+	}
+
+	if l.Program() != nil {
+		return nil
+	}
+
+	proj, err := l.ProjectSettings(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to install, unable to read project settings")
+	}
+
+	var out io.Writer = ioutil.Discard
+	if len(installOpts.ProgressStreams) > 0 {
+		out = io.MultiWriter(installOpts.ProgressStreams...)
+	}
+
+	if !installOpts.NoDependencies {
+		if err = l.installDependencies(ctx, proj, out); err != nil {
+			return errors.Wrap(err, "failed to install dependencies")
+		}
+	}
+	if !installOpts.NoPlugins {
+		if err = l.installRequiredPlugins(ctx); err != nil {
+			return errors.Wrap(err, "failed to install plugins")
+		}
+	}
+	return nil
+}
+
+// installDependencies installs the language dependencies of the given project, which lives in the workspace's
+// working directory.
+func (l *LocalWorkspace) installDependencies(ctx context.Context, proj *workspace.Project, out io.Writer) error {
+	root := l.WorkDir()
+	switch strings.ToLower(proj.Runtime.Name()) {
+	case "nodejs":
+		if bin, err := npm.Install(root, out, out); err != nil {
+			return errors.Wrapf(err, "%s install failed", bin)
+		}
+		return nil
+	case "python":
+		// Reuse the project's virtual environment if it has one, and otherwise record the one we create so that the
+		// language host uses it.
+		venv, hasVenv := proj.Runtime.Options()["virtualenv"].(string)
+		if !hasVenv || venv == "" {
+			venv = defaultVirtualEnv
+		}
+		if !filepath.IsAbs(venv) {
+			venv = filepath.Join(root, venv)
+		}
+		if err := python.InstallDependenciesWithWriters(root, venv, true /*showOutput*/, out, out); err != nil {
+			return err
+		}
+		if !hasVenv {
+			proj.Runtime.SetOption("virtualenv", defaultVirtualEnv)
+			return l.SaveProjectSettings(ctx, proj)
+		}
+		return nil
+	case "go":
+		gobin, err := executable.FindExecutable("go")
+		if err != nil {
+			return err
+		}
+		if err = goversion.CheckMinimumGoVersion(gobin); err != nil {
+			return err
+		}
+		return l.runInstallCommand(ctx, out, gobin, "mod", "download")
+	case "dotnet":
+		dotnetbin, err := executable.FindExecutable("dotnet")
+		if err != nil {
+			return err
+		}
+		// `dotnet build` restores the project's packages, and building now makes the first update faster.
+		return l.runInstallCommand(ctx, out, dotnetbin, "build", "-nologo")
+	default:
+		return nil
+	}
+}
+
+// runInstallCommand runs the given command in the workspace's working directory, with the workspace's environment.
+func (l *LocalWorkspace) runInstallCommand(ctx context.Context, out io.Writer, name string, args ...string) error {
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Dir = l.WorkDir()
+	cmd.Env = os.Environ()
+	if l.PulumiHome() != "" {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", pulumiHomeEnv, l.PulumiHome()))
+	}
+	for k, v := range l.GetEnvVars() {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", k, v))
+	}
+	cmd.Stdout, cmd.Stderr = out, out
+	if err := cmd.Run(); err != nil {
+		return errors.Wrapf(err, "'%s %s' failed", filepath.Base(name), strings.Join(args, " "))
+	}
+	return nil
+}
+
+// installRequiredPlugins installs every plugin that the project's language host reports the program requires. The
+// CLI computes the set of plugins itself when `pulumi plugin install` is run without arguments, so both the language
+// host and the installs run with the workspace's PULUMI_HOME and environment variables.
+func (l *LocalWorkspace) installRequiredPlugins(ctx context.Context) error {
+	stdout, stderr, errCode, err := l.runPulumiCmdSync(ctx, "plugin", "install")
+	if err != nil {
+		return newAutoError(errors.Wrap(err, "failed to install required plugins"), stdout, stderr, errCode)
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auto
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/workspace"
+	"github.com/pulumi/pulumi/sdk/v2/go/x/auto/optinstall"
+)
+
+// recordingCLI is a CLI that records the arguments, PULUMI_HOME and INSTALL_TEST_VAR of each command it runs.
+const recordingCLI = `#!/bin/sh
+echo "$* home=$PULUMI_HOME var=$INSTALL_TEST_VAR" >> "$(dirname "$0")/commands.log"
+`
+
+func TestInstallPluginsEnv(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the fake CLI is a shell script")
+	}
+
+	ctx := context.Background()
+	binDir, err := ioutil.TempDir("", "install-cli")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	defer os.RemoveAll(binDir)
+	if !assert.NoError(t, ioutil.WriteFile(filepath.Join(binDir, "pulumi"), []byte(recordingCLI), 0700)) {
+		t.FailNow()
+	}
+
+	path := os.Getenv("PATH")
+	defer os.Setenv("PATH", path)
+	os.Setenv("PATH", binDir+string(os.PathListSeparator)+path)
+
+	workDir, err := ioutil.TempDir("", "install")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	defer os.RemoveAll(workDir)
+
+	ws, err := NewLocalWorkspace(ctx, WorkDir(workDir), PulumiHome(filepath.Join(workDir, ".pulumi")),
+		EnvVars(map[string]string{"INSTALL_TEST_VAR": "value"}), Project(workspace.Project{
+			Name:    "install",
+			Runtime: workspace.NewProjectRuntimeInfo("go", nil),
+		}))
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	// The plugins are computed and installed by the CLI, using the workspace's PULUMI_HOME and environment.
+	assert.NoError(t, ws.Install(ctx, optinstall.NoDependencies()))
+	log, err := ioutil.ReadFile(filepath.Join(binDir, "commands.log"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(log),
+		"plugin install --non-interactive home="+filepath.Join(workDir, ".pulumi")+" var=value\n")
+}
+
+func TestInstallGoDependencies(t *testing.T) {
+	ctx := context.Background()
+	workDir, err := ioutil.TempDir("", "install")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	defer os.RemoveAll(workDir)
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(workDir, "go.mod"), []byte("module example.com/install\n"), 0600))
+
+	ws, err := NewLocalWorkspace(ctx, WorkDir(workDir), Project(workspace.Project{
+		Name:    "install",
+		Runtime: workspace.NewProjectRuntimeInfo("go", nil),
+	}))
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	assert.NoError(t, ws.Install(ctx, optinstall.NoPlugins()))
+
+	// Without a go.mod, `go mod download` fails.
+	assert.NoError(t, os.Remove(filepath.Join(workDir, "go.mod")))
+	assert.Error(t, ws.Install(ctx, optinstall.NoPlugins()))
+}
@@ -0,0 +1,204 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auto
+
+import (
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/diag/colors"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
+)
+
+// OperationErrorKind classifies why a stack operation (preview/up/refresh/destroy) failed.
+type OperationErrorKind string
+
+const (
+	// OperationErrorResource indicates that one or more resource operations failed, e.g. because a provider
+	// returned an error.
+	OperationErrorResource OperationErrorKind = "resource"
+	// OperationErrorPolicy indicates that one or more resources violated a mandatory policy.
+	OperationErrorPolicy OperationErrorKind = "policy"
+	// OperationErrorProgram indicates that the program itself failed, e.g. with an unhandled exception.
+	OperationErrorProgram OperationErrorKind = "program"
+	// OperationErrorEngine indicates that the engine encountered an unexpected error (most likely a bug).
+	OperationErrorEngine OperationErrorKind = "engine"
+	// OperationErrorUnknown indicates that the operation failed without reporting why, e.g. because the CLI could
+	// not reach the backend.
+	OperationErrorUnknown OperationErrorKind = "unknown"
+)
+
+// ResourceFailure describes a resource whose operation failed.
+type ResourceFailure struct {
+	// URN is the URN of the resource.
+	URN string
+	// Type is the type of the resource.
+	Type string
+	// Op is the operation that failed (e.g. "create" or "update"), if known.
+	Op string
+	// Messages are the error messages reported for the resource, typically by its provider.
+	Messages []string
+}
+
+// OperationError is returned by Stack.Preview, Stack.Up, Stack.Refresh and Stack.Destroy when the operation fails.
+// In addition to the output of the CLI, it describes the failure using the engine events that the operation emitted.
+type OperationError struct {
+	autoError
+
+	// Kind classifies the failure.
+	Kind OperationErrorKind
+	// FailedResources are the resources whose operations failed, in the order in which they failed.
+	FailedResources []ResourceFailure
+	// Diagnostics are the error diagnostics reported during the operation, including those for failed resources.
+	Diagnostics []apitype.DiagnosticEvent
+	// PolicyViolations are the policy violations reported during the operation, both advisory and mandatory.
+	PolicyViolations []apitype.PolicyEvent
+}
+
+// AsOperationError returns the OperationError for a failed stack operation, if the error is one or wraps one.
+func AsOperationError(e error) (OperationError, bool) {
+	var oe OperationError
+	ok := errors.As(e, &oe)
+	return oe, ok
+}
+
+// newOperationError creates an OperationError for a failed operation from the engine events logged at the given
+// path. If the event log cannot be read, the error describes the failure using only the output of the CLI. If there
+// is no event log, because the CLI is too old to write one, it returns the same error as any other failed command.
+func newOperationError(err error, stdout, stderr string, code int, eventLogPath string) error {
+	if eventLogPath == "" {
+		return newAutoError(err, stdout, stderr, code)
+	}
+
+	var events []apitype.EngineEvent
+	if f, openErr := os.Open(eventLogPath); openErr == nil {
+		defer contract.IgnoreClose(f)
+		events = readEngineEvents(f)
+	}
+	return newOperationErrorFromEvents(newAutoError(err, stdout, stderr, code), events)
+}
+
+// readEngineEvents reads the JSON-encoded engine events in an event log. A partially written event at the end of the
+// log is ignored.
+func readEngineEvents(r io.Reader) []apitype.EngineEvent {
+	var events []apitype.EngineEvent
+	dec := json.NewDecoder(r)
+	for {
+		var e apitype.EngineEvent
+		if err := dec.Decode(&e); err != nil {
+			return events
+		}
+		events = append(events, e)
+	}
+}
+
+func newOperationErrorFromEvents(ae autoError, events []apitype.EngineEvent) OperationError {
+	oe := OperationError{autoError: ae}
+
+	failed := make(map[string]int) // the index of each failed resource in oe.FailedResources.
+	ops := make(map[string]string) // the most recent operation on each resource.
+	addFailure := func(urn string) *ResourceFailure {
+		i, has := failed[urn]
+		if !has {
+			i = len(oe.FailedResources)
+			failed[urn] = i
+			oe.FailedResources = append(oe.FailedResources, ResourceFailure{
+				URN:  urn,
+				Type: urnType(urn),
+			})
+		}
+		return &oe.FailedResources[i]
+	}
+
+	programFailed, policyFailed := false, false
+	for _, e := range events {
+		switch {
+		case e.ResourcePreEvent != nil:
+			ops[e.ResourcePreEvent.Metadata.URN] = e.ResourcePreEvent.Metadata.Op
+		case e.ResOpFailedEvent != nil:
+			failure := addFailure(e.ResOpFailedEvent.Metadata.URN)
+			failure.Op = e.ResOpFailedEvent.Metadata.Op
+		case e.PolicyEvent != nil:
+			violation := *e.PolicyEvent
+			violation.Message = colors.Never.Colorize(violation.Message)
+			oe.PolicyViolations = append(oe.PolicyViolations, violation)
+			if violation.EnforcementLevel == "mandatory" {
+				policyFailed = true
+			}
+		case e.DiagnosticEvent != nil && e.DiagnosticEvent.Severity == "error":
+			diagnostic := *e.DiagnosticEvent
+			diagnostic.Message = strings.TrimSpace(colors.Never.Colorize(diagnostic.Message))
+			oe.Diagnostics = append(oe.Diagnostics, diagnostic)
+
+			// Errors reported against the stack itself come from the program rather than from a resource.
+			if urnType(diagnostic.URN) == "" || urnType(diagnostic.URN) == string(resource.RootStackType) {
+				programFailed = true
+				continue
+			}
+			failure := addFailure(diagnostic.URN)
+			failure.Messages = append(failure.Messages, diagnostic.Message)
+		}
+	}
+	for i := range oe.FailedResources {
+		if failure := &oe.FailedResources[i]; failure.Op == "" {
+			failure.Op = ops[failure.URN]
+		}
+	}
+
+	switch {
+	case policyFailed:
+		oe.Kind = OperationErrorPolicy
+	case len(oe.FailedResources) > 0:
+		oe.Kind = OperationErrorResource
+	case IsUnexpectedEngineError(ae):
+		oe.Kind = OperationErrorEngine
+	case programFailed:
+		oe.Kind = OperationErrorProgram
+	default:
+		oe.Kind = OperationErrorUnknown
+	}
+	return oe
+}
+
+// urnType returns the type of the resource with the given URN, or the empty string if the URN is not valid.
+func urnType(urn string) string {
+	if !resource.URN(urn).IsValid() {
+		return ""
+	}
+	return string(resource.URN(urn).Type())
+}
+
+// newEventLog creates a temporary file for the CLI to log engine events to. The returned function removes the file.
+// If the CLI cannot log engine events, newEventLog returns an empty path and creates no file. Operations run without
+// an event log fail with a plain error.
+func newEventLog(supported bool) (string, func(), error) {
+	if !supported {
+		return "", func() {}, nil
+	}
+
+	f, err := ioutil.TempFile("", "automation-events-")
+	if err != nil {
+		return "", nil, errors.Wrap(err, "failed to create event log")
+	}
+	contract.IgnoreClose(f)
+	return f.Name(), func() { contract.IgnoreError(os.Remove(f.Name())) }, nil
+}
@@ -0,0 +1,175 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auto
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/apitype"
+)
+
+const (
+	testStackURN  = "urn:pulumi:dev::proj::pulumi:pulumi:Stack::proj-dev"
+	testBucketURN = "urn:pulumi:dev::proj::aws:s3/bucket:Bucket::bucket"
+	testRoleURN   = "urn:pulumi:dev::proj::aws:iam/role:Role::role"
+)
+
+func TestOperationErrorResourceFailure(t *testing.T) {
+	log := `
+{"sequence":0,"timestamp":0,"preludeEvent":{"config":{}}}
+{"sequence":1,"timestamp":0,"resourcePreEvent":{"metadata":{"op":"create","urn":"` + testBucketURN + `",` +
+		`"type":"aws:s3/bucket:Bucket","provider":""}}}
+{"sequence":2,"timestamp":0,"diagnosticEvent":{"urn":"` + testBucketURN + `",` +
+		`"message":"<{%reset%}>BucketAlreadyExists: bucket exists<{%reset%}>\n","color":"raw","severity":"error"}}
+{"sequence":3,"timestamp":0,"resOpFailedEvent":{"metadata":{"op":"create","urn":"` + testBucketURN + `",` +
+		`"type":"aws:s3/bucket:Bucket","provider":""},"status":1,"steps":1}}
+{"sequence":4,"timestamp":0,"policyEvent":{"resourceUrn":"` + testRoleURN + `","message":"too broad",` +
+		`"color":"raw","policyName":"no-admin","policyPackName":"security","policyPackVersion":"1",` +
+		`"policyPackVersionTag":"1","enforcementLevel":"advisory"}}
+{"sequence":5,"timestamp":0,"diagnosticEvent":{"urn":"` + testStackURN + `",` +
+		`"message":"update failed","color":"raw","severity":"error"}}
+{"sequence":6,"timestamp":0,"diagnosticEvent":{"message":"just info","color":"raw","severity":"info"}}
+{"sequence":7,"timestamp":0,"summaryEvent":{"maybeCorrupt":false,"durationSeconds":1,"resourceChanges":{}`
+
+	err := newOperationErrorFromEvents(newAutoError(errors.New("failed to run update"), "", "", 255),
+		readEngineEvents(strings.NewReader(log)))
+
+	oe, ok := AsOperationError(err)
+	assert.True(t, ok)
+	assert.Equal(t, OperationErrorResource, oe.Kind)
+	assert.Equal(t, []ResourceFailure{{
+		URN:      testBucketURN,
+		Type:     "aws:s3/bucket:Bucket",
+		Op:       "create",
+		Messages: []string{"BucketAlreadyExists: bucket exists"},
+	}}, oe.FailedResources)
+	assert.Len(t, oe.Diagnostics, 2)
+	if assert.Len(t, oe.PolicyViolations, 1) {
+		assert.Equal(t, "no-admin", oe.PolicyViolations[0].PolicyName)
+	}
+	assert.Contains(t, oe.Error(), "failed to run update")
+
+	// Wrapped operation errors are found as well.
+	oe, ok = AsOperationError(errors.Wrap(err, "deploying"))
+	assert.True(t, ok)
+	assert.Equal(t, OperationErrorResource, oe.Kind)
+}
+
+func TestOperationErrorWithoutEventLog(t *testing.T) {
+	// CLIs that are too old to log engine events are not asked to.
+	eventLog, removeEventLog, err := newEventLog(false)
+	assert.NoError(t, err)
+	removeEventLog()
+	assert.Empty(t, eventLog)
+
+	eventLog, removeEventLog, err = newEventLog(true)
+	assert.NoError(t, err)
+	defer removeEventLog()
+	assert.NotEmpty(t, eventLog)
+
+	// Without an event log, a failed operation returns a plain error.
+	_, ok := AsOperationError(newOperationError(errors.New("failed to run update"), "", "", 255, ""))
+	assert.False(t, ok)
+	_, ok = AsOperationError(newOperationError(errors.New("failed to run update"), "", "", 255, eventLog))
+	assert.True(t, ok)
+}
+
+// fakeCLI is a CLI that behaves like a released 2.15.x CLI, which only registers `--event-log` when debug commands
+// are enabled.
+const fakeCLI = `#!/bin/sh
+if [ "$1" = "version" ]; then
+	echo "v2.15.3"
+	exit 0
+fi
+for arg in "$@"; do
+	case "$arg" in
+	--event-log*)
+		if [ -z "$PULUMI_DEBUG_COMMANDS" ]; then
+			echo "error: unknown flag: --event-log" >&2
+			exit 255
+		fi
+		;;
+	esac
+done
+echo "Usage:"
+`
+
+func TestSupportsEventLog(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the fake CLI is a shell script")
+	}
+
+	dir, err := ioutil.TempDir("", "automation-fake-cli-")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	defer os.RemoveAll(dir)
+	if !assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "pulumi"), []byte(fakeCLI), 0700)) {
+		t.FailNow()
+	}
+
+	path := os.Getenv("PATH")
+	defer os.Setenv("PATH", path)
+	os.Setenv("PATH", dir+string(os.PathListSeparator)+path)
+
+	ctx := context.Background()
+
+	// A 2.15.x CLI rejects the flag, so operations run without an event log rather than failing.
+	for _, command := range []string{"preview", "up", "refresh", "destroy"} {
+		assert.False(t, supportsEventLog(ctx, dir, nil, command), command)
+	}
+	eventLog, removeEventLog, err := newEventLog(supportsEventLog(ctx, dir, nil, "up"))
+	assert.NoError(t, err)
+	defer removeEventLog()
+	assert.Empty(t, eventLog)
+
+	// The same CLI accepts the flag when debug commands are enabled.
+	assert.True(t, supportsEventLog(ctx, dir, []string{"PULUMI_DEBUG_COMMANDS=true"}, "up"))
+}
+
+func TestOperationErrorKinds(t *testing.T) {
+	ae := newAutoError(errors.New("failed"), "", "", 255)
+
+	oe := newOperationErrorFromEvents(ae, []apitype.EngineEvent{{
+		PolicyEvent: &apitype.PolicyEvent{ResourceURN: testRoleURN, EnforcementLevel: "mandatory"},
+	}})
+	assert.Equal(t, OperationErrorPolicy, oe.Kind)
+
+	oe = newOperationErrorFromEvents(ae, []apitype.EngineEvent{{
+		DiagnosticEvent: &apitype.DiagnosticEvent{URN: testStackURN, Message: "unhandled exception", Severity: "error"},
+	}})
+	assert.Equal(t, OperationErrorProgram, oe.Kind)
+	assert.Empty(t, oe.FailedResources)
+
+	engineError := newAutoError(errors.New("failed"), "The Pulumi CLI encountered a fatal error. This is a bug!", "", 255)
+	oe = newOperationErrorFromEvents(engineError, nil)
+	assert.Equal(t, OperationErrorEngine, oe.Kind)
+
+	oe = newOperationErrorFromEvents(ae, nil)
+	assert.Equal(t, OperationErrorUnknown, oe.Kind)
+
+	// The existing error classifiers see through operation errors.
+	runtimeError := newAutoError(errors.New("failed"), "panic: runtime error: index out of range", "", 255)
+	assert.True(t, IsRuntimeError(newOperationErrorFromEvents(runtimeError, nil)))
+}
@@ -0,0 +1,64 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package optinstall contains functional options to be used with workspace install operations
+// github.com/sdk/v2/go/x/auto Workspace.Install(...optinstall.Option)
+package optinstall
+
+import "io"
+
+// NoDependencies skips installing the program's language dependencies (e.g. `npm install`)
+func NoDependencies() Option {
+	return optionFunc(func(opts *Options) {
+		opts.NoDependencies = true
+	})
+}
+
+// NoPlugins skips installing the plugins required by the program
+func NoPlugins() Option {
+	return optionFunc(func(opts *Options) {
+		opts.NoPlugins = true
+	})
+}
+
+// ProgressStreams allows specifying one or more io.Writers to redirect the output of the dependency installers
+func ProgressStreams(writers ...io.Writer) Option {
+	return optionFunc(func(opts *Options) {
+		opts.ProgressStreams = writers
+	})
+}
+
+// Option is a parameter to be applied to a Workspace.Install() operation
+type Option interface {
+	ApplyOption(*Options)
+}
+
+// ---------------------------------- implementation details ----------------------------------
+
+// Options is an implementation detail
+type Options struct {
+	// NoDependencies skips installing the program's language dependencies
+	NoDependencies bool
+	// NoPlugins skips installing the plugins required by the program
+	NoPlugins bool
+	// ProgressStreams allows specifying one or more io.Writers to redirect the output of the dependency installers
+	ProgressStreams []io.Writer
+}
+
+type optionFunc func(*Options)
+
+// ApplyOption is an implementation detail
+func (o optionFunc) ApplyOption(opts *Options) {
+	o(opts)
+}
@@ -59,6 +59,14 @@ func TargetDependents() Option {
 	})
 }
 
+// SkipBuild runs the most recently built program instead of rebuilding it before the preview.
+// Only programs whose runtime builds them ahead of running them, such as Go programs, support this option.
+func SkipBuild() Option {
+	return optionFunc(func(opts *Options) {
+		opts.SkipBuild = true
+	})
+}
+
 // Option is a parameter to be applied to a Stack.Preview() operation
 type Option interface {
 	ApplyOption(*Options)
@@ -81,6 +89,8 @@ type Options struct {
 	Target []string
 	// Allows updating of dependent targets discovered but not specified in the Target list
 	TargetDependents bool
+	// Run the most recently built program without rebuilding it
+	SkipBuild bool
 }
 
 type optionFunc func(*Options)
@@ -68,6 +68,14 @@ func ProgressStreams(writers ...io.Writer) Option {
 	})
 }
 
+// SkipBuild runs the most recently built program instead of rebuilding it before the update.
+// Only programs whose runtime builds them ahead of running them, such as Go programs, support this option.
+func SkipBuild() Option {
+	return optionFunc(func(opts *Options) {
+		opts.SkipBuild = true
+	})
+}
+
 // Option is a parameter to be applied to a Stack.Up() operation
 type Option interface {
 	ApplyOption(*Options)
@@ -90,6 +98,8 @@ type Options struct {
 	Target []string
 	// Allows updating of dependent targets discovered but not specified in the Target list
 	TargetDependents bool
+	// Run the most recently built program without rebuilding it
+	SkipBuild bool
 	// ProgressStreams allows specifying one or more io.Writers to redirect incremental update output
 	ProgressStreams []io.Writer
 }
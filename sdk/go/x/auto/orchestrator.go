@@ -0,0 +1,470 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auto
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/graph"
+	"github.com/pulumi/pulumi/sdk/v2/go/x/auto/optdestroy"
+	"github.com/pulumi/pulumi/sdk/v2/go/x/auto/optpreview"
+	"github.com/pulumi/pulumi/sdk/v2/go/x/auto/optup"
+)
+
+// stackReferenceType is the type token of the resource that a program registers for each StackReference it reads.
+const stackReferenceType = "pulumi:pulumi:StackReference"
+
+// Orchestrator runs lifecycle operations across a set of stacks in dependency order. A stack runs only once every
+// stack it depends on has finished successfully; stacks that do not depend on one another run in parallel.
+// Dependencies can be declared explicitly with AddDependency or discovered from the StackReferences in each stack's
+// state with DiscoverDependencies.
+//
+// Stacks are identified by their names, so every stack given to an Orchestrator must have a distinct name. When
+// orchestrating stacks across several projects, use FullyQualifiedStackName to keep their names distinct.
+type Orchestrator struct {
+	stacks       map[string]*Stack
+	names        []string                   // the stacks' names, in the order they were given.
+	dependencies map[string]map[string]bool // each stack's dependencies, by name.
+	parallelism  int
+}
+
+// OrchestratorOption is a parameter to NewOrchestrator.
+type OrchestratorOption func(*Orchestrator)
+
+// OrchestratorParallelism limits the number of stacks that an Orchestrator operates on at once. Values less than one
+// leave the number of stacks unlimited.
+func OrchestratorParallelism(n int) OrchestratorOption {
+	return func(o *Orchestrator) {
+		o.parallelism = n
+	}
+}
+
+// NewOrchestrator creates an Orchestrator for the given stacks. It fails if two of the stacks have the same name.
+func NewOrchestrator(stacks []Stack, opts ...OrchestratorOption) (*Orchestrator, error) {
+	o := &Orchestrator{
+		stacks:       make(map[string]*Stack),
+		dependencies: make(map[string]map[string]bool),
+	}
+	for i := range stacks {
+		s := stacks[i]
+		name := s.Name()
+		if _, has := o.stacks[name]; has {
+			return nil, errors.Errorf("duplicate stack %q", name)
+		}
+		o.stacks[name] = &s
+		o.names = append(o.names, name)
+		o.dependencies[name] = make(map[string]bool)
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o, nil
+}
+
+// AddDependency declares that the stack named stackName depends on the stack named dependency, so that it is updated
+// after and destroyed before its dependency. Both stacks must be part of the Orchestrator.
+func (o *Orchestrator) AddDependency(stackName, dependency string) error {
+	if _, has := o.stacks[stackName]; !has {
+		return errors.Errorf("unknown stack %q", stackName)
+	}
+	if _, has := o.stacks[dependency]; !has {
+		return errors.Errorf("unknown stack %q", dependency)
+	}
+	if stackName == dependency {
+		return errors.Errorf("stack %q cannot depend on itself", stackName)
+	}
+	o.dependencies[stackName][dependency] = true
+	return nil
+}
+
+// Dependencies returns the names of the stacks that the named stack depends on, in sorted order.
+func (o *Orchestrator) Dependencies(stackName string) []string {
+	var names []string
+	for name := range o.dependencies[stackName] {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// DiscoverDependencies scans the state of each stack for StackReference resources and adds a dependency on each
+// referenced stack. Stack names may leave out the organization and project, as they can on the command line, so both
+// the references and the names of the Orchestrator's stacks are qualified with the organization and project of the
+// stack's workspace before they are matched. DiscoverDependencies returns the fully qualified names of the referenced
+// stacks that are not part of the Orchestrator, keyed by the name of the stack that references them.
+func (o *Orchestrator) DiscoverDependencies(ctx context.Context) (map[string][]string, error) {
+	scopes := make(map[string]stackScope)
+	workDirScopes := make(map[string]stackScope)
+	refs := make(map[string][]string)
+	for _, name := range o.names {
+		s := o.stacks[name]
+		if ws := s.Workspace(); ws != nil {
+			scope, has := workDirScopes[ws.WorkDir()]
+			if !has {
+				var err error
+				if scope, err = workspaceScope(ctx, ws); err != nil {
+					return nil, errors.Wrapf(err, "failed to qualify the name of stack %q", name)
+				}
+				workDirScopes[ws.WorkDir()] = scope
+			}
+			scopes[name] = scope
+		}
+
+		deployment, err := s.Export(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to export stack %q", name)
+		}
+		if refs[name], err = stackReferences(deployment); err != nil {
+			return nil, errors.Wrapf(err, "failed to read state of stack %q", name)
+		}
+	}
+	return o.addReferences(scopes, refs)
+}
+
+// stackScope holds the organization and project that qualify the stack names used within a workspace.
+type stackScope struct {
+	org     string
+	project string
+}
+
+// workspaceScope returns the scope of the given workspace: the current user, who is the default organization, and the
+// workspace's project.
+func workspaceScope(ctx context.Context, ws Workspace) (stackScope, error) {
+	org, err := ws.WhoAmI(ctx)
+	if err != nil {
+		return stackScope{}, err
+	}
+	project, err := ws.ProjectSettings(ctx)
+	if err != nil {
+		return stackScope{}, err
+	}
+	return stackScope{org: org, project: string(project.Name)}, nil
+}
+
+// qualify returns the fully qualified form of the given stack name, filling in the parts it leaves out from the
+// scope. Names of the form stack and org/stack are resolved the same way that the CLI resolves them.
+func (scope stackScope) qualify(name string) string {
+	parts := strings.Split(name, "/")
+	switch len(parts) {
+	case 1:
+		return FullyQualifiedStackName(scope.org, scope.project, name)
+	case 2:
+		return FullyQualifiedStackName(parts[0], scope.project, parts[1])
+	default:
+		return name
+	}
+}
+
+// addReferences adds a dependency for each of the given stack references, which are keyed by the name of the stack
+// that holds them. Each stack's names are qualified with its scope. It returns the references that do not resolve to
+// one of the Orchestrator's stacks.
+func (o *Orchestrator) addReferences(scopes map[string]stackScope,
+	refs map[string][]string) (map[string][]string, error) {
+
+	qualified := make(map[string]string)
+	for _, name := range o.names {
+		fqn := scopes[name].qualify(name)
+		if other, has := qualified[fqn]; has {
+			return nil, errors.Errorf("stacks %q and %q are both named %q", other, name, fqn)
+		}
+		qualified[fqn] = name
+	}
+
+	unresolved := make(map[string][]string)
+	for _, name := range o.names {
+		for _, ref := range refs[name] {
+			fqn := scopes[name].qualify(ref)
+			dep, has := qualified[fqn]
+			switch {
+			case !has:
+				unresolved[name] = append(unresolved[name], fqn)
+			case dep != name:
+				o.dependencies[name][dep] = true
+			}
+		}
+	}
+	return unresolved, nil
+}
+
+// stackReferences returns the names of the stacks referenced by the StackReference resources in the given
+// deployment.
+func stackReferences(deployment apitype.UntypedDeployment) ([]string, error) {
+	if len(deployment.Deployment) == 0 {
+		return nil, nil
+	}
+
+	// Every version of the deployment format records each resource's type and inputs in the same way, so we only
+	// decode as much as we need.
+	var state struct {
+		Resources []struct {
+			Type   string                 `json:"type"`
+			Inputs map[string]interface{} `json:"inputs"`
+		} `json:"resources"`
+	}
+	if err := json.Unmarshal(deployment.Deployment, &state); err != nil {
+		return nil, err
+	}
+
+	var refs []string
+	for _, res := range state.Resources {
+		if res.Type != stackReferenceType {
+			continue
+		}
+		if name, ok := res.Inputs["name"].(string); ok && name != "" {
+			refs = append(refs, name)
+		}
+	}
+	return refs, nil
+}
+
+// StackStatus is the outcome of an orchestrated operation on a single stack.
+type StackStatus string
+
+const (
+	// StackSucceeded indicates that the operation on the stack succeeded.
+	StackSucceeded StackStatus = "succeeded"
+	// StackFailed indicates that the operation on the stack failed.
+	StackFailed StackStatus = "failed"
+	// StackSkipped indicates that the operation was not run on the stack because a stack that it had to wait for did
+	// not succeed, or because the context was canceled.
+	StackSkipped StackStatus = "skipped"
+)
+
+// StackResult is the result of an orchestrated operation on a single stack. Only the field for the operation that
+// was run is set, and only if the operation ran.
+type StackResult struct {
+	Status  StackStatus
+	Err     error
+	Preview *PreviewResult
+	Up      *UpResult
+	Destroy *DestroyResult
+}
+
+// Preview previews each stack, after previewing the stacks it depends on.
+func (o *Orchestrator) Preview(ctx context.Context, opts ...optpreview.Option) (map[string]StackResult, error) {
+	return o.run(ctx, false, func(ctx context.Context, s *Stack) StackResult {
+		res, err := s.Preview(ctx, opts...)
+		return StackResult{Err: err, Preview: &res}
+	})
+}
+
+// Up updates each stack, after updating the stacks it depends on.
+func (o *Orchestrator) Up(ctx context.Context, opts ...optup.Option) (map[string]StackResult, error) {
+	return o.run(ctx, false, func(ctx context.Context, s *Stack) StackResult {
+		res, err := s.Up(ctx, opts...)
+		return StackResult{Err: err, Up: &res}
+	})
+}
+
+// Destroy destroys each stack, after destroying the stacks that depend on it.
+func (o *Orchestrator) Destroy(ctx context.Context, opts ...optdestroy.Option) (map[string]StackResult, error) {
+	return o.run(ctx, true, func(ctx context.Context, s *Stack) StackResult {
+		res, err := s.Destroy(ctx, opts...)
+		return StackResult{Err: err, Destroy: &res}
+	})
+}
+
+// run applies the given operation to every stack. Unless reverse is set, each stack waits for the stacks it depends
+// on; if it is set, each stack waits for the stacks that depend on it instead. A stack is skipped if any stack it
+// waits for does not succeed. run returns a result for every stack, along with an error if any stack did not succeed.
+func (o *Orchestrator) run(ctx context.Context, reverse bool,
+	op func(ctx context.Context, s *Stack) StackResult) (map[string]StackResult, error) {
+
+	waitsFor := o.dependencies
+	if reverse {
+		waitsFor = make(map[string]map[string]bool)
+		for _, name := range o.names {
+			waitsFor[name] = make(map[string]bool)
+		}
+		for name, deps := range o.dependencies {
+			for dep := range deps {
+				waitsFor[dep][name] = true
+			}
+		}
+	}
+
+	order, err := o.sort(waitsFor)
+	if err != nil {
+		return nil, err
+	}
+
+	var sem chan struct{}
+	if o.parallelism > 0 {
+		sem = make(chan struct{}, o.parallelism)
+	}
+
+	// Stacks that share a working directory also share the workspace's selected stack, so they must not run at the
+	// same time.
+	workDirs := make(map[string]*sync.Mutex)
+	for _, name := range o.names {
+		if ws := o.stacks[name].Workspace(); ws != nil {
+			if _, has := workDirs[ws.WorkDir()]; !has {
+				workDirs[ws.WorkDir()] = &sync.Mutex{}
+			}
+		}
+	}
+
+	var mutex sync.Mutex
+	results := make(map[string]StackResult)
+	done := make(map[string]chan struct{})
+	for _, name := range order {
+		done[name] = make(chan struct{})
+	}
+
+	var wg sync.WaitGroup
+	for _, name := range order {
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			defer close(done[name])
+
+			result := func() StackResult {
+				for dep := range waitsFor[name] {
+					<-done[dep]
+					mutex.Lock()
+					status := results[dep].Status
+					mutex.Unlock()
+					if status != StackSucceeded {
+						return StackResult{
+							Status: StackSkipped,
+							Err:    errors.Errorf("skipped because stack %q did not succeed", dep),
+						}
+					}
+				}
+
+				if sem != nil {
+					select {
+					case sem <- struct{}{}:
+						defer func() { <-sem }()
+					case <-ctx.Done():
+						return StackResult{Status: StackSkipped, Err: ctx.Err()}
+					}
+				}
+
+				s := o.stacks[name]
+				if ws := s.Workspace(); ws != nil {
+					lock := workDirs[ws.WorkDir()]
+					lock.Lock()
+					defer lock.Unlock()
+				}
+				if err := ctx.Err(); err != nil {
+					return StackResult{Status: StackSkipped, Err: err}
+				}
+
+				result := op(ctx, s)
+				if result.Err != nil {
+					result.Status = StackFailed
+				} else {
+					result.Status = StackSucceeded
+				}
+				return result
+			}()
+
+			mutex.Lock()
+			results[name] = result
+			mutex.Unlock()
+		}(name)
+	}
+	wg.Wait()
+
+	var failed []string
+	for _, name := range order {
+		if results[name].Status != StackSucceeded {
+			failed = append(failed, name)
+		}
+	}
+	if len(failed) != 0 {
+		return results, errors.Errorf("%d of %d stacks did not succeed: %v", len(failed), len(order), failed)
+	}
+	return results, nil
+}
+
+// sort topologically sorts the stacks so that each stack comes after the stacks it waits for.
+func (o *Orchestrator) sort(waitsFor map[string]map[string]bool) ([]string, error) {
+	vertices := make(map[string]*stackVertex)
+	for _, name := range o.names {
+		vertices[name] = &stackVertex{name: name}
+	}
+	g := &stackGraph{}
+	for _, name := range o.names {
+		v := vertices[name]
+		g.roots = append(g.roots, &stackEdge{to: v})
+
+		// Visit the dependencies in a stable order so that the sort is deterministic.
+		var deps []string
+		for dep := range waitsFor[name] {
+			deps = append(deps, dep)
+		}
+		sort.Strings(deps)
+		for _, dep := range deps {
+			e := &stackEdge{from: v, to: vertices[dep]}
+			v.outs = append(v.outs, e)
+			vertices[dep].ins = append(vertices[dep].ins, e)
+		}
+	}
+
+	sorted, err := graph.Topsort(g)
+	if err != nil {
+		return nil, errors.Wrap(err, "stack dependencies contain a cycle")
+	}
+	order := make([]string, len(sorted))
+	for i, v := range sorted {
+		order[i] = v.Label()
+	}
+	return order, nil
+}
+
+// stackGraph is the graph of the dependencies between an Orchestrator's stacks. Each edge points from a stack to a
+// stack that it waits for.
+type stackGraph struct {
+	roots []graph.Edge
+}
+
+func (g *stackGraph) Roots() []graph.Edge { return g.roots }
+
+type stackVertex struct {
+	name string
+	ins  []graph.Edge
+	outs []graph.Edge
+}
+
+func (v *stackVertex) Data() interface{}  { return v.name }
+func (v *stackVertex) Label() string      { return v.name }
+func (v *stackVertex) Ins() []graph.Edge  { return v.ins }
+func (v *stackVertex) Outs() []graph.Edge { return v.outs }
+
+type stackEdge struct {
+	from *stackVertex
+	to   *stackVertex
+}
+
+func (e *stackEdge) Data() interface{} { return nil }
+func (e *stackEdge) Label() string     { return "" }
+func (e *stackEdge) To() graph.Vertex  { return e.to }
+func (e *stackEdge) Color() string     { return "" }
+
+func (e *stackEdge) From() graph.Vertex {
+	if e.from == nil {
+		return nil
+	}
+	return e.from
+}
@@ -0,0 +1,204 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auto
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/apitype"
+)
+
+func newTestOrchestrator(t *testing.T, names []string, deps map[string][]string,
+	opts ...OrchestratorOption) *Orchestrator {
+
+	var stacks []Stack
+	for _, name := range names {
+		stacks = append(stacks, Stack{stackName: name})
+	}
+	o, err := NewOrchestrator(stacks, opts...)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	for name, ds := range deps {
+		for _, d := range ds {
+			if !assert.NoError(t, o.AddDependency(name, d)) {
+				t.FailNow()
+			}
+		}
+	}
+	return o
+}
+
+func TestOrchestratorOrder(t *testing.T) {
+	o := newTestOrchestrator(t, []string{"apps", "cluster", "network", "dns"}, map[string][]string{
+		"apps":    {"cluster", "dns"},
+		"cluster": {"network"},
+	}, OrchestratorParallelism(1))
+
+	var mutex sync.Mutex
+	record := func(order *[]string) func(ctx context.Context, s *Stack) StackResult {
+		return func(ctx context.Context, s *Stack) StackResult {
+			mutex.Lock()
+			defer mutex.Unlock()
+			*order = append(*order, s.Name())
+			return StackResult{}
+		}
+	}
+	index := func(order []string, name string) int {
+		for i, n := range order {
+			if n == name {
+				return i
+			}
+		}
+		return -1
+	}
+
+	var up []string
+	results, err := o.run(context.Background(), false, record(&up))
+	assert.NoError(t, err)
+	assert.Len(t, results, 4)
+	for _, res := range results {
+		assert.Equal(t, StackSucceeded, res.Status)
+	}
+	assert.Len(t, up, 4)
+	assert.True(t, index(up, "network") < index(up, "cluster"))
+	assert.True(t, index(up, "cluster") < index(up, "apps"))
+	assert.True(t, index(up, "dns") < index(up, "apps"))
+
+	var destroy []string
+	_, err = o.run(context.Background(), true, record(&destroy))
+	assert.NoError(t, err)
+	assert.Len(t, destroy, 4)
+	assert.True(t, index(destroy, "apps") < index(destroy, "cluster"))
+	assert.True(t, index(destroy, "cluster") < index(destroy, "network"))
+	assert.True(t, index(destroy, "apps") < index(destroy, "dns"))
+}
+
+func TestOrchestratorSkipsDependentsOfFailedStacks(t *testing.T) {
+	o := newTestOrchestrator(t, []string{"network", "cluster", "apps", "dns"}, map[string][]string{
+		"cluster": {"network"},
+		"apps":    {"cluster"},
+	})
+
+	results, err := o.run(context.Background(), false, func(ctx context.Context, s *Stack) StackResult {
+		if s.Name() == "network" {
+			return StackResult{Err: errors.New("boom")}
+		}
+		return StackResult{}
+	})
+	assert.Error(t, err)
+	assert.Equal(t, StackFailed, results["network"].Status)
+	assert.EqualError(t, results["network"].Err, "boom")
+	assert.Equal(t, StackSkipped, results["cluster"].Status)
+	assert.Equal(t, StackSkipped, results["apps"].Status)
+	assert.Equal(t, StackSucceeded, results["dns"].Status)
+}
+
+func TestOrchestratorParallelism(t *testing.T) {
+	names := []string{"a", "b", "c", "d", "e", "f"}
+	o := newTestOrchestrator(t, names, nil, OrchestratorParallelism(2))
+
+	var mutex sync.Mutex
+	running, maxRunning := 0, 0
+	_, err := o.run(context.Background(), false, func(ctx context.Context, s *Stack) StackResult {
+		mutex.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mutex.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		mutex.Lock()
+		running--
+		mutex.Unlock()
+		return StackResult{}
+	})
+	assert.NoError(t, err)
+	assert.True(t, maxRunning <= 2)
+}
+
+func TestOrchestratorRejectsCycles(t *testing.T) {
+	o := newTestOrchestrator(t, []string{"a", "b", "c"}, map[string][]string{
+		"a": {"b"},
+		"b": {"c"},
+		"c": {"a"},
+	})
+	_, err := o.run(context.Background(), false, func(ctx context.Context, s *Stack) StackResult {
+		t.Fatalf("unexpected operation on stack %q", s.Name())
+		return StackResult{}
+	})
+	assert.Error(t, err)
+
+	_, err = NewOrchestrator([]Stack{{stackName: "a"}, {stackName: "a"}})
+	assert.Error(t, err)
+	assert.Error(t, o.AddDependency("a", "unknown"))
+	assert.Error(t, o.AddDependency("a", "a"))
+}
+
+func TestStackReferences(t *testing.T) {
+	deployment, err := json.Marshal(map[string]interface{}{
+		"resources": []map[string]interface{}{
+			{"type": "pulumi:pulumi:Stack", "inputs": map[string]interface{}{}},
+			{"type": stackReferenceType, "inputs": map[string]interface{}{"name": "org/network/prod"}},
+			{"type": "aws:s3/bucket:Bucket", "inputs": map[string]interface{}{"name": "not-a-stack"}},
+			{"type": stackReferenceType, "inputs": map[string]interface{}{"name": "dns"}},
+		},
+	})
+	assert.NoError(t, err)
+
+	refs, err := stackReferences(apitype.UntypedDeployment{Version: 3, Deployment: deployment})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"org/network/prod", "dns"}, refs)
+
+	refs, err = stackReferences(apitype.UntypedDeployment{})
+	assert.NoError(t, err)
+	assert.Empty(t, refs)
+}
+
+func TestAddReferences(t *testing.T) {
+	o := newTestOrchestrator(t, []string{"apps", "org/network/prod", "other/dns/prod"}, nil)
+	scopes := map[string]stackScope{
+		"apps":             {org: "org", project: "apps"},
+		"org/network/prod": {org: "org", project: "network"},
+		"other/dns/prod":   {org: "org", project: "dns"},
+	}
+
+	// References are qualified relative to the stack that holds them, whichever form they are written in.
+	unresolved, err := o.addReferences(scopes, map[string][]string{
+		"apps":             {"org/network/prod", "other/dns/prod", "dev", "org/prod"},
+		"org/network/prod": {"prod"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"org/network/prod", "other/dns/prod"}, o.Dependencies("apps"))
+	assert.Empty(t, o.Dependencies("org/network/prod"))
+	assert.Equal(t, map[string][]string{"apps": {"org/apps/dev", "org/apps/prod"}}, unresolved)
+
+	// Stacks whose names qualify to the same stack cannot be told apart.
+	o = newTestOrchestrator(t, []string{"prod", "org/network/prod"}, nil)
+	_, err = o.addReferences(map[string]stackScope{
+		"prod":             {org: "org", project: "network"},
+		"org/network/prod": {org: "org", project: "network"},
+	}, nil)
+	assert.Error(t, err)
+}
@@ -234,6 +234,9 @@ func (s *Stack) Preview(ctx context.Context, opts ...optpreview.Option) (Preview
 	if preOpts.Parallel > 0 {
 		sharedArgs = append(sharedArgs, fmt.Sprintf("--parallel=%d", preOpts.Parallel))
 	}
+	if preOpts.SkipBuild {
+		sharedArgs = append(sharedArgs, "--skip-build")
+	}
 
 	kind, args := constant.ExecKindAutoLocal, []string{"preview", "--json"}
 	if program := s.Workspace().Program(); program != nil {
@@ -248,9 +251,17 @@ func (s *Stack) Preview(ctx context.Context, opts ...optpreview.Option) (Preview
 
 	args = append(args, fmt.Sprintf("--exec-kind=%s", kind))
 	args = append(args, sharedArgs...)
+	eventLog, removeEventLog, err := s.newEventLog(ctx, args[0])
+	if err != nil {
+		return res, err
+	}
+	defer removeEventLog()
+	if eventLog != "" {
+		args = append(args, "--event-log="+eventLog)
+	}
 	stdout, stderr, code, err := s.runPulumiCmdSync(ctx, nil /* additionalOutput */, args...)
 	if err != nil {
-		return res, newAutoError(errors.Wrap(err, "failed to run preview"), stdout, stderr, code)
+		return res, newOperationError(errors.Wrap(err, "failed to run preview"), stdout, stderr, code, eventLog)
 	}
 
 	err = json.Unmarshal([]byte(stdout), &res)
@@ -294,6 +305,9 @@ func (s *Stack) Up(ctx context.Context, opts ...optup.Option) (UpResult, error)
 	if upOpts.Parallel > 0 {
 		sharedArgs = append(sharedArgs, fmt.Sprintf("--parallel=%d", upOpts.Parallel))
 	}
+	if upOpts.SkipBuild {
+		sharedArgs = append(sharedArgs, "--skip-build")
+	}
 
 	kind, args := constant.ExecKindAutoLocal, []string{"up", "--yes", "--skip-preview"}
 	if program := s.Workspace().Program(); program != nil {
@@ -308,9 +322,17 @@ func (s *Stack) Up(ctx context.Context, opts ...optup.Option) (UpResult, error)
 
 	args = append(args, fmt.Sprintf("--exec-kind=%s", kind))
 	args = append(args, sharedArgs...)
+	eventLog, removeEventLog, err := s.newEventLog(ctx, args[0])
+	if err != nil {
+		return res, err
+	}
+	defer removeEventLog()
+	if eventLog != "" {
+		args = append(args, "--event-log="+eventLog)
+	}
 	stdout, stderr, code, err := s.runPulumiCmdSync(ctx, upOpts.ProgressStreams, args...)
 	if err != nil {
-		return res, newAutoError(errors.Wrap(err, "failed to run update"), stdout, stderr, code)
+		return res, newOperationError(errors.Wrap(err, "failed to run update"), stdout, stderr, code, eventLog)
 	}
 
 	outs, err := s.Outputs(ctx)
@@ -370,9 +392,17 @@ func (s *Stack) Refresh(ctx context.Context, opts ...optrefresh.Option) (Refresh
 	}
 	args = append(args, fmt.Sprintf("--exec-kind=%s", execKind))
 
+	eventLog, removeEventLog, err := s.newEventLog(ctx, args[0])
+	if err != nil {
+		return res, err
+	}
+	defer removeEventLog()
+	if eventLog != "" {
+		args = append(args, "--event-log="+eventLog)
+	}
 	stdout, stderr, code, err := s.runPulumiCmdSync(ctx, refreshOpts.ProgressStreams, args...)
 	if err != nil {
-		return res, newAutoError(errors.Wrap(err, "failed to refresh stack"), stdout, stderr, code)
+		return res, newOperationError(errors.Wrap(err, "failed to refresh stack"), stdout, stderr, code, eventLog)
 	}
 
 	history, err := s.History(ctx)
@@ -427,9 +457,17 @@ func (s *Stack) Destroy(ctx context.Context, opts ...optdestroy.Option) (Destroy
 	}
 	args = append(args, fmt.Sprintf("--exec-kind=%s", execKind))
 
+	eventLog, removeEventLog, err := s.newEventLog(ctx, args[0])
+	if err != nil {
+		return res, err
+	}
+	defer removeEventLog()
+	if eventLog != "" {
+		args = append(args, "--event-log="+eventLog)
+	}
 	stdout, stderr, code, err := s.runPulumiCmdSync(ctx, destroyOpts.ProgressStreams, args...)
 	if err != nil {
-		return res, newAutoError(errors.Wrap(err, "failed to destroy stack"), stdout, stderr, code)
+		return res, newOperationError(errors.Wrap(err, "failed to destroy stack"), stdout, stderr, code, eventLog)
 	}
 
 	history, err := s.History(ctx)
@@ -752,6 +790,19 @@ func (s *Stack) runPulumiCmdSync(
 	return stdout, stderr, errCode, nil
 }
 
+// newEventLog creates a temporary file for the CLI to log the engine events of the given command on the stack to, if
+// the workspace's CLI supports doing so. The returned function removes the file.
+func (s *Stack) newEventLog(ctx context.Context, command string) (string, func(), error) {
+	var env []string
+	if s.Workspace().PulumiHome() != "" {
+		env = append(env, fmt.Sprintf("%s=%s", pulumiHomeEnv, s.Workspace().PulumiHome()))
+	}
+	for k, v := range s.Workspace().GetEnvVars() {
+		env = append(env, k+"="+v)
+	}
+	return newEventLog(supportsEventLog(ctx, s.Workspace().WorkDir(), env, command))
+}
+
 const (
 	stateWaiting = iota
 	stateRunning
@@ -20,6 +20,7 @@ import (
 	"github.com/pulumi/pulumi/sdk/v2/go/common/apitype"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/workspace"
 	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v2/go/x/auto/optinstall"
 )
 
 // Workspace is the execution context containing a single Pulumi project, a program, and multiple stacks.
@@ -92,6 +93,9 @@ type Workspace interface {
 	RemovePlugin(context.Context, string, string) error
 	// ListPlugins lists all installed plugins.
 	ListPlugins(context.Context) ([]workspace.PluginInfo, error)
+	// Install installs the project's language dependencies (e.g. `npm install` or a Python virtualenv) along with
+	// the plugins that its program requires, so that a freshly cloned project is ready for Preview/Update.
+	Install(context.Context, ...optinstall.Option) error
 	// Program returns the program `pulumi.RunFunc` to be used for Preview/Update if any.
 	// If none is specified, the stack will refer to ProjectSettings for this information.
 	Program() pulumi.RunFunc
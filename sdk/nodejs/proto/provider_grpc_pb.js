@@ -33,6 +33,28 @@ function deserialize_google_protobuf_Empty(buffer_arg) {
   return google_protobuf_empty_pb.Empty.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_pulumirpc_CallRequest(arg) {
+  if (!(arg instanceof provider_pb.CallRequest)) {
+    throw new Error('Expected argument of type pulumirpc.CallRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_CallRequest(buffer_arg) {
+  return provider_pb.CallRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pulumirpc_CallResponse(arg) {
+  if (!(arg instanceof provider_pb.CallResponse)) {
+    throw new Error('Expected argument of type pulumirpc.CallResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_CallResponse(buffer_arg) {
+  return provider_pb.CallResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_pulumirpc_CheckRequest(arg) {
   if (!(arg instanceof provider_pb.CheckRequest)) {
     throw new Error('Expected argument of type pulumirpc.CheckRequest');
@@ -198,6 +220,28 @@ function deserialize_pulumirpc_InvokeResponse(buffer_arg) {
   return provider_pb.InvokeResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_pulumirpc_ListRequest(arg) {
+  if (!(arg instanceof provider_pb.ListRequest)) {
+    throw new Error('Expected argument of type pulumirpc.ListRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_ListRequest(buffer_arg) {
+  return provider_pb.ListRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pulumirpc_ListResponse(arg) {
+  if (!(arg instanceof provider_pb.ListResponse)) {
+    throw new Error('Expected argument of type pulumirpc.ListResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_ListResponse(buffer_arg) {
+  return provider_pb.ListResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_pulumirpc_PluginInfo(arg) {
   if (!(arg instanceof plugin_pb.PluginInfo)) {
     throw new Error('Expected argument of type pulumirpc.PluginInfo');
@@ -330,6 +374,19 @@ streamInvoke: {
     responseSerialize: serialize_pulumirpc_InvokeResponse,
     responseDeserialize: deserialize_pulumirpc_InvokeResponse,
   },
+  // Call dynamically executes a method in the provider associated with a component resource. The resource on which
+// the method is called is passed as a resource reference in the `__self__` argument.
+call: {
+    path: '/pulumirpc.ResourceProvider/Call',
+    requestStream: false,
+    responseStream: false,
+    requestType: provider_pb.CallRequest,
+    responseType: provider_pb.CallResponse,
+    requestSerialize: serialize_pulumirpc_CallRequest,
+    requestDeserialize: deserialize_pulumirpc_CallRequest,
+    responseSerialize: serialize_pulumirpc_CallResponse,
+    responseDeserialize: deserialize_pulumirpc_CallResponse,
+  },
   // Check validates that the given property bag is valid for a resource of the given type and returns the inputs
 // that should be passed to successive calls to Diff, Create, or Update for this resource. As a rule, the provider
 // inputs returned by a call to Check should preserve the original representation of the properties as present in
@@ -420,6 +477,20 @@ construct: {
     responseSerialize: serialize_pulumirpc_ConstructResponse,
     responseDeserialize: deserialize_pulumirpc_ConstructResponse,
   },
+  // List returns the IDs of the existing resources of the given type that match the given filters. The IDs may be
+// used to import the resources. Providers are not required to implement List; those that do not return an
+// UNIMPLEMENTED error.
+list: {
+    path: '/pulumirpc.ResourceProvider/List',
+    requestStream: false,
+    responseStream: false,
+    requestType: provider_pb.ListRequest,
+    responseType: provider_pb.ListResponse,
+    requestSerialize: serialize_pulumirpc_ListRequest,
+    requestDeserialize: deserialize_pulumirpc_ListRequest,
+    responseSerialize: serialize_pulumirpc_ListResponse,
+    responseDeserialize: deserialize_pulumirpc_ListResponse,
+  },
   // Cancel signals the provider to abort all outstanding resource operations.
 cancel: {
     path: '/pulumirpc.ResourceProvider/Cancel',
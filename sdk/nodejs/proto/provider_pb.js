@@ -18,6 +18,10 @@ var google_protobuf_empty_pb = require('google-protobuf/google/protobuf/empty_pb
 goog.object.extend(proto, google_protobuf_empty_pb);
 var google_protobuf_struct_pb = require('google-protobuf/google/protobuf/struct_pb.js');
 goog.object.extend(proto, google_protobuf_struct_pb);
+goog.exportSymbol('proto.pulumirpc.CallRequest', null, global);
+goog.exportSymbol('proto.pulumirpc.CallRequest.ArgumentDependencies', null, global);
+goog.exportSymbol('proto.pulumirpc.CallResponse', null, global);
+goog.exportSymbol('proto.pulumirpc.CallResponse.ReturnDependencies', null, global);
 goog.exportSymbol('proto.pulumirpc.CheckFailure', null, global);
 goog.exportSymbol('proto.pulumirpc.CheckRequest', null, global);
 goog.exportSymbol('proto.pulumirpc.CheckResponse', null, global);
@@ -40,6 +44,8 @@ goog.exportSymbol('proto.pulumirpc.GetSchemaRequest', null, global);
 goog.exportSymbol('proto.pulumirpc.GetSchemaResponse', null, global);
 goog.exportSymbol('proto.pulumirpc.InvokeRequest', null, global);
 goog.exportSymbol('proto.pulumirpc.InvokeResponse', null, global);
+goog.exportSymbol('proto.pulumirpc.ListRequest', null, global);
+goog.exportSymbol('proto.pulumirpc.ListResponse', null, global);
 goog.exportSymbol('proto.pulumirpc.PropertyDiff', null, global);
 goog.exportSymbol('proto.pulumirpc.PropertyDiff.Kind', null, global);
 goog.exportSymbol('proto.pulumirpc.ReadRequest', null, global);
@@ -214,6 +220,90 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.pulumirpc.InvokeResponse.displayName = 'proto.pulumirpc.InvokeResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pulumirpc.CallRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pulumirpc.CallRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pulumirpc.CallRequest.displayName = 'proto.pulumirpc.CallRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pulumirpc.CallRequest.ArgumentDependencies = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.pulumirpc.CallRequest.ArgumentDependencies.repeatedFields_, null);
+};
+goog.inherits(proto.pulumirpc.CallRequest.ArgumentDependencies, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pulumirpc.CallRequest.ArgumentDependencies.displayName = 'proto.pulumirpc.CallRequest.ArgumentDependencies';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pulumirpc.CallResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.pulumirpc.CallResponse.repeatedFields_, null);
+};
+goog.inherits(proto.pulumirpc.CallResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pulumirpc.CallResponse.displayName = 'proto.pulumirpc.CallResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pulumirpc.CallResponse.ReturnDependencies = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.pulumirpc.CallResponse.ReturnDependencies.repeatedFields_, null);
+};
+goog.inherits(proto.pulumirpc.CallResponse.ReturnDependencies, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pulumirpc.CallResponse.ReturnDependencies.displayName = 'proto.pulumirpc.CallResponse.ReturnDependencies';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -571,6 +661,48 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.pulumirpc.ConstructResponse.PropertyDependencies.displayName = 'proto.pulumirpc.ConstructResponse.PropertyDependencies';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pulumirpc.ListRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pulumirpc.ListRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pulumirpc.ListRequest.displayName = 'proto.pulumirpc.ListRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pulumirpc.ListResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.pulumirpc.ListResponse.repeatedFields_, null);
+};
+goog.inherits(proto.pulumirpc.ListResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pulumirpc.ListResponse.displayName = 'proto.pulumirpc.ListResponse';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -2104,8 +2236,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pulumirpc.CheckRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.CheckRequest.toObject(opt_includeInstance, this);
+proto.pulumirpc.CallRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.CallRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -2114,15 +2246,23 @@ proto.pulumirpc.CheckRequest.prototype.toObject = function(opt_includeInstance)
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.CheckRequest} msg The msg instance to transform.
+ * @param {!proto.pulumirpc.CallRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.CheckRequest.toObject = function(includeInstance, msg) {
+proto.pulumirpc.CallRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    urn: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    olds: (f = msg.getOlds()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
-    news: (f = msg.getNews()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+    tok: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    args: (f = msg.getArgs()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
+    argdependenciesMap: (f = msg.getArgdependenciesMap()) ? f.toObject(includeInstance, proto.pulumirpc.CallRequest.ArgumentDependencies.toObject) : [],
+    provider: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    version: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    project: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    stack: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    configMap: (f = msg.getConfigMap()) ? f.toObject(includeInstance, undefined) : [],
+    dryrun: jspb.Message.getBooleanFieldWithDefault(msg, 9, false),
+    parallel: jspb.Message.getFieldWithDefault(msg, 10, 0),
+    monitorendpoint: jspb.Message.getFieldWithDefault(msg, 11, "")
   };
 
   if (includeInstance) {
@@ -2136,23 +2276,23 @@ proto.pulumirpc.CheckRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.CheckRequest}
+ * @return {!proto.pulumirpc.CallRequest}
  */
-proto.pulumirpc.CheckRequest.deserializeBinary = function(bytes) {
+proto.pulumirpc.CallRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.CheckRequest;
-  return proto.pulumirpc.CheckRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pulumirpc.CallRequest;
+  return proto.pulumirpc.CallRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pulumirpc.CheckRequest} msg The message object to deserialize into.
+ * @param {!proto.pulumirpc.CallRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.CheckRequest}
+ * @return {!proto.pulumirpc.CallRequest}
  */
-proto.pulumirpc.CheckRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.pulumirpc.CallRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2161,17 +2301,52 @@ proto.pulumirpc.CheckRequest.deserializeBinaryFromReader = function(msg, reader)
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setUrn(value);
+      msg.setTok(value);
       break;
     case 2:
       var value = new google_protobuf_struct_pb.Struct;
       reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setOlds(value);
+      msg.setArgs(value);
       break;
     case 3:
-      var value = new google_protobuf_struct_pb.Struct;
-      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setNews(value);
+      var value = msg.getArgdependenciesMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.pulumirpc.CallRequest.ArgumentDependencies.deserializeBinaryFromReader, "", new proto.pulumirpc.CallRequest.ArgumentDependencies());
+         });
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setProvider(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setVersion(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setProject(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setStack(value);
+      break;
+    case 8:
+      var value = msg.getConfigMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    case 9:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setDryrun(value);
+      break;
+    case 10:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setParallel(value);
+      break;
+    case 11:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMonitorendpoint(value);
       break;
     default:
       reader.skipField();
@@ -2186,9 +2361,9 @@ proto.pulumirpc.CheckRequest.deserializeBinaryFromReader = function(msg, reader)
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pulumirpc.CheckRequest.prototype.serializeBinary = function() {
+proto.pulumirpc.CallRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.CheckRequest.serializeBinaryToWriter(this, writer);
+  proto.pulumirpc.CallRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2196,20 +2371,20 @@ proto.pulumirpc.CheckRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.CheckRequest} message
+ * @param {!proto.pulumirpc.CallRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.CheckRequest.serializeBinaryToWriter = function(message, writer) {
+proto.pulumirpc.CallRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUrn();
+  f = message.getTok();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getOlds();
+  f = message.getArgs();
   if (f != null) {
     writer.writeMessage(
       2,
@@ -2217,134 +2392,91 @@ proto.pulumirpc.CheckRequest.serializeBinaryToWriter = function(message, writer)
       google_protobuf_struct_pb.Struct.serializeBinaryToWriter
     );
   }
-  f = message.getNews();
-  if (f != null) {
-    writer.writeMessage(
-      3,
-      f,
-      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+  f = message.getArgdependenciesMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(3, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.pulumirpc.CallRequest.ArgumentDependencies.serializeBinaryToWriter);
+  }
+  f = message.getProvider();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getVersion();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getProject();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getStack();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getConfigMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(8, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+  f = message.getDryrun();
+  if (f) {
+    writer.writeBool(
+      9,
+      f
+    );
+  }
+  f = message.getParallel();
+  if (f !== 0) {
+    writer.writeInt32(
+      10,
+      f
+    );
+  }
+  f = message.getMonitorendpoint();
+  if (f.length > 0) {
+    writer.writeString(
+      11,
+      f
     );
   }
 };
 
 
+
 /**
- * optional string urn = 1;
- * @return {string}
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.pulumirpc.CheckRequest.prototype.getUrn = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
+proto.pulumirpc.CallRequest.ArgumentDependencies.repeatedFields_ = [1];
 
 
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * @param {string} value
- * @return {!proto.pulumirpc.CheckRequest} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.pulumirpc.CheckRequest.prototype.setUrn = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
-
-
-/**
- * optional google.protobuf.Struct olds = 2;
- * @return {?proto.google.protobuf.Struct}
- */
-proto.pulumirpc.CheckRequest.prototype.getOlds = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 2));
-};
-
-
-/**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.pulumirpc.CheckRequest} returns this
-*/
-proto.pulumirpc.CheckRequest.prototype.setOlds = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.pulumirpc.CheckRequest} returns this
- */
-proto.pulumirpc.CheckRequest.prototype.clearOlds = function() {
-  return this.setOlds(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.pulumirpc.CheckRequest.prototype.hasOlds = function() {
-  return jspb.Message.getField(this, 2) != null;
-};
-
-
-/**
- * optional google.protobuf.Struct news = 3;
- * @return {?proto.google.protobuf.Struct}
- */
-proto.pulumirpc.CheckRequest.prototype.getNews = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 3));
-};
-
-
-/**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.pulumirpc.CheckRequest} returns this
-*/
-proto.pulumirpc.CheckRequest.prototype.setNews = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.pulumirpc.CheckRequest} returns this
- */
-proto.pulumirpc.CheckRequest.prototype.clearNews = function() {
-  return this.setNews(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.pulumirpc.CheckRequest.prototype.hasNews = function() {
-  return jspb.Message.getField(this, 3) != null;
-};
-
-
-
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.pulumirpc.CheckResponse.repeatedFields_ = [2];
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
-/**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
- */
-proto.pulumirpc.CheckResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.CheckResponse.toObject(opt_includeInstance, this);
+proto.pulumirpc.CallRequest.ArgumentDependencies.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.CallRequest.ArgumentDependencies.toObject(opt_includeInstance, this);
 };
 
 
@@ -2353,15 +2485,13 @@ proto.pulumirpc.CheckResponse.prototype.toObject = function(opt_includeInstance)
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.CheckResponse} msg The msg instance to transform.
+ * @param {!proto.pulumirpc.CallRequest.ArgumentDependencies} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.CheckResponse.toObject = function(includeInstance, msg) {
+proto.pulumirpc.CallRequest.ArgumentDependencies.toObject = function(includeInstance, msg) {
   var f, obj = {
-    inputs: (f = msg.getInputs()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
-    failuresList: jspb.Message.toObjectList(msg.getFailuresList(),
-    proto.pulumirpc.CheckFailure.toObject, includeInstance)
+    urnsList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -2375,23 +2505,23 @@ proto.pulumirpc.CheckResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.CheckResponse}
+ * @return {!proto.pulumirpc.CallRequest.ArgumentDependencies}
  */
-proto.pulumirpc.CheckResponse.deserializeBinary = function(bytes) {
+proto.pulumirpc.CallRequest.ArgumentDependencies.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.CheckResponse;
-  return proto.pulumirpc.CheckResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pulumirpc.CallRequest.ArgumentDependencies;
+  return proto.pulumirpc.CallRequest.ArgumentDependencies.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pulumirpc.CheckResponse} msg The message object to deserialize into.
+ * @param {!proto.pulumirpc.CallRequest.ArgumentDependencies} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.CheckResponse}
+ * @return {!proto.pulumirpc.CallRequest.ArgumentDependencies}
  */
-proto.pulumirpc.CheckResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.pulumirpc.CallRequest.ArgumentDependencies.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2399,14 +2529,8 @@ proto.pulumirpc.CheckResponse.deserializeBinaryFromReader = function(msg, reader
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new google_protobuf_struct_pb.Struct;
-      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setInputs(value);
-      break;
-    case 2:
-      var value = new proto.pulumirpc.CheckFailure;
-      reader.readMessage(value,proto.pulumirpc.CheckFailure.deserializeBinaryFromReader);
-      msg.addFailures(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.addUrns(value);
       break;
     default:
       reader.skipField();
@@ -2421,9 +2545,9 @@ proto.pulumirpc.CheckResponse.deserializeBinaryFromReader = function(msg, reader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pulumirpc.CheckResponse.prototype.serializeBinary = function() {
+proto.pulumirpc.CallRequest.ArgumentDependencies.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.CheckResponse.serializeBinaryToWriter(this, writer);
+  proto.pulumirpc.CallRequest.ArgumentDependencies.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2431,263 +2555,281 @@ proto.pulumirpc.CheckResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.CheckResponse} message
+ * @param {!proto.pulumirpc.CallRequest.ArgumentDependencies} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.CheckResponse.serializeBinaryToWriter = function(message, writer) {
+proto.pulumirpc.CallRequest.ArgumentDependencies.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getInputs();
-  if (f != null) {
-    writer.writeMessage(
-      1,
-      f,
-      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
-    );
-  }
-  f = message.getFailuresList();
+  f = message.getUrnsList();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      2,
-      f,
-      proto.pulumirpc.CheckFailure.serializeBinaryToWriter
+    writer.writeRepeatedString(
+      1,
+      f
     );
   }
 };
 
 
 /**
- * optional google.protobuf.Struct inputs = 1;
- * @return {?proto.google.protobuf.Struct}
+ * repeated string urns = 1;
+ * @return {!Array<string>}
  */
-proto.pulumirpc.CheckResponse.prototype.getInputs = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 1));
+proto.pulumirpc.CallRequest.ArgumentDependencies.prototype.getUrnsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
 };
 
 
 /**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.pulumirpc.CheckResponse} returns this
-*/
-proto.pulumirpc.CheckResponse.prototype.setInputs = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
+ * @param {!Array<string>} value
+ * @return {!proto.pulumirpc.CallRequest.ArgumentDependencies} returns this
+ */
+proto.pulumirpc.CallRequest.ArgumentDependencies.prototype.setUrnsList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.pulumirpc.CheckResponse} returns this
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.pulumirpc.CallRequest.ArgumentDependencies} returns this
  */
-proto.pulumirpc.CheckResponse.prototype.clearInputs = function() {
-  return this.setInputs(undefined);
+proto.pulumirpc.CallRequest.ArgumentDependencies.prototype.addUrns = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pulumirpc.CallRequest.ArgumentDependencies} returns this
  */
-proto.pulumirpc.CheckResponse.prototype.hasInputs = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.pulumirpc.CallRequest.ArgumentDependencies.prototype.clearUrnsList = function() {
+  return this.setUrnsList([]);
 };
 
 
 /**
- * repeated CheckFailure failures = 2;
- * @return {!Array<!proto.pulumirpc.CheckFailure>}
+ * optional string tok = 1;
+ * @return {string}
  */
-proto.pulumirpc.CheckResponse.prototype.getFailuresList = function() {
-  return /** @type{!Array<!proto.pulumirpc.CheckFailure>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.pulumirpc.CheckFailure, 2));
+proto.pulumirpc.CallRequest.prototype.getTok = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {!Array<!proto.pulumirpc.CheckFailure>} value
- * @return {!proto.pulumirpc.CheckResponse} returns this
-*/
-proto.pulumirpc.CheckResponse.prototype.setFailuresList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+ * @param {string} value
+ * @return {!proto.pulumirpc.CallRequest} returns this
+ */
+proto.pulumirpc.CallRequest.prototype.setTok = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.pulumirpc.CheckFailure=} opt_value
- * @param {number=} opt_index
- * @return {!proto.pulumirpc.CheckFailure}
+ * optional google.protobuf.Struct args = 2;
+ * @return {?proto.google.protobuf.Struct}
  */
-proto.pulumirpc.CheckResponse.prototype.addFailures = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.pulumirpc.CheckFailure, opt_index);
+proto.pulumirpc.CallRequest.prototype.getArgs = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 2));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.pulumirpc.CheckResponse} returns this
- */
-proto.pulumirpc.CheckResponse.prototype.clearFailuresList = function() {
-  return this.setFailuresList([]);
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.pulumirpc.CallRequest} returns this
+*/
+proto.pulumirpc.CallRequest.prototype.setArgs = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
 };
 
 
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Clears the message field making it undefined.
+ * @return {!proto.pulumirpc.CallRequest} returns this
  */
-proto.pulumirpc.CheckFailure.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.CheckFailure.toObject(opt_includeInstance, this);
+proto.pulumirpc.CallRequest.prototype.clearArgs = function() {
+  return this.setArgs(undefined);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.CheckFailure} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.pulumirpc.CheckFailure.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    property: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    reason: jspb.Message.getFieldWithDefault(msg, 2, "")
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.pulumirpc.CallRequest.prototype.hasArgs = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.CheckFailure}
+ * map<string, ArgumentDependencies> argDependencies = 3;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.pulumirpc.CallRequest.ArgumentDependencies>}
  */
-proto.pulumirpc.CheckFailure.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.CheckFailure;
-  return proto.pulumirpc.CheckFailure.deserializeBinaryFromReader(msg, reader);
+proto.pulumirpc.CallRequest.prototype.getArgdependenciesMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.pulumirpc.CallRequest.ArgumentDependencies>} */ (
+      jspb.Message.getMapField(this, 3, opt_noLazyCreate,
+      proto.pulumirpc.CallRequest.ArgumentDependencies));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.pulumirpc.CheckFailure} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.CheckFailure}
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.pulumirpc.CallRequest} returns this
  */
-proto.pulumirpc.CheckFailure.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setProperty(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setReason(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.pulumirpc.CallRequest.prototype.clearArgdependenciesMap = function() {
+  this.getArgdependenciesMap().clear();
+  return this;};
+
+
+/**
+ * optional string provider = 4;
+ * @return {string}
+ */
+proto.pulumirpc.CallRequest.prototype.getProvider = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * @param {string} value
+ * @return {!proto.pulumirpc.CallRequest} returns this
  */
-proto.pulumirpc.CheckFailure.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.CheckFailure.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.pulumirpc.CallRequest.prototype.setProvider = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.CheckFailure} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional string version = 5;
+ * @return {string}
  */
-proto.pulumirpc.CheckFailure.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getProperty();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getReason();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
+proto.pulumirpc.CallRequest.prototype.getVersion = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /**
- * optional string property = 1;
+ * @param {string} value
+ * @return {!proto.pulumirpc.CallRequest} returns this
+ */
+proto.pulumirpc.CallRequest.prototype.setVersion = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional string project = 6;
  * @return {string}
  */
-proto.pulumirpc.CheckFailure.prototype.getProperty = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.pulumirpc.CallRequest.prototype.getProject = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.pulumirpc.CheckFailure} returns this
+ * @return {!proto.pulumirpc.CallRequest} returns this
  */
-proto.pulumirpc.CheckFailure.prototype.setProperty = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.pulumirpc.CallRequest.prototype.setProject = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
 };
 
 
 /**
- * optional string reason = 2;
+ * optional string stack = 7;
  * @return {string}
  */
-proto.pulumirpc.CheckFailure.prototype.getReason = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.pulumirpc.CallRequest.prototype.getStack = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.pulumirpc.CheckFailure} returns this
+ * @return {!proto.pulumirpc.CallRequest} returns this
  */
-proto.pulumirpc.CheckFailure.prototype.setReason = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.pulumirpc.CallRequest.prototype.setStack = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+/**
+ * map<string, string> config = 8;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.pulumirpc.CallRequest.prototype.getConfigMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 8, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.pulumirpc.CallRequest} returns this
+ */
+proto.pulumirpc.CallRequest.prototype.clearConfigMap = function() {
+  this.getConfigMap().clear();
+  return this;};
+
+
+/**
+ * optional bool dryRun = 9;
+ * @return {boolean}
+ */
+proto.pulumirpc.CallRequest.prototype.getDryrun = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 9, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.pulumirpc.CallRequest} returns this
+ */
+proto.pulumirpc.CallRequest.prototype.setDryrun = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 9, value);
+};
+
+
+/**
+ * optional int32 parallel = 10;
+ * @return {number}
+ */
+proto.pulumirpc.CallRequest.prototype.getParallel = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pulumirpc.CallRequest} returns this
+ */
+proto.pulumirpc.CallRequest.prototype.setParallel = function(value) {
+  return jspb.Message.setProto3IntField(this, 10, value);
+};
+
+
+/**
+ * optional string monitorEndpoint = 11;
+ * @return {string}
+ */
+proto.pulumirpc.CallRequest.prototype.getMonitorendpoint = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 11, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.CallRequest} returns this
+ */
+proto.pulumirpc.CallRequest.prototype.setMonitorendpoint = function(value) {
+  return jspb.Message.setProto3StringField(this, 11, value);
 };
 
 
@@ -2697,7 +2839,7 @@ proto.pulumirpc.CheckFailure.prototype.setReason = function(value) {
  * @private {!Array<number>}
  * @const
  */
-proto.pulumirpc.DiffRequest.repeatedFields_ = [5];
+proto.pulumirpc.CallResponse.repeatedFields_ = [3];
 
 
 
@@ -2714,8 +2856,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pulumirpc.DiffRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.DiffRequest.toObject(opt_includeInstance, this);
+proto.pulumirpc.CallResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.CallResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -2724,17 +2866,16 @@ proto.pulumirpc.DiffRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.DiffRequest} msg The msg instance to transform.
+ * @param {!proto.pulumirpc.CallResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.DiffRequest.toObject = function(includeInstance, msg) {
+proto.pulumirpc.CallResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    urn: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    olds: (f = msg.getOlds()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
-    news: (f = msg.getNews()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
-    ignorechangesList: (f = jspb.Message.getRepeatedField(msg, 5)) == null ? undefined : f
+    pb_return: (f = msg.getReturn()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
+    returndependenciesMap: (f = msg.getReturndependenciesMap()) ? f.toObject(includeInstance, proto.pulumirpc.CallResponse.ReturnDependencies.toObject) : [],
+    failuresList: jspb.Message.toObjectList(msg.getFailuresList(),
+    proto.pulumirpc.CheckFailure.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -2748,23 +2889,23 @@ proto.pulumirpc.DiffRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.DiffRequest}
+ * @return {!proto.pulumirpc.CallResponse}
  */
-proto.pulumirpc.DiffRequest.deserializeBinary = function(bytes) {
+proto.pulumirpc.CallResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.DiffRequest;
-  return proto.pulumirpc.DiffRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pulumirpc.CallResponse;
+  return proto.pulumirpc.CallResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pulumirpc.DiffRequest} msg The message object to deserialize into.
+ * @param {!proto.pulumirpc.CallResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.DiffRequest}
+ * @return {!proto.pulumirpc.CallResponse}
  */
-proto.pulumirpc.DiffRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.pulumirpc.CallResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2772,26 +2913,20 @@ proto.pulumirpc.DiffRequest.deserializeBinaryFromReader = function(msg, reader)
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setUrn(value);
-      break;
-    case 3:
       var value = new google_protobuf_struct_pb.Struct;
       reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setOlds(value);
+      msg.setReturn(value);
       break;
-    case 4:
-      var value = new google_protobuf_struct_pb.Struct;
-      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setNews(value);
+    case 2:
+      var value = msg.getReturndependenciesMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.pulumirpc.CallResponse.ReturnDependencies.deserializeBinaryFromReader, "", new proto.pulumirpc.CallResponse.ReturnDependencies());
+         });
       break;
-    case 5:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addIgnorechanges(value);
+    case 3:
+      var value = new proto.pulumirpc.CheckFailure;
+      reader.readMessage(value,proto.pulumirpc.CheckFailure.deserializeBinaryFromReader);
+      msg.addFailures(value);
       break;
     default:
       reader.skipField();
@@ -2806,9 +2941,9 @@ proto.pulumirpc.DiffRequest.deserializeBinaryFromReader = function(msg, reader)
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pulumirpc.DiffRequest.prototype.serializeBinary = function() {
+proto.pulumirpc.CallResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.DiffRequest.serializeBinaryToWriter(this, writer);
+  proto.pulumirpc.CallResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2816,150 +2951,216 @@ proto.pulumirpc.DiffRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.DiffRequest} message
+ * @param {!proto.pulumirpc.CallResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.DiffRequest.serializeBinaryToWriter = function(message, writer) {
+proto.pulumirpc.CallResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getUrn();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getOlds();
+  f = message.getReturn();
   if (f != null) {
     writer.writeMessage(
-      3,
+      1,
       f,
       google_protobuf_struct_pb.Struct.serializeBinaryToWriter
     );
   }
-  f = message.getNews();
-  if (f != null) {
-    writer.writeMessage(
-      4,
-      f,
-      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
-    );
+  f = message.getReturndependenciesMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.pulumirpc.CallResponse.ReturnDependencies.serializeBinaryToWriter);
   }
-  f = message.getIgnorechangesList();
+  f = message.getFailuresList();
   if (f.length > 0) {
-    writer.writeRepeatedString(
-      5,
-      f
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      proto.pulumirpc.CheckFailure.serializeBinaryToWriter
     );
   }
 };
 
 
-/**
- * optional string id = 1;
- * @return {string}
- */
-proto.pulumirpc.DiffRequest.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
 
 /**
- * @param {string} value
- * @return {!proto.pulumirpc.DiffRequest} returns this
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.pulumirpc.DiffRequest.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
+proto.pulumirpc.CallResponse.ReturnDependencies.repeatedFields_ = [1];
+
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional string urn = 2;
- * @return {string}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.pulumirpc.DiffRequest.prototype.getUrn = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.pulumirpc.CallResponse.ReturnDependencies.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.CallResponse.ReturnDependencies.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.pulumirpc.DiffRequest} returns this
- */
-proto.pulumirpc.DiffRequest.prototype.setUrn = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pulumirpc.CallResponse.ReturnDependencies} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.CallResponse.ReturnDependencies.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    urnsList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional google.protobuf.Struct olds = 3;
- * @return {?proto.google.protobuf.Struct}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pulumirpc.CallResponse.ReturnDependencies}
  */
-proto.pulumirpc.DiffRequest.prototype.getOlds = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 3));
+proto.pulumirpc.CallResponse.ReturnDependencies.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pulumirpc.CallResponse.ReturnDependencies;
+  return proto.pulumirpc.CallResponse.ReturnDependencies.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.pulumirpc.DiffRequest} returns this
-*/
-proto.pulumirpc.DiffRequest.prototype.setOlds = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pulumirpc.CallResponse.ReturnDependencies} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pulumirpc.CallResponse.ReturnDependencies}
+ */
+proto.pulumirpc.CallResponse.ReturnDependencies.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addUrns(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.pulumirpc.DiffRequest} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.pulumirpc.DiffRequest.prototype.clearOlds = function() {
-  return this.setOlds(undefined);
+proto.pulumirpc.CallResponse.ReturnDependencies.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pulumirpc.CallResponse.ReturnDependencies.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pulumirpc.CallResponse.ReturnDependencies} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.DiffRequest.prototype.hasOlds = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.pulumirpc.CallResponse.ReturnDependencies.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUrnsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * optional google.protobuf.Struct news = 4;
+ * repeated string urns = 1;
+ * @return {!Array<string>}
+ */
+proto.pulumirpc.CallResponse.ReturnDependencies.prototype.getUrnsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.pulumirpc.CallResponse.ReturnDependencies} returns this
+ */
+proto.pulumirpc.CallResponse.ReturnDependencies.prototype.setUrnsList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.pulumirpc.CallResponse.ReturnDependencies} returns this
+ */
+proto.pulumirpc.CallResponse.ReturnDependencies.prototype.addUrns = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pulumirpc.CallResponse.ReturnDependencies} returns this
+ */
+proto.pulumirpc.CallResponse.ReturnDependencies.prototype.clearUrnsList = function() {
+  return this.setUrnsList([]);
+};
+
+
+/**
+ * optional google.protobuf.Struct return = 1;
  * @return {?proto.google.protobuf.Struct}
  */
-proto.pulumirpc.DiffRequest.prototype.getNews = function() {
+proto.pulumirpc.CallResponse.prototype.getReturn = function() {
   return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 4));
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 1));
 };
 
 
 /**
  * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.pulumirpc.DiffRequest} returns this
+ * @return {!proto.pulumirpc.CallResponse} returns this
 */
-proto.pulumirpc.DiffRequest.prototype.setNews = function(value) {
-  return jspb.Message.setWrapperField(this, 4, value);
+proto.pulumirpc.CallResponse.prototype.setReturn = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.pulumirpc.DiffRequest} returns this
+ * @return {!proto.pulumirpc.CallResponse} returns this
  */
-proto.pulumirpc.DiffRequest.prototype.clearNews = function() {
-  return this.setNews(undefined);
+proto.pulumirpc.CallResponse.prototype.clearReturn = function() {
+  return this.setReturn(undefined);
 };
 
 
@@ -2967,45 +3168,68 @@ proto.pulumirpc.DiffRequest.prototype.clearNews = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.pulumirpc.DiffRequest.prototype.hasNews = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.pulumirpc.CallResponse.prototype.hasReturn = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 /**
- * repeated string ignoreChanges = 5;
- * @return {!Array<string>}
+ * map<string, ReturnDependencies> returnDependencies = 2;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.pulumirpc.CallResponse.ReturnDependencies>}
  */
-proto.pulumirpc.DiffRequest.prototype.getIgnorechangesList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 5));
+proto.pulumirpc.CallResponse.prototype.getReturndependenciesMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.pulumirpc.CallResponse.ReturnDependencies>} */ (
+      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
+      proto.pulumirpc.CallResponse.ReturnDependencies));
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.pulumirpc.DiffRequest} returns this
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.pulumirpc.CallResponse} returns this
  */
-proto.pulumirpc.DiffRequest.prototype.setIgnorechangesList = function(value) {
-  return jspb.Message.setField(this, 5, value || []);
+proto.pulumirpc.CallResponse.prototype.clearReturndependenciesMap = function() {
+  this.getReturndependenciesMap().clear();
+  return this;};
+
+
+/**
+ * repeated CheckFailure failures = 3;
+ * @return {!Array<!proto.pulumirpc.CheckFailure>}
+ */
+proto.pulumirpc.CallResponse.prototype.getFailuresList = function() {
+  return /** @type{!Array<!proto.pulumirpc.CheckFailure>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.pulumirpc.CheckFailure, 3));
 };
 
 
 /**
- * @param {string} value
+ * @param {!Array<!proto.pulumirpc.CheckFailure>} value
+ * @return {!proto.pulumirpc.CallResponse} returns this
+*/
+proto.pulumirpc.CallResponse.prototype.setFailuresList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.pulumirpc.CheckFailure=} opt_value
  * @param {number=} opt_index
- * @return {!proto.pulumirpc.DiffRequest} returns this
+ * @return {!proto.pulumirpc.CheckFailure}
  */
-proto.pulumirpc.DiffRequest.prototype.addIgnorechanges = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 5, value, opt_index);
+proto.pulumirpc.CallResponse.prototype.addFailures = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.pulumirpc.CheckFailure, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.pulumirpc.DiffRequest} returns this
+ * @return {!proto.pulumirpc.CallResponse} returns this
  */
-proto.pulumirpc.DiffRequest.prototype.clearIgnorechangesList = function() {
-  return this.setIgnorechangesList([]);
+proto.pulumirpc.CallResponse.prototype.clearFailuresList = function() {
+  return this.setFailuresList([]);
 };
 
 
@@ -3025,8 +3249,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pulumirpc.PropertyDiff.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.PropertyDiff.toObject(opt_includeInstance, this);
+proto.pulumirpc.CheckRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.CheckRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -3035,14 +3259,15 @@ proto.pulumirpc.PropertyDiff.prototype.toObject = function(opt_includeInstance)
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.PropertyDiff} msg The msg instance to transform.
+ * @param {!proto.pulumirpc.CheckRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.PropertyDiff.toObject = function(includeInstance, msg) {
+proto.pulumirpc.CheckRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    kind: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    inputdiff: jspb.Message.getBooleanFieldWithDefault(msg, 2, false)
+    urn: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    olds: (f = msg.getOlds()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
+    news: (f = msg.getNews()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -3056,23 +3281,23 @@ proto.pulumirpc.PropertyDiff.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.PropertyDiff}
+ * @return {!proto.pulumirpc.CheckRequest}
  */
-proto.pulumirpc.PropertyDiff.deserializeBinary = function(bytes) {
+proto.pulumirpc.CheckRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.PropertyDiff;
-  return proto.pulumirpc.PropertyDiff.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pulumirpc.CheckRequest;
+  return proto.pulumirpc.CheckRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pulumirpc.PropertyDiff} msg The message object to deserialize into.
+ * @param {!proto.pulumirpc.CheckRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.PropertyDiff}
+ * @return {!proto.pulumirpc.CheckRequest}
  */
-proto.pulumirpc.PropertyDiff.deserializeBinaryFromReader = function(msg, reader) {
+proto.pulumirpc.CheckRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3080,12 +3305,18 @@ proto.pulumirpc.PropertyDiff.deserializeBinaryFromReader = function(msg, reader)
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {!proto.pulumirpc.PropertyDiff.Kind} */ (reader.readEnum());
-      msg.setKind(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUrn(value);
       break;
     case 2:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setInputdiff(value);
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setOlds(value);
+      break;
+    case 3:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setNews(value);
       break;
     default:
       reader.skipField();
@@ -3100,9 +3331,9 @@ proto.pulumirpc.PropertyDiff.deserializeBinaryFromReader = function(msg, reader)
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pulumirpc.PropertyDiff.prototype.serializeBinary = function() {
+proto.pulumirpc.CheckRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.PropertyDiff.serializeBinaryToWriter(this, writer);
+  proto.pulumirpc.CheckRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3110,74 +3341,127 @@ proto.pulumirpc.PropertyDiff.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.PropertyDiff} message
+ * @param {!proto.pulumirpc.CheckRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.PropertyDiff.serializeBinaryToWriter = function(message, writer) {
+proto.pulumirpc.CheckRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getKind();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getUrn();
+  if (f.length > 0) {
+    writer.writeString(
       1,
       f
     );
   }
-  f = message.getInputdiff();
-  if (f) {
-    writer.writeBool(
+  f = message.getOlds();
+  if (f != null) {
+    writer.writeMessage(
       2,
-      f
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+  f = message.getNews();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * @enum {number}
- */
-proto.pulumirpc.PropertyDiff.Kind = {
-  ADD: 0,
-  ADD_REPLACE: 1,
-  DELETE: 2,
-  DELETE_REPLACE: 3,
-  UPDATE: 4,
-  UPDATE_REPLACE: 5
-};
-
-/**
- * optional Kind kind = 1;
- * @return {!proto.pulumirpc.PropertyDiff.Kind}
+ * optional string urn = 1;
+ * @return {string}
  */
-proto.pulumirpc.PropertyDiff.prototype.getKind = function() {
-  return /** @type {!proto.pulumirpc.PropertyDiff.Kind} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.pulumirpc.CheckRequest.prototype.getUrn = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {!proto.pulumirpc.PropertyDiff.Kind} value
- * @return {!proto.pulumirpc.PropertyDiff} returns this
+ * @param {string} value
+ * @return {!proto.pulumirpc.CheckRequest} returns this
  */
-proto.pulumirpc.PropertyDiff.prototype.setKind = function(value) {
-  return jspb.Message.setProto3EnumField(this, 1, value);
+proto.pulumirpc.CheckRequest.prototype.setUrn = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional bool inputDiff = 2;
+ * optional google.protobuf.Struct olds = 2;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.pulumirpc.CheckRequest.prototype.getOlds = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 2));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.pulumirpc.CheckRequest} returns this
+*/
+proto.pulumirpc.CheckRequest.prototype.setOlds = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.pulumirpc.CheckRequest} returns this
+ */
+proto.pulumirpc.CheckRequest.prototype.clearOlds = function() {
+  return this.setOlds(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
  * @return {boolean}
  */
-proto.pulumirpc.PropertyDiff.prototype.getInputdiff = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+proto.pulumirpc.CheckRequest.prototype.hasOlds = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.pulumirpc.PropertyDiff} returns this
+ * optional google.protobuf.Struct news = 3;
+ * @return {?proto.google.protobuf.Struct}
  */
-proto.pulumirpc.PropertyDiff.prototype.setInputdiff = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 2, value);
+proto.pulumirpc.CheckRequest.prototype.getNews = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 3));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.pulumirpc.CheckRequest} returns this
+*/
+proto.pulumirpc.CheckRequest.prototype.setNews = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.pulumirpc.CheckRequest} returns this
+ */
+proto.pulumirpc.CheckRequest.prototype.clearNews = function() {
+  return this.setNews(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.pulumirpc.CheckRequest.prototype.hasNews = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
 
@@ -3187,7 +3471,7 @@ proto.pulumirpc.PropertyDiff.prototype.setInputdiff = function(value) {
  * @private {!Array<number>}
  * @const
  */
-proto.pulumirpc.DiffResponse.repeatedFields_ = [1,2,5];
+proto.pulumirpc.CheckResponse.repeatedFields_ = [2];
 
 
 
@@ -3204,8 +3488,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pulumirpc.DiffResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.DiffResponse.toObject(opt_includeInstance, this);
+proto.pulumirpc.CheckResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.CheckResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -3214,19 +3498,15 @@ proto.pulumirpc.DiffResponse.prototype.toObject = function(opt_includeInstance)
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.DiffResponse} msg The msg instance to transform.
+ * @param {!proto.pulumirpc.CheckResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.DiffResponse.toObject = function(includeInstance, msg) {
+proto.pulumirpc.CheckResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    replacesList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
-    stablesList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
-    deletebeforereplace: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
-    changes: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    diffsList: (f = jspb.Message.getRepeatedField(msg, 5)) == null ? undefined : f,
-    detaileddiffMap: (f = msg.getDetaileddiffMap()) ? f.toObject(includeInstance, proto.pulumirpc.PropertyDiff.toObject) : [],
-    hasdetaileddiff: jspb.Message.getBooleanFieldWithDefault(msg, 7, false)
+    inputs: (f = msg.getInputs()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
+    failuresList: jspb.Message.toObjectList(msg.getFailuresList(),
+    proto.pulumirpc.CheckFailure.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -3240,23 +3520,23 @@ proto.pulumirpc.DiffResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.DiffResponse}
+ * @return {!proto.pulumirpc.CheckResponse}
  */
-proto.pulumirpc.DiffResponse.deserializeBinary = function(bytes) {
+proto.pulumirpc.CheckResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.DiffResponse;
-  return proto.pulumirpc.DiffResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pulumirpc.CheckResponse;
+  return proto.pulumirpc.CheckResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pulumirpc.DiffResponse} msg The message object to deserialize into.
+ * @param {!proto.pulumirpc.CheckResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.DiffResponse}
+ * @return {!proto.pulumirpc.CheckResponse}
  */
-proto.pulumirpc.DiffResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.pulumirpc.CheckResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3264,34 +3544,14 @@ proto.pulumirpc.DiffResponse.deserializeBinaryFromReader = function(msg, reader)
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addReplaces(value);
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setInputs(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addStables(value);
-      break;
-    case 3:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setDeletebeforereplace(value);
-      break;
-    case 4:
-      var value = /** @type {!proto.pulumirpc.DiffResponse.DiffChanges} */ (reader.readEnum());
-      msg.setChanges(value);
-      break;
-    case 5:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addDiffs(value);
-      break;
-    case 6:
-      var value = msg.getDetaileddiffMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.pulumirpc.PropertyDiff.deserializeBinaryFromReader, "", new proto.pulumirpc.PropertyDiff());
-         });
-      break;
-    case 7:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setHasdetaileddiff(value);
+      var value = new proto.pulumirpc.CheckFailure;
+      reader.readMessage(value,proto.pulumirpc.CheckFailure.deserializeBinaryFromReader);
+      msg.addFailures(value);
       break;
     default:
       reader.skipField();
@@ -3306,9 +3566,9 @@ proto.pulumirpc.DiffResponse.deserializeBinaryFromReader = function(msg, reader)
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pulumirpc.DiffResponse.prototype.serializeBinary = function() {
+proto.pulumirpc.CheckResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.DiffResponse.serializeBinaryToWriter(this, writer);
+  proto.pulumirpc.CheckResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3316,257 +3576,273 @@ proto.pulumirpc.DiffResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.DiffResponse} message
+ * @param {!proto.pulumirpc.CheckResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.DiffResponse.serializeBinaryToWriter = function(message, writer) {
+proto.pulumirpc.CheckResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getReplacesList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
+  f = message.getInputs();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
     );
   }
-  f = message.getStablesList();
+  f = message.getFailuresList();
   if (f.length > 0) {
-    writer.writeRepeatedString(
+    writer.writeRepeatedMessage(
       2,
-      f
-    );
-  }
-  f = message.getDeletebeforereplace();
-  if (f) {
-    writer.writeBool(
-      3,
-      f
-    );
-  }
-  f = message.getChanges();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      4,
-      f
-    );
-  }
-  f = message.getDiffsList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
-      5,
-      f
-    );
-  }
-  f = message.getDetaileddiffMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(6, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.pulumirpc.PropertyDiff.serializeBinaryToWriter);
-  }
-  f = message.getHasdetaileddiff();
-  if (f) {
-    writer.writeBool(
-      7,
-      f
+      f,
+      proto.pulumirpc.CheckFailure.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * @enum {number}
- */
-proto.pulumirpc.DiffResponse.DiffChanges = {
-  DIFF_UNKNOWN: 0,
-  DIFF_NONE: 1,
-  DIFF_SOME: 2
-};
-
-/**
- * repeated string replaces = 1;
- * @return {!Array<string>}
+ * optional google.protobuf.Struct inputs = 1;
+ * @return {?proto.google.protobuf.Struct}
  */
-proto.pulumirpc.DiffResponse.prototype.getReplacesList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+proto.pulumirpc.CheckResponse.prototype.getInputs = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 1));
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.pulumirpc.DiffResponse} returns this
- */
-proto.pulumirpc.DiffResponse.prototype.setReplacesList = function(value) {
-  return jspb.Message.setField(this, 1, value || []);
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.pulumirpc.CheckResponse} returns this
+*/
+proto.pulumirpc.CheckResponse.prototype.setInputs = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.pulumirpc.DiffResponse} returns this
+ * Clears the message field making it undefined.
+ * @return {!proto.pulumirpc.CheckResponse} returns this
  */
-proto.pulumirpc.DiffResponse.prototype.addReplaces = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+proto.pulumirpc.CheckResponse.prototype.clearInputs = function() {
+  return this.setInputs(undefined);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.pulumirpc.DiffResponse} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.pulumirpc.DiffResponse.prototype.clearReplacesList = function() {
-  return this.setReplacesList([]);
+proto.pulumirpc.CheckResponse.prototype.hasInputs = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 /**
- * repeated string stables = 2;
- * @return {!Array<string>}
+ * repeated CheckFailure failures = 2;
+ * @return {!Array<!proto.pulumirpc.CheckFailure>}
  */
-proto.pulumirpc.DiffResponse.prototype.getStablesList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
+proto.pulumirpc.CheckResponse.prototype.getFailuresList = function() {
+  return /** @type{!Array<!proto.pulumirpc.CheckFailure>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.pulumirpc.CheckFailure, 2));
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.pulumirpc.DiffResponse} returns this
- */
-proto.pulumirpc.DiffResponse.prototype.setStablesList = function(value) {
-  return jspb.Message.setField(this, 2, value || []);
+ * @param {!Array<!proto.pulumirpc.CheckFailure>} value
+ * @return {!proto.pulumirpc.CheckResponse} returns this
+*/
+proto.pulumirpc.CheckResponse.prototype.setFailuresList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
 };
 
 
 /**
- * @param {string} value
+ * @param {!proto.pulumirpc.CheckFailure=} opt_value
  * @param {number=} opt_index
- * @return {!proto.pulumirpc.DiffResponse} returns this
+ * @return {!proto.pulumirpc.CheckFailure}
  */
-proto.pulumirpc.DiffResponse.prototype.addStables = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+proto.pulumirpc.CheckResponse.prototype.addFailures = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.pulumirpc.CheckFailure, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.pulumirpc.DiffResponse} returns this
+ * @return {!proto.pulumirpc.CheckResponse} returns this
  */
-proto.pulumirpc.DiffResponse.prototype.clearStablesList = function() {
-  return this.setStablesList([]);
+proto.pulumirpc.CheckResponse.prototype.clearFailuresList = function() {
+  return this.setFailuresList([]);
 };
 
 
-/**
- * optional bool deleteBeforeReplace = 3;
- * @return {boolean}
- */
-proto.pulumirpc.DiffResponse.prototype.getDeletebeforereplace = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
-};
 
 
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * @param {boolean} value
- * @return {!proto.pulumirpc.DiffResponse} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.pulumirpc.DiffResponse.prototype.setDeletebeforereplace = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 3, value);
+proto.pulumirpc.CheckFailure.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.CheckFailure.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * optional DiffChanges changes = 4;
- * @return {!proto.pulumirpc.DiffResponse.DiffChanges}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pulumirpc.CheckFailure} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.DiffResponse.prototype.getChanges = function() {
-  return /** @type {!proto.pulumirpc.DiffResponse.DiffChanges} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.pulumirpc.CheckFailure.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    property: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    reason: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * @param {!proto.pulumirpc.DiffResponse.DiffChanges} value
- * @return {!proto.pulumirpc.DiffResponse} returns this
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pulumirpc.CheckFailure}
  */
-proto.pulumirpc.DiffResponse.prototype.setChanges = function(value) {
-  return jspb.Message.setProto3EnumField(this, 4, value);
+proto.pulumirpc.CheckFailure.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pulumirpc.CheckFailure;
+  return proto.pulumirpc.CheckFailure.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * repeated string diffs = 5;
- * @return {!Array<string>}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pulumirpc.CheckFailure} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pulumirpc.CheckFailure}
  */
-proto.pulumirpc.DiffResponse.prototype.getDiffsList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 5));
+proto.pulumirpc.CheckFailure.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setProperty(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setReason(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.pulumirpc.DiffResponse} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.pulumirpc.DiffResponse.prototype.setDiffsList = function(value) {
-  return jspb.Message.setField(this, 5, value || []);
+proto.pulumirpc.CheckFailure.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pulumirpc.CheckFailure.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.pulumirpc.DiffResponse} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pulumirpc.CheckFailure} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.DiffResponse.prototype.addDiffs = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 5, value, opt_index);
+proto.pulumirpc.CheckFailure.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getProperty();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getReason();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.pulumirpc.DiffResponse} returns this
+ * optional string property = 1;
+ * @return {string}
  */
-proto.pulumirpc.DiffResponse.prototype.clearDiffsList = function() {
-  return this.setDiffsList([]);
+proto.pulumirpc.CheckFailure.prototype.getProperty = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * map<string, PropertyDiff> detailedDiff = 6;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.pulumirpc.PropertyDiff>}
+ * @param {string} value
+ * @return {!proto.pulumirpc.CheckFailure} returns this
  */
-proto.pulumirpc.DiffResponse.prototype.getDetaileddiffMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.pulumirpc.PropertyDiff>} */ (
-      jspb.Message.getMapField(this, 6, opt_noLazyCreate,
-      proto.pulumirpc.PropertyDiff));
+proto.pulumirpc.CheckFailure.prototype.setProperty = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.pulumirpc.DiffResponse} returns this
+ * optional string reason = 2;
+ * @return {string}
  */
-proto.pulumirpc.DiffResponse.prototype.clearDetaileddiffMap = function() {
-  this.getDetaileddiffMap().clear();
-  return this;};
+proto.pulumirpc.CheckFailure.prototype.getReason = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
 
 
 /**
- * optional bool hasDetailedDiff = 7;
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.pulumirpc.CheckFailure} returns this
  */
-proto.pulumirpc.DiffResponse.prototype.getHasdetaileddiff = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 7, false));
+proto.pulumirpc.CheckFailure.prototype.setReason = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
+
 /**
- * @param {boolean} value
- * @return {!proto.pulumirpc.DiffResponse} returns this
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.pulumirpc.DiffResponse.prototype.setHasdetaileddiff = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 7, value);
-};
-
-
+proto.pulumirpc.DiffRequest.repeatedFields_ = [5];
 
 
 
@@ -3583,8 +3859,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pulumirpc.CreateRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.CreateRequest.toObject(opt_includeInstance, this);
+proto.pulumirpc.DiffRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.DiffRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -3593,16 +3869,17 @@ proto.pulumirpc.CreateRequest.prototype.toObject = function(opt_includeInstance)
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.CreateRequest} msg The msg instance to transform.
+ * @param {!proto.pulumirpc.DiffRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.CreateRequest.toObject = function(includeInstance, msg) {
+proto.pulumirpc.DiffRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    urn: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    properties: (f = msg.getProperties()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
-    timeout: jspb.Message.getFloatingPointFieldWithDefault(msg, 3, 0.0),
-    preview: jspb.Message.getBooleanFieldWithDefault(msg, 4, false)
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    urn: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    olds: (f = msg.getOlds()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
+    news: (f = msg.getNews()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
+    ignorechangesList: (f = jspb.Message.getRepeatedField(msg, 5)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -3616,23 +3893,23 @@ proto.pulumirpc.CreateRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.CreateRequest}
+ * @return {!proto.pulumirpc.DiffRequest}
  */
-proto.pulumirpc.CreateRequest.deserializeBinary = function(bytes) {
+proto.pulumirpc.DiffRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.CreateRequest;
-  return proto.pulumirpc.CreateRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pulumirpc.DiffRequest;
+  return proto.pulumirpc.DiffRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pulumirpc.CreateRequest} msg The message object to deserialize into.
+ * @param {!proto.pulumirpc.DiffRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.CreateRequest}
+ * @return {!proto.pulumirpc.DiffRequest}
  */
-proto.pulumirpc.CreateRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.pulumirpc.DiffRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3641,20 +3918,25 @@ proto.pulumirpc.CreateRequest.deserializeBinaryFromReader = function(msg, reader
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setUrn(value);
+      msg.setId(value);
       break;
     case 2:
-      var value = new google_protobuf_struct_pb.Struct;
-      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setProperties(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUrn(value);
       break;
     case 3:
-      var value = /** @type {number} */ (reader.readDouble());
-      msg.setTimeout(value);
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setOlds(value);
       break;
     case 4:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setPreview(value);
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setNews(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addIgnorechanges(value);
       break;
     default:
       reader.skipField();
@@ -3669,9 +3951,9 @@ proto.pulumirpc.CreateRequest.deserializeBinaryFromReader = function(msg, reader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pulumirpc.CreateRequest.prototype.serializeBinary = function() {
+proto.pulumirpc.DiffRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.CreateRequest.serializeBinaryToWriter(this, writer);
+  proto.pulumirpc.DiffRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3679,38 +3961,46 @@ proto.pulumirpc.CreateRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.CreateRequest} message
+ * @param {!proto.pulumirpc.DiffRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.CreateRequest.serializeBinaryToWriter = function(message, writer) {
+proto.pulumirpc.DiffRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUrn();
+  f = message.getId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getProperties();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getUrn();
+  if (f.length > 0) {
+    writer.writeString(
       2,
-      f,
-      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getTimeout();
-  if (f !== 0.0) {
-    writer.writeDouble(
+  f = message.getOlds();
+  if (f != null) {
+    writer.writeMessage(
       3,
-      f
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
     );
   }
-  f = message.getPreview();
-  if (f) {
-    writer.writeBool(
+  f = message.getNews();
+  if (f != null) {
+    writer.writeMessage(
       4,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+  f = message.getIgnorechangesList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      5,
       f
     );
   }
@@ -3718,48 +4008,66 @@ proto.pulumirpc.CreateRequest.serializeBinaryToWriter = function(message, writer
 
 
 /**
- * optional string urn = 1;
+ * optional string id = 1;
  * @return {string}
  */
-proto.pulumirpc.CreateRequest.prototype.getUrn = function() {
+proto.pulumirpc.DiffRequest.prototype.getId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.pulumirpc.CreateRequest} returns this
+ * @return {!proto.pulumirpc.DiffRequest} returns this
  */
-proto.pulumirpc.CreateRequest.prototype.setUrn = function(value) {
+proto.pulumirpc.DiffRequest.prototype.setId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional google.protobuf.Struct properties = 2;
+ * optional string urn = 2;
+ * @return {string}
+ */
+proto.pulumirpc.DiffRequest.prototype.getUrn = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.DiffRequest} returns this
+ */
+proto.pulumirpc.DiffRequest.prototype.setUrn = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional google.protobuf.Struct olds = 3;
  * @return {?proto.google.protobuf.Struct}
  */
-proto.pulumirpc.CreateRequest.prototype.getProperties = function() {
+proto.pulumirpc.DiffRequest.prototype.getOlds = function() {
   return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 2));
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 3));
 };
 
 
 /**
  * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.pulumirpc.CreateRequest} returns this
+ * @return {!proto.pulumirpc.DiffRequest} returns this
 */
-proto.pulumirpc.CreateRequest.prototype.setProperties = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
+proto.pulumirpc.DiffRequest.prototype.setOlds = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.pulumirpc.CreateRequest} returns this
+ * @return {!proto.pulumirpc.DiffRequest} returns this
  */
-proto.pulumirpc.CreateRequest.prototype.clearProperties = function() {
-  return this.setProperties(undefined);
+proto.pulumirpc.DiffRequest.prototype.clearOlds = function() {
+  return this.setOlds(undefined);
 };
 
 
@@ -3767,44 +4075,82 @@ proto.pulumirpc.CreateRequest.prototype.clearProperties = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.pulumirpc.CreateRequest.prototype.hasProperties = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.pulumirpc.DiffRequest.prototype.hasOlds = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
 
 /**
- * optional double timeout = 3;
- * @return {number}
+ * optional google.protobuf.Struct news = 4;
+ * @return {?proto.google.protobuf.Struct}
  */
-proto.pulumirpc.CreateRequest.prototype.getTimeout = function() {
-  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 3, 0.0));
+proto.pulumirpc.DiffRequest.prototype.getNews = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 4));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.pulumirpc.CreateRequest} returns this
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.pulumirpc.DiffRequest} returns this
+*/
+proto.pulumirpc.DiffRequest.prototype.setNews = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.pulumirpc.DiffRequest} returns this
  */
-proto.pulumirpc.CreateRequest.prototype.setTimeout = function(value) {
-  return jspb.Message.setProto3FloatField(this, 3, value);
+proto.pulumirpc.DiffRequest.prototype.clearNews = function() {
+  return this.setNews(undefined);
 };
 
 
 /**
- * optional bool preview = 4;
+ * Returns whether this field is set.
  * @return {boolean}
  */
-proto.pulumirpc.CreateRequest.prototype.getPreview = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+proto.pulumirpc.DiffRequest.prototype.hasNews = function() {
+  return jspb.Message.getField(this, 4) != null;
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.pulumirpc.CreateRequest} returns this
+ * repeated string ignoreChanges = 5;
+ * @return {!Array<string>}
  */
-proto.pulumirpc.CreateRequest.prototype.setPreview = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 4, value);
+proto.pulumirpc.DiffRequest.prototype.getIgnorechangesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 5));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.pulumirpc.DiffRequest} returns this
+ */
+proto.pulumirpc.DiffRequest.prototype.setIgnorechangesList = function(value) {
+  return jspb.Message.setField(this, 5, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.pulumirpc.DiffRequest} returns this
+ */
+proto.pulumirpc.DiffRequest.prototype.addIgnorechanges = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 5, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pulumirpc.DiffRequest} returns this
+ */
+proto.pulumirpc.DiffRequest.prototype.clearIgnorechangesList = function() {
+  return this.setIgnorechangesList([]);
 };
 
 
@@ -3824,8 +4170,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pulumirpc.CreateResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.CreateResponse.toObject(opt_includeInstance, this);
+proto.pulumirpc.PropertyDiff.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.PropertyDiff.toObject(opt_includeInstance, this);
 };
 
 
@@ -3834,14 +4180,14 @@ proto.pulumirpc.CreateResponse.prototype.toObject = function(opt_includeInstance
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.CreateResponse} msg The msg instance to transform.
+ * @param {!proto.pulumirpc.PropertyDiff} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.CreateResponse.toObject = function(includeInstance, msg) {
+proto.pulumirpc.PropertyDiff.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    properties: (f = msg.getProperties()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+    kind: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    inputdiff: jspb.Message.getBooleanFieldWithDefault(msg, 2, false)
   };
 
   if (includeInstance) {
@@ -3855,23 +4201,23 @@ proto.pulumirpc.CreateResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.CreateResponse}
+ * @return {!proto.pulumirpc.PropertyDiff}
  */
-proto.pulumirpc.CreateResponse.deserializeBinary = function(bytes) {
+proto.pulumirpc.PropertyDiff.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.CreateResponse;
-  return proto.pulumirpc.CreateResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pulumirpc.PropertyDiff;
+  return proto.pulumirpc.PropertyDiff.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pulumirpc.CreateResponse} msg The message object to deserialize into.
+ * @param {!proto.pulumirpc.PropertyDiff} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.CreateResponse}
+ * @return {!proto.pulumirpc.PropertyDiff}
  */
-proto.pulumirpc.CreateResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.pulumirpc.PropertyDiff.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3879,13 +4225,12 @@ proto.pulumirpc.CreateResponse.deserializeBinaryFromReader = function(msg, reade
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
+      var value = /** @type {!proto.pulumirpc.PropertyDiff.Kind} */ (reader.readEnum());
+      msg.setKind(value);
       break;
     case 2:
-      var value = new google_protobuf_struct_pb.Struct;
-      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setProperties(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setInputdiff(value);
       break;
     default:
       reader.skipField();
@@ -3900,9 +4245,9 @@ proto.pulumirpc.CreateResponse.deserializeBinaryFromReader = function(msg, reade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pulumirpc.CreateResponse.prototype.serializeBinary = function() {
+proto.pulumirpc.PropertyDiff.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.CreateResponse.serializeBinaryToWriter(this, writer);
+  proto.pulumirpc.PropertyDiff.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3910,88 +4255,87 @@ proto.pulumirpc.CreateResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.CreateResponse} message
+ * @param {!proto.pulumirpc.PropertyDiff} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.CreateResponse.serializeBinaryToWriter = function(message, writer) {
+proto.pulumirpc.PropertyDiff.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getKind();
+  if (f !== 0.0) {
+    writer.writeEnum(
       1,
       f
     );
   }
-  f = message.getProperties();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getInputdiff();
+  if (f) {
+    writer.writeBool(
       2,
-      f,
-      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+      f
     );
   }
 };
 
 
 /**
- * optional string id = 1;
- * @return {string}
+ * @enum {number}
  */
-proto.pulumirpc.CreateResponse.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.pulumirpc.PropertyDiff.Kind = {
+  ADD: 0,
+  ADD_REPLACE: 1,
+  DELETE: 2,
+  DELETE_REPLACE: 3,
+  UPDATE: 4,
+  UPDATE_REPLACE: 5
 };
 
-
 /**
- * @param {string} value
- * @return {!proto.pulumirpc.CreateResponse} returns this
+ * optional Kind kind = 1;
+ * @return {!proto.pulumirpc.PropertyDiff.Kind}
  */
-proto.pulumirpc.CreateResponse.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.pulumirpc.PropertyDiff.prototype.getKind = function() {
+  return /** @type {!proto.pulumirpc.PropertyDiff.Kind} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * optional google.protobuf.Struct properties = 2;
- * @return {?proto.google.protobuf.Struct}
+ * @param {!proto.pulumirpc.PropertyDiff.Kind} value
+ * @return {!proto.pulumirpc.PropertyDiff} returns this
  */
-proto.pulumirpc.CreateResponse.prototype.getProperties = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 2));
-};
-
-
-/**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.pulumirpc.CreateResponse} returns this
-*/
-proto.pulumirpc.CreateResponse.prototype.setProperties = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
+proto.pulumirpc.PropertyDiff.prototype.setKind = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.pulumirpc.CreateResponse} returns this
+ * optional bool inputDiff = 2;
+ * @return {boolean}
  */
-proto.pulumirpc.CreateResponse.prototype.clearProperties = function() {
-  return this.setProperties(undefined);
+proto.pulumirpc.PropertyDiff.prototype.getInputdiff = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {boolean} value
+ * @return {!proto.pulumirpc.PropertyDiff} returns this
  */
-proto.pulumirpc.CreateResponse.prototype.hasProperties = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.pulumirpc.PropertyDiff.prototype.setInputdiff = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
 };
 
 
 
-
-
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.pulumirpc.DiffResponse.repeatedFields_ = [1,2,5];
+
+
+
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
  * Creates an object representation of this proto.
@@ -4005,8 +4349,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pulumirpc.ReadRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.ReadRequest.toObject(opt_includeInstance, this);
+proto.pulumirpc.DiffResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.DiffResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -4015,16 +4359,19 @@ proto.pulumirpc.ReadRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.ReadRequest} msg The msg instance to transform.
+ * @param {!proto.pulumirpc.DiffResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.ReadRequest.toObject = function(includeInstance, msg) {
+proto.pulumirpc.DiffResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    urn: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    properties: (f = msg.getProperties()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
-    inputs: (f = msg.getInputs()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+    replacesList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
+    stablesList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
+    deletebeforereplace: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    changes: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    diffsList: (f = jspb.Message.getRepeatedField(msg, 5)) == null ? undefined : f,
+    detaileddiffMap: (f = msg.getDetaileddiffMap()) ? f.toObject(includeInstance, proto.pulumirpc.PropertyDiff.toObject) : [],
+    hasdetaileddiff: jspb.Message.getBooleanFieldWithDefault(msg, 7, false)
   };
 
   if (includeInstance) {
@@ -4038,23 +4385,23 @@ proto.pulumirpc.ReadRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.ReadRequest}
+ * @return {!proto.pulumirpc.DiffResponse}
  */
-proto.pulumirpc.ReadRequest.deserializeBinary = function(bytes) {
+proto.pulumirpc.DiffResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.ReadRequest;
-  return proto.pulumirpc.ReadRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pulumirpc.DiffResponse;
+  return proto.pulumirpc.DiffResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pulumirpc.ReadRequest} msg The message object to deserialize into.
+ * @param {!proto.pulumirpc.DiffResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.ReadRequest}
+ * @return {!proto.pulumirpc.DiffResponse}
  */
-proto.pulumirpc.ReadRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.pulumirpc.DiffResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4063,21 +4410,33 @@ proto.pulumirpc.ReadRequest.deserializeBinaryFromReader = function(msg, reader)
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
+      msg.addReplaces(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setUrn(value);
+      msg.addStables(value);
       break;
     case 3:
-      var value = new google_protobuf_struct_pb.Struct;
-      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setProperties(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setDeletebeforereplace(value);
       break;
     case 4:
-      var value = new google_protobuf_struct_pb.Struct;
-      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setInputs(value);
+      var value = /** @type {!proto.pulumirpc.DiffResponse.DiffChanges} */ (reader.readEnum());
+      msg.setChanges(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addDiffs(value);
+      break;
+    case 6:
+      var value = msg.getDetaileddiffMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.pulumirpc.PropertyDiff.deserializeBinaryFromReader, "", new proto.pulumirpc.PropertyDiff());
+         });
+      break;
+    case 7:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setHasdetaileddiff(value);
       break;
     default:
       reader.skipField();
@@ -4092,9 +4451,9 @@ proto.pulumirpc.ReadRequest.deserializeBinaryFromReader = function(msg, reader)
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pulumirpc.ReadRequest.prototype.serializeBinary = function() {
+proto.pulumirpc.DiffResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.ReadRequest.serializeBinaryToWriter(this, writer);
+  proto.pulumirpc.DiffResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4102,190 +4461,293 @@ proto.pulumirpc.ReadRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.ReadRequest} message
+ * @param {!proto.pulumirpc.DiffResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.ReadRequest.serializeBinaryToWriter = function(message, writer) {
+proto.pulumirpc.DiffResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getReplacesList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedString(
       1,
       f
     );
   }
-  f = message.getUrn();
+  f = message.getStablesList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedString(
       2,
       f
     );
   }
-  f = message.getProperties();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getDeletebeforereplace();
+  if (f) {
+    writer.writeBool(
       3,
-      f,
-      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getInputs();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getChanges();
+  if (f !== 0.0) {
+    writer.writeEnum(
       4,
-      f,
-      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getDiffsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      5,
+      f
+    );
+  }
+  f = message.getDetaileddiffMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(6, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.pulumirpc.PropertyDiff.serializeBinaryToWriter);
+  }
+  f = message.getHasdetaileddiff();
+  if (f) {
+    writer.writeBool(
+      7,
+      f
     );
   }
 };
 
 
 /**
- * optional string id = 1;
- * @return {string}
+ * @enum {number}
  */
-proto.pulumirpc.ReadRequest.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.pulumirpc.DiffResponse.DiffChanges = {
+  DIFF_UNKNOWN: 0,
+  DIFF_NONE: 1,
+  DIFF_SOME: 2
 };
 
-
 /**
- * @param {string} value
- * @return {!proto.pulumirpc.ReadRequest} returns this
+ * repeated string replaces = 1;
+ * @return {!Array<string>}
  */
-proto.pulumirpc.ReadRequest.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.pulumirpc.DiffResponse.prototype.getReplacesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
 };
 
 
 /**
- * optional string urn = 2;
- * @return {string}
+ * @param {!Array<string>} value
+ * @return {!proto.pulumirpc.DiffResponse} returns this
  */
-proto.pulumirpc.ReadRequest.prototype.getUrn = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.pulumirpc.DiffResponse.prototype.setReplacesList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.pulumirpc.ReadRequest} returns this
+ * @param {number=} opt_index
+ * @return {!proto.pulumirpc.DiffResponse} returns this
  */
-proto.pulumirpc.ReadRequest.prototype.setUrn = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.pulumirpc.DiffResponse.prototype.addReplaces = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
 };
 
 
 /**
- * optional google.protobuf.Struct properties = 3;
- * @return {?proto.google.protobuf.Struct}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pulumirpc.DiffResponse} returns this
  */
-proto.pulumirpc.ReadRequest.prototype.getProperties = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 3));
+proto.pulumirpc.DiffResponse.prototype.clearReplacesList = function() {
+  return this.setReplacesList([]);
 };
 
 
 /**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.pulumirpc.ReadRequest} returns this
-*/
-proto.pulumirpc.ReadRequest.prototype.setProperties = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
+ * repeated string stables = 2;
+ * @return {!Array<string>}
+ */
+proto.pulumirpc.DiffResponse.prototype.getStablesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.pulumirpc.ReadRequest} returns this
+ * @param {!Array<string>} value
+ * @return {!proto.pulumirpc.DiffResponse} returns this
  */
-proto.pulumirpc.ReadRequest.prototype.clearProperties = function() {
-  return this.setProperties(undefined);
+proto.pulumirpc.DiffResponse.prototype.setStablesList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.pulumirpc.DiffResponse} returns this
  */
-proto.pulumirpc.ReadRequest.prototype.hasProperties = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.pulumirpc.DiffResponse.prototype.addStables = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
 };
 
 
 /**
- * optional google.protobuf.Struct inputs = 4;
- * @return {?proto.google.protobuf.Struct}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pulumirpc.DiffResponse} returns this
  */
-proto.pulumirpc.ReadRequest.prototype.getInputs = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 4));
+proto.pulumirpc.DiffResponse.prototype.clearStablesList = function() {
+  return this.setStablesList([]);
 };
 
 
 /**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.pulumirpc.ReadRequest} returns this
-*/
-proto.pulumirpc.ReadRequest.prototype.setInputs = function(value) {
-  return jspb.Message.setWrapperField(this, 4, value);
+ * optional bool deleteBeforeReplace = 3;
+ * @return {boolean}
+ */
+proto.pulumirpc.DiffResponse.prototype.getDeletebeforereplace = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.pulumirpc.ReadRequest} returns this
+ * @param {boolean} value
+ * @return {!proto.pulumirpc.DiffResponse} returns this
  */
-proto.pulumirpc.ReadRequest.prototype.clearInputs = function() {
-  return this.setInputs(undefined);
+proto.pulumirpc.DiffResponse.prototype.setDeletebeforereplace = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * optional DiffChanges changes = 4;
+ * @return {!proto.pulumirpc.DiffResponse.DiffChanges}
  */
-proto.pulumirpc.ReadRequest.prototype.hasInputs = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.pulumirpc.DiffResponse.prototype.getChanges = function() {
+  return /** @type {!proto.pulumirpc.DiffResponse.DiffChanges} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
+/**
+ * @param {!proto.pulumirpc.DiffResponse.DiffChanges} value
+ * @return {!proto.pulumirpc.DiffResponse} returns this
+ */
+proto.pulumirpc.DiffResponse.prototype.setChanges = function(value) {
+  return jspb.Message.setProto3EnumField(this, 4, value);
+};
 
 
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * repeated string diffs = 5;
+ * @return {!Array<string>}
  */
-proto.pulumirpc.ReadResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.ReadResponse.toObject(opt_includeInstance, this);
+proto.pulumirpc.DiffResponse.prototype.getDiffsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 5));
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.ReadResponse} msg The msg instance to transform.
+ * @param {!Array<string>} value
+ * @return {!proto.pulumirpc.DiffResponse} returns this
+ */
+proto.pulumirpc.DiffResponse.prototype.setDiffsList = function(value) {
+  return jspb.Message.setField(this, 5, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.pulumirpc.DiffResponse} returns this
+ */
+proto.pulumirpc.DiffResponse.prototype.addDiffs = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 5, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pulumirpc.DiffResponse} returns this
+ */
+proto.pulumirpc.DiffResponse.prototype.clearDiffsList = function() {
+  return this.setDiffsList([]);
+};
+
+
+/**
+ * map<string, PropertyDiff> detailedDiff = 6;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.pulumirpc.PropertyDiff>}
+ */
+proto.pulumirpc.DiffResponse.prototype.getDetaileddiffMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.pulumirpc.PropertyDiff>} */ (
+      jspb.Message.getMapField(this, 6, opt_noLazyCreate,
+      proto.pulumirpc.PropertyDiff));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.pulumirpc.DiffResponse} returns this
+ */
+proto.pulumirpc.DiffResponse.prototype.clearDetaileddiffMap = function() {
+  this.getDetaileddiffMap().clear();
+  return this;};
+
+
+/**
+ * optional bool hasDetailedDiff = 7;
+ * @return {boolean}
+ */
+proto.pulumirpc.DiffResponse.prototype.getHasdetaileddiff = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 7, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.pulumirpc.DiffResponse} returns this
+ */
+proto.pulumirpc.DiffResponse.prototype.setHasdetaileddiff = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 7, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pulumirpc.CreateRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.CreateRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pulumirpc.CreateRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.ReadResponse.toObject = function(includeInstance, msg) {
+proto.pulumirpc.CreateRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    urn: jspb.Message.getFieldWithDefault(msg, 1, ""),
     properties: (f = msg.getProperties()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
-    inputs: (f = msg.getInputs()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+    timeout: jspb.Message.getFloatingPointFieldWithDefault(msg, 3, 0.0),
+    preview: jspb.Message.getBooleanFieldWithDefault(msg, 4, false)
   };
 
   if (includeInstance) {
@@ -4299,23 +4761,23 @@ proto.pulumirpc.ReadResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.ReadResponse}
+ * @return {!proto.pulumirpc.CreateRequest}
  */
-proto.pulumirpc.ReadResponse.deserializeBinary = function(bytes) {
+proto.pulumirpc.CreateRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.ReadResponse;
-  return proto.pulumirpc.ReadResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pulumirpc.CreateRequest;
+  return proto.pulumirpc.CreateRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pulumirpc.ReadResponse} msg The message object to deserialize into.
+ * @param {!proto.pulumirpc.CreateRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.ReadResponse}
+ * @return {!proto.pulumirpc.CreateRequest}
  */
-proto.pulumirpc.ReadResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.pulumirpc.CreateRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4324,7 +4786,7 @@ proto.pulumirpc.ReadResponse.deserializeBinaryFromReader = function(msg, reader)
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
+      msg.setUrn(value);
       break;
     case 2:
       var value = new google_protobuf_struct_pb.Struct;
@@ -4332,9 +4794,12 @@ proto.pulumirpc.ReadResponse.deserializeBinaryFromReader = function(msg, reader)
       msg.setProperties(value);
       break;
     case 3:
-      var value = new google_protobuf_struct_pb.Struct;
-      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setInputs(value);
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setTimeout(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setPreview(value);
       break;
     default:
       reader.skipField();
@@ -4349,9 +4814,9 @@ proto.pulumirpc.ReadResponse.deserializeBinaryFromReader = function(msg, reader)
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pulumirpc.ReadResponse.prototype.serializeBinary = function() {
+proto.pulumirpc.CreateRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.ReadResponse.serializeBinaryToWriter(this, writer);
+  proto.pulumirpc.CreateRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4359,13 +4824,13 @@ proto.pulumirpc.ReadResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.ReadResponse} message
+ * @param {!proto.pulumirpc.CreateRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.ReadResponse.serializeBinaryToWriter = function(message, writer) {
+proto.pulumirpc.CreateRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getUrn();
   if (f.length > 0) {
     writer.writeString(
       1,
@@ -4380,31 +4845,37 @@ proto.pulumirpc.ReadResponse.serializeBinaryToWriter = function(message, writer)
       google_protobuf_struct_pb.Struct.serializeBinaryToWriter
     );
   }
-  f = message.getInputs();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getTimeout();
+  if (f !== 0.0) {
+    writer.writeDouble(
       3,
-      f,
-      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getPreview();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
     );
   }
 };
 
 
 /**
- * optional string id = 1;
+ * optional string urn = 1;
  * @return {string}
  */
-proto.pulumirpc.ReadResponse.prototype.getId = function() {
+proto.pulumirpc.CreateRequest.prototype.getUrn = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.pulumirpc.ReadResponse} returns this
+ * @return {!proto.pulumirpc.CreateRequest} returns this
  */
-proto.pulumirpc.ReadResponse.prototype.setId = function(value) {
+proto.pulumirpc.CreateRequest.prototype.setUrn = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
@@ -4413,7 +4884,7 @@ proto.pulumirpc.ReadResponse.prototype.setId = function(value) {
  * optional google.protobuf.Struct properties = 2;
  * @return {?proto.google.protobuf.Struct}
  */
-proto.pulumirpc.ReadResponse.prototype.getProperties = function() {
+proto.pulumirpc.CreateRequest.prototype.getProperties = function() {
   return /** @type{?proto.google.protobuf.Struct} */ (
     jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 2));
 };
@@ -4421,18 +4892,18 @@ proto.pulumirpc.ReadResponse.prototype.getProperties = function() {
 
 /**
  * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.pulumirpc.ReadResponse} returns this
+ * @return {!proto.pulumirpc.CreateRequest} returns this
 */
-proto.pulumirpc.ReadResponse.prototype.setProperties = function(value) {
+proto.pulumirpc.CreateRequest.prototype.setProperties = function(value) {
   return jspb.Message.setWrapperField(this, 2, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.pulumirpc.ReadResponse} returns this
+ * @return {!proto.pulumirpc.CreateRequest} returns this
  */
-proto.pulumirpc.ReadResponse.prototype.clearProperties = function() {
+proto.pulumirpc.CreateRequest.prototype.clearProperties = function() {
   return this.setProperties(undefined);
 };
 
@@ -4441,56 +4912,48 @@ proto.pulumirpc.ReadResponse.prototype.clearProperties = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.pulumirpc.ReadResponse.prototype.hasProperties = function() {
+proto.pulumirpc.CreateRequest.prototype.hasProperties = function() {
   return jspb.Message.getField(this, 2) != null;
 };
 
 
 /**
- * optional google.protobuf.Struct inputs = 3;
- * @return {?proto.google.protobuf.Struct}
+ * optional double timeout = 3;
+ * @return {number}
  */
-proto.pulumirpc.ReadResponse.prototype.getInputs = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 3));
+proto.pulumirpc.CreateRequest.prototype.getTimeout = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 3, 0.0));
 };
 
 
 /**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.pulumirpc.ReadResponse} returns this
-*/
-proto.pulumirpc.ReadResponse.prototype.setInputs = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
+ * @param {number} value
+ * @return {!proto.pulumirpc.CreateRequest} returns this
+ */
+proto.pulumirpc.CreateRequest.prototype.setTimeout = function(value) {
+  return jspb.Message.setProto3FloatField(this, 3, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.pulumirpc.ReadResponse} returns this
+ * optional bool preview = 4;
+ * @return {boolean}
  */
-proto.pulumirpc.ReadResponse.prototype.clearInputs = function() {
-  return this.setInputs(undefined);
+proto.pulumirpc.CreateRequest.prototype.getPreview = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {boolean} value
+ * @return {!proto.pulumirpc.CreateRequest} returns this
  */
-proto.pulumirpc.ReadResponse.prototype.hasInputs = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.pulumirpc.CreateRequest.prototype.setPreview = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.pulumirpc.UpdateRequest.repeatedFields_ = [6];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -4506,8 +4969,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pulumirpc.UpdateRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.UpdateRequest.toObject(opt_includeInstance, this);
+proto.pulumirpc.CreateResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.CreateResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -4516,19 +4979,14 @@ proto.pulumirpc.UpdateRequest.prototype.toObject = function(opt_includeInstance)
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.UpdateRequest} msg The msg instance to transform.
+ * @param {!proto.pulumirpc.CreateResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.UpdateRequest.toObject = function(includeInstance, msg) {
+proto.pulumirpc.CreateResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    urn: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    olds: (f = msg.getOlds()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
-    news: (f = msg.getNews()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
-    timeout: jspb.Message.getFloatingPointFieldWithDefault(msg, 5, 0.0),
-    ignorechangesList: (f = jspb.Message.getRepeatedField(msg, 6)) == null ? undefined : f,
-    preview: jspb.Message.getBooleanFieldWithDefault(msg, 7, false)
+    properties: (f = msg.getProperties()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -4542,23 +5000,23 @@ proto.pulumirpc.UpdateRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.UpdateRequest}
+ * @return {!proto.pulumirpc.CreateResponse}
  */
-proto.pulumirpc.UpdateRequest.deserializeBinary = function(bytes) {
+proto.pulumirpc.CreateResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.UpdateRequest;
-  return proto.pulumirpc.UpdateRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pulumirpc.CreateResponse;
+  return proto.pulumirpc.CreateResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pulumirpc.UpdateRequest} msg The message object to deserialize into.
+ * @param {!proto.pulumirpc.CreateResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.UpdateRequest}
+ * @return {!proto.pulumirpc.CreateResponse}
  */
-proto.pulumirpc.UpdateRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.pulumirpc.CreateResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4570,33 +5028,12 @@ proto.pulumirpc.UpdateRequest.deserializeBinaryFromReader = function(msg, reader
       msg.setId(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setUrn(value);
-      break;
-    case 3:
       var value = new google_protobuf_struct_pb.Struct;
       reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setOlds(value);
+      msg.setProperties(value);
       break;
-    case 4:
-      var value = new google_protobuf_struct_pb.Struct;
-      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setNews(value);
-      break;
-    case 5:
-      var value = /** @type {number} */ (reader.readDouble());
-      msg.setTimeout(value);
-      break;
-    case 6:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addIgnorechanges(value);
-      break;
-    case 7:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setPreview(value);
-      break;
-    default:
-      reader.skipField();
+    default:
+      reader.skipField();
       break;
     }
   }
@@ -4608,9 +5045,9 @@ proto.pulumirpc.UpdateRequest.deserializeBinaryFromReader = function(msg, reader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pulumirpc.UpdateRequest.prototype.serializeBinary = function() {
+proto.pulumirpc.CreateResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.UpdateRequest.serializeBinaryToWriter(this, writer);
+  proto.pulumirpc.CreateResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4618,11 +5055,11 @@ proto.pulumirpc.UpdateRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.UpdateRequest} message
+ * @param {!proto.pulumirpc.CreateResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.UpdateRequest.serializeBinaryToWriter = function(message, writer) {
+proto.pulumirpc.CreateResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f.length > 0) {
@@ -4631,50 +5068,14 @@ proto.pulumirpc.UpdateRequest.serializeBinaryToWriter = function(message, writer
       f
     );
   }
-  f = message.getUrn();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getOlds();
-  if (f != null) {
-    writer.writeMessage(
-      3,
-      f,
-      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
-    );
-  }
-  f = message.getNews();
+  f = message.getProperties();
   if (f != null) {
     writer.writeMessage(
-      4,
+      2,
       f,
       google_protobuf_struct_pb.Struct.serializeBinaryToWriter
     );
   }
-  f = message.getTimeout();
-  if (f !== 0.0) {
-    writer.writeDouble(
-      5,
-      f
-    );
-  }
-  f = message.getIgnorechangesList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
-      6,
-      f
-    );
-  }
-  f = message.getPreview();
-  if (f) {
-    writer.writeBool(
-      7,
-      f
-    );
-  }
 };
 
 
@@ -4682,100 +5083,45 @@ proto.pulumirpc.UpdateRequest.serializeBinaryToWriter = function(message, writer
  * optional string id = 1;
  * @return {string}
  */
-proto.pulumirpc.UpdateRequest.prototype.getId = function() {
+proto.pulumirpc.CreateResponse.prototype.getId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.pulumirpc.UpdateRequest} returns this
+ * @return {!proto.pulumirpc.CreateResponse} returns this
  */
-proto.pulumirpc.UpdateRequest.prototype.setId = function(value) {
+proto.pulumirpc.CreateResponse.prototype.setId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string urn = 2;
- * @return {string}
- */
-proto.pulumirpc.UpdateRequest.prototype.getUrn = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.pulumirpc.UpdateRequest} returns this
- */
-proto.pulumirpc.UpdateRequest.prototype.setUrn = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
-};
-
-
-/**
- * optional google.protobuf.Struct olds = 3;
- * @return {?proto.google.protobuf.Struct}
- */
-proto.pulumirpc.UpdateRequest.prototype.getOlds = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 3));
-};
-
-
-/**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.pulumirpc.UpdateRequest} returns this
-*/
-proto.pulumirpc.UpdateRequest.prototype.setOlds = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.pulumirpc.UpdateRequest} returns this
- */
-proto.pulumirpc.UpdateRequest.prototype.clearOlds = function() {
-  return this.setOlds(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.pulumirpc.UpdateRequest.prototype.hasOlds = function() {
-  return jspb.Message.getField(this, 3) != null;
-};
-
-
-/**
- * optional google.protobuf.Struct news = 4;
+ * optional google.protobuf.Struct properties = 2;
  * @return {?proto.google.protobuf.Struct}
  */
-proto.pulumirpc.UpdateRequest.prototype.getNews = function() {
+proto.pulumirpc.CreateResponse.prototype.getProperties = function() {
   return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 4));
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 2));
 };
 
 
 /**
  * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.pulumirpc.UpdateRequest} returns this
+ * @return {!proto.pulumirpc.CreateResponse} returns this
 */
-proto.pulumirpc.UpdateRequest.prototype.setNews = function(value) {
-  return jspb.Message.setWrapperField(this, 4, value);
+proto.pulumirpc.CreateResponse.prototype.setProperties = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.pulumirpc.UpdateRequest} returns this
+ * @return {!proto.pulumirpc.CreateResponse} returns this
  */
-proto.pulumirpc.UpdateRequest.prototype.clearNews = function() {
-  return this.setNews(undefined);
+proto.pulumirpc.CreateResponse.prototype.clearProperties = function() {
+  return this.setProperties(undefined);
 };
 
 
@@ -4783,81 +5129,8 @@ proto.pulumirpc.UpdateRequest.prototype.clearNews = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.pulumirpc.UpdateRequest.prototype.hasNews = function() {
-  return jspb.Message.getField(this, 4) != null;
-};
-
-
-/**
- * optional double timeout = 5;
- * @return {number}
- */
-proto.pulumirpc.UpdateRequest.prototype.getTimeout = function() {
-  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 5, 0.0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.pulumirpc.UpdateRequest} returns this
- */
-proto.pulumirpc.UpdateRequest.prototype.setTimeout = function(value) {
-  return jspb.Message.setProto3FloatField(this, 5, value);
-};
-
-
-/**
- * repeated string ignoreChanges = 6;
- * @return {!Array<string>}
- */
-proto.pulumirpc.UpdateRequest.prototype.getIgnorechangesList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 6));
-};
-
-
-/**
- * @param {!Array<string>} value
- * @return {!proto.pulumirpc.UpdateRequest} returns this
- */
-proto.pulumirpc.UpdateRequest.prototype.setIgnorechangesList = function(value) {
-  return jspb.Message.setField(this, 6, value || []);
-};
-
-
-/**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.pulumirpc.UpdateRequest} returns this
- */
-proto.pulumirpc.UpdateRequest.prototype.addIgnorechanges = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 6, value, opt_index);
-};
-
-
-/**
- * Clears the list making it empty but non-null.
- * @return {!proto.pulumirpc.UpdateRequest} returns this
- */
-proto.pulumirpc.UpdateRequest.prototype.clearIgnorechangesList = function() {
-  return this.setIgnorechangesList([]);
-};
-
-
-/**
- * optional bool preview = 7;
- * @return {boolean}
- */
-proto.pulumirpc.UpdateRequest.prototype.getPreview = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 7, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.pulumirpc.UpdateRequest} returns this
- */
-proto.pulumirpc.UpdateRequest.prototype.setPreview = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 7, value);
+proto.pulumirpc.CreateResponse.prototype.hasProperties = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
@@ -4877,8 +5150,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pulumirpc.UpdateResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.UpdateResponse.toObject(opt_includeInstance, this);
+proto.pulumirpc.ReadRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.ReadRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -4887,13 +5160,16 @@ proto.pulumirpc.UpdateResponse.prototype.toObject = function(opt_includeInstance
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.UpdateResponse} msg The msg instance to transform.
+ * @param {!proto.pulumirpc.ReadRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.UpdateResponse.toObject = function(includeInstance, msg) {
+proto.pulumirpc.ReadRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    properties: (f = msg.getProperties()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    urn: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    properties: (f = msg.getProperties()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
+    inputs: (f = msg.getInputs()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -4907,23 +5183,23 @@ proto.pulumirpc.UpdateResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.UpdateResponse}
+ * @return {!proto.pulumirpc.ReadRequest}
  */
-proto.pulumirpc.UpdateResponse.deserializeBinary = function(bytes) {
+proto.pulumirpc.ReadRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.UpdateResponse;
-  return proto.pulumirpc.UpdateResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pulumirpc.ReadRequest;
+  return proto.pulumirpc.ReadRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pulumirpc.UpdateResponse} msg The message object to deserialize into.
+ * @param {!proto.pulumirpc.ReadRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.UpdateResponse}
+ * @return {!proto.pulumirpc.ReadRequest}
  */
-proto.pulumirpc.UpdateResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.pulumirpc.ReadRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4931,13 +5207,26 @@ proto.pulumirpc.UpdateResponse.deserializeBinaryFromReader = function(msg, reade
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUrn(value);
+      break;
+    case 3:
       var value = new google_protobuf_struct_pb.Struct;
       reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
       msg.setProperties(value);
       break;
-    default:
-      reader.skipField();
-      break;
+    case 4:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setInputs(value);
+      break;
+    default:
+      reader.skipField();
+      break;
     }
   }
   return msg;
@@ -4948,9 +5237,9 @@ proto.pulumirpc.UpdateResponse.deserializeBinaryFromReader = function(msg, reade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pulumirpc.UpdateResponse.prototype.serializeBinary = function() {
+proto.pulumirpc.ReadRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.UpdateResponse.serializeBinaryToWriter(this, writer);
+  proto.pulumirpc.ReadRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4958,16 +5247,38 @@ proto.pulumirpc.UpdateResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.UpdateResponse} message
+ * @param {!proto.pulumirpc.ReadRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.UpdateResponse.serializeBinaryToWriter = function(message, writer) {
+proto.pulumirpc.ReadRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getUrn();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
   f = message.getProperties();
   if (f != null) {
     writer.writeMessage(
-      1,
+      3,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+  f = message.getInputs();
+  if (f != null) {
+    writer.writeMessage(
+      4,
       f,
       google_protobuf_struct_pb.Struct.serializeBinaryToWriter
     );
@@ -4976,29 +5287,65 @@ proto.pulumirpc.UpdateResponse.serializeBinaryToWriter = function(message, write
 
 
 /**
- * optional google.protobuf.Struct properties = 1;
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.pulumirpc.ReadRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.ReadRequest} returns this
+ */
+proto.pulumirpc.ReadRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string urn = 2;
+ * @return {string}
+ */
+proto.pulumirpc.ReadRequest.prototype.getUrn = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.ReadRequest} returns this
+ */
+proto.pulumirpc.ReadRequest.prototype.setUrn = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional google.protobuf.Struct properties = 3;
  * @return {?proto.google.protobuf.Struct}
  */
-proto.pulumirpc.UpdateResponse.prototype.getProperties = function() {
+proto.pulumirpc.ReadRequest.prototype.getProperties = function() {
   return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 1));
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 3));
 };
 
 
 /**
  * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.pulumirpc.UpdateResponse} returns this
+ * @return {!proto.pulumirpc.ReadRequest} returns this
 */
-proto.pulumirpc.UpdateResponse.prototype.setProperties = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
+proto.pulumirpc.ReadRequest.prototype.setProperties = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.pulumirpc.UpdateResponse} returns this
+ * @return {!proto.pulumirpc.ReadRequest} returns this
  */
-proto.pulumirpc.UpdateResponse.prototype.clearProperties = function() {
+proto.pulumirpc.ReadRequest.prototype.clearProperties = function() {
   return this.setProperties(undefined);
 };
 
@@ -5007,8 +5354,45 @@ proto.pulumirpc.UpdateResponse.prototype.clearProperties = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.pulumirpc.UpdateResponse.prototype.hasProperties = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.pulumirpc.ReadRequest.prototype.hasProperties = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional google.protobuf.Struct inputs = 4;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.pulumirpc.ReadRequest.prototype.getInputs = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 4));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.pulumirpc.ReadRequest} returns this
+*/
+proto.pulumirpc.ReadRequest.prototype.setInputs = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.pulumirpc.ReadRequest} returns this
+ */
+proto.pulumirpc.ReadRequest.prototype.clearInputs = function() {
+  return this.setInputs(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.pulumirpc.ReadRequest.prototype.hasInputs = function() {
+  return jspb.Message.getField(this, 4) != null;
 };
 
 
@@ -5028,8 +5412,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pulumirpc.DeleteRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.DeleteRequest.toObject(opt_includeInstance, this);
+proto.pulumirpc.ReadResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.ReadResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -5038,16 +5422,15 @@ proto.pulumirpc.DeleteRequest.prototype.toObject = function(opt_includeInstance)
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.DeleteRequest} msg The msg instance to transform.
+ * @param {!proto.pulumirpc.ReadResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.DeleteRequest.toObject = function(includeInstance, msg) {
+proto.pulumirpc.ReadResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    urn: jspb.Message.getFieldWithDefault(msg, 2, ""),
     properties: (f = msg.getProperties()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
-    timeout: jspb.Message.getFloatingPointFieldWithDefault(msg, 4, 0.0)
+    inputs: (f = msg.getInputs()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -5061,23 +5444,23 @@ proto.pulumirpc.DeleteRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.DeleteRequest}
+ * @return {!proto.pulumirpc.ReadResponse}
  */
-proto.pulumirpc.DeleteRequest.deserializeBinary = function(bytes) {
+proto.pulumirpc.ReadResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.DeleteRequest;
-  return proto.pulumirpc.DeleteRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pulumirpc.ReadResponse;
+  return proto.pulumirpc.ReadResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pulumirpc.DeleteRequest} msg The message object to deserialize into.
+ * @param {!proto.pulumirpc.ReadResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.DeleteRequest}
+ * @return {!proto.pulumirpc.ReadResponse}
  */
-proto.pulumirpc.DeleteRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.pulumirpc.ReadResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5089,17 +5472,14 @@ proto.pulumirpc.DeleteRequest.deserializeBinaryFromReader = function(msg, reader
       msg.setId(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setUrn(value);
-      break;
-    case 3:
       var value = new google_protobuf_struct_pb.Struct;
       reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
       msg.setProperties(value);
       break;
-    case 4:
-      var value = /** @type {number} */ (reader.readDouble());
-      msg.setTimeout(value);
+    case 3:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setInputs(value);
       break;
     default:
       reader.skipField();
@@ -5114,9 +5494,9 @@ proto.pulumirpc.DeleteRequest.deserializeBinaryFromReader = function(msg, reader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pulumirpc.DeleteRequest.prototype.serializeBinary = function() {
+proto.pulumirpc.ReadResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.DeleteRequest.serializeBinaryToWriter(this, writer);
+  proto.pulumirpc.ReadResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5124,11 +5504,11 @@ proto.pulumirpc.DeleteRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.DeleteRequest} message
+ * @param {!proto.pulumirpc.ReadResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.DeleteRequest.serializeBinaryToWriter = function(message, writer) {
+proto.pulumirpc.ReadResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f.length > 0) {
@@ -5137,14 +5517,15 @@ proto.pulumirpc.DeleteRequest.serializeBinaryToWriter = function(message, writer
       f
     );
   }
-  f = message.getUrn();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getProperties();
+  if (f != null) {
+    writer.writeMessage(
       2,
-      f
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
     );
   }
-  f = message.getProperties();
+  f = message.getInputs();
   if (f != null) {
     writer.writeMessage(
       3,
@@ -5152,13 +5533,6 @@ proto.pulumirpc.DeleteRequest.serializeBinaryToWriter = function(message, writer
       google_protobuf_struct_pb.Struct.serializeBinaryToWriter
     );
   }
-  f = message.getTimeout();
-  if (f !== 0.0) {
-    writer.writeDouble(
-      4,
-      f
-    );
-  }
 };
 
 
@@ -5166,43 +5540,62 @@ proto.pulumirpc.DeleteRequest.serializeBinaryToWriter = function(message, writer
  * optional string id = 1;
  * @return {string}
  */
-proto.pulumirpc.DeleteRequest.prototype.getId = function() {
+proto.pulumirpc.ReadResponse.prototype.getId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.pulumirpc.DeleteRequest} returns this
+ * @return {!proto.pulumirpc.ReadResponse} returns this
  */
-proto.pulumirpc.DeleteRequest.prototype.setId = function(value) {
+proto.pulumirpc.ReadResponse.prototype.setId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string urn = 2;
- * @return {string}
+ * optional google.protobuf.Struct properties = 2;
+ * @return {?proto.google.protobuf.Struct}
  */
-proto.pulumirpc.DeleteRequest.prototype.getUrn = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.pulumirpc.ReadResponse.prototype.getProperties = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 2));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.pulumirpc.DeleteRequest} returns this
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.pulumirpc.ReadResponse} returns this
+*/
+proto.pulumirpc.ReadResponse.prototype.setProperties = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.pulumirpc.ReadResponse} returns this
  */
-proto.pulumirpc.DeleteRequest.prototype.setUrn = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.pulumirpc.ReadResponse.prototype.clearProperties = function() {
+  return this.setProperties(undefined);
 };
 
 
 /**
- * optional google.protobuf.Struct properties = 3;
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.pulumirpc.ReadResponse.prototype.hasProperties = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional google.protobuf.Struct inputs = 3;
  * @return {?proto.google.protobuf.Struct}
  */
-proto.pulumirpc.DeleteRequest.prototype.getProperties = function() {
+proto.pulumirpc.ReadResponse.prototype.getInputs = function() {
   return /** @type{?proto.google.protobuf.Struct} */ (
     jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 3));
 };
@@ -5210,19 +5603,19 @@ proto.pulumirpc.DeleteRequest.prototype.getProperties = function() {
 
 /**
  * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.pulumirpc.DeleteRequest} returns this
+ * @return {!proto.pulumirpc.ReadResponse} returns this
 */
-proto.pulumirpc.DeleteRequest.prototype.setProperties = function(value) {
+proto.pulumirpc.ReadResponse.prototype.setInputs = function(value) {
   return jspb.Message.setWrapperField(this, 3, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.pulumirpc.DeleteRequest} returns this
+ * @return {!proto.pulumirpc.ReadResponse} returns this
  */
-proto.pulumirpc.DeleteRequest.prototype.clearProperties = function() {
-  return this.setProperties(undefined);
+proto.pulumirpc.ReadResponse.prototype.clearInputs = function() {
+  return this.setInputs(undefined);
 };
 
 
@@ -5230,36 +5623,18 @@ proto.pulumirpc.DeleteRequest.prototype.clearProperties = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.pulumirpc.DeleteRequest.prototype.hasProperties = function() {
+proto.pulumirpc.ReadResponse.prototype.hasInputs = function() {
   return jspb.Message.getField(this, 3) != null;
 };
 
 
-/**
- * optional double timeout = 4;
- * @return {number}
- */
-proto.pulumirpc.DeleteRequest.prototype.getTimeout = function() {
-  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 4, 0.0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.pulumirpc.DeleteRequest} returns this
- */
-proto.pulumirpc.DeleteRequest.prototype.setTimeout = function(value) {
-  return jspb.Message.setProto3FloatField(this, 4, value);
-};
-
-
-
+
 /**
  * List of repeated fields within this message type.
  * @private {!Array<number>}
  * @const
  */
-proto.pulumirpc.ConstructRequest.repeatedFields_ = [14,15];
+proto.pulumirpc.UpdateRequest.repeatedFields_ = [6];
 
 
 
@@ -5276,8 +5651,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pulumirpc.ConstructRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.ConstructRequest.toObject(opt_includeInstance, this);
+proto.pulumirpc.UpdateRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.UpdateRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -5286,27 +5661,19 @@ proto.pulumirpc.ConstructRequest.prototype.toObject = function(opt_includeInstan
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.ConstructRequest} msg The msg instance to transform.
+ * @param {!proto.pulumirpc.UpdateRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.ConstructRequest.toObject = function(includeInstance, msg) {
+proto.pulumirpc.UpdateRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    project: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    stack: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    configMap: (f = msg.getConfigMap()) ? f.toObject(includeInstance, undefined) : [],
-    dryrun: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
-    parallel: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    monitorendpoint: jspb.Message.getFieldWithDefault(msg, 6, ""),
-    type: jspb.Message.getFieldWithDefault(msg, 7, ""),
-    name: jspb.Message.getFieldWithDefault(msg, 8, ""),
-    parent: jspb.Message.getFieldWithDefault(msg, 9, ""),
-    inputs: (f = msg.getInputs()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
-    inputdependenciesMap: (f = msg.getInputdependenciesMap()) ? f.toObject(includeInstance, proto.pulumirpc.ConstructRequest.PropertyDependencies.toObject) : [],
-    protect: jspb.Message.getBooleanFieldWithDefault(msg, 12, false),
-    providersMap: (f = msg.getProvidersMap()) ? f.toObject(includeInstance, undefined) : [],
-    aliasesList: (f = jspb.Message.getRepeatedField(msg, 14)) == null ? undefined : f,
-    dependenciesList: (f = jspb.Message.getRepeatedField(msg, 15)) == null ? undefined : f
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    urn: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    olds: (f = msg.getOlds()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
+    news: (f = msg.getNews()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
+    timeout: jspb.Message.getFloatingPointFieldWithDefault(msg, 5, 0.0),
+    ignorechangesList: (f = jspb.Message.getRepeatedField(msg, 6)) == null ? undefined : f,
+    preview: jspb.Message.getBooleanFieldWithDefault(msg, 7, false)
   };
 
   if (includeInstance) {
@@ -5320,23 +5687,23 @@ proto.pulumirpc.ConstructRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.ConstructRequest}
+ * @return {!proto.pulumirpc.UpdateRequest}
  */
-proto.pulumirpc.ConstructRequest.deserializeBinary = function(bytes) {
+proto.pulumirpc.UpdateRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.ConstructRequest;
-  return proto.pulumirpc.ConstructRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pulumirpc.UpdateRequest;
+  return proto.pulumirpc.UpdateRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pulumirpc.ConstructRequest} msg The message object to deserialize into.
+ * @param {!proto.pulumirpc.UpdateRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.ConstructRequest}
+ * @return {!proto.pulumirpc.UpdateRequest}
  */
-proto.pulumirpc.ConstructRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.pulumirpc.UpdateRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5345,70 +5712,33 @@ proto.pulumirpc.ConstructRequest.deserializeBinaryFromReader = function(msg, rea
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setProject(value);
+      msg.setId(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setStack(value);
+      msg.setUrn(value);
       break;
     case 3:
-      var value = msg.getConfigMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
-         });
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setOlds(value);
       break;
     case 4:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setDryrun(value);
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setNews(value);
       break;
     case 5:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setParallel(value);
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setTimeout(value);
       break;
     case 6:
       var value = /** @type {string} */ (reader.readString());
-      msg.setMonitorendpoint(value);
+      msg.addIgnorechanges(value);
       break;
     case 7:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setType(value);
-      break;
-    case 8:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
-      break;
-    case 9:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setParent(value);
-      break;
-    case 10:
-      var value = new google_protobuf_struct_pb.Struct;
-      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setInputs(value);
-      break;
-    case 11:
-      var value = msg.getInputdependenciesMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.pulumirpc.ConstructRequest.PropertyDependencies.deserializeBinaryFromReader, "", new proto.pulumirpc.ConstructRequest.PropertyDependencies());
-         });
-      break;
-    case 12:
       var value = /** @type {boolean} */ (reader.readBool());
-      msg.setProtect(value);
-      break;
-    case 13:
-      var value = msg.getProvidersMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
-         });
-      break;
-    case 14:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addAliases(value);
-      break;
-    case 15:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addDependencies(value);
+      msg.setPreview(value);
       break;
     default:
       reader.skipField();
@@ -5423,9 +5753,9 @@ proto.pulumirpc.ConstructRequest.deserializeBinaryFromReader = function(msg, rea
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pulumirpc.ConstructRequest.prototype.serializeBinary = function() {
+proto.pulumirpc.UpdateRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.ConstructRequest.serializeBinaryToWriter(this, writer);
+  proto.pulumirpc.UpdateRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5433,607 +5763,1792 @@ proto.pulumirpc.ConstructRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.ConstructRequest} message
+ * @param {!proto.pulumirpc.UpdateRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.ConstructRequest.serializeBinaryToWriter = function(message, writer) {
+proto.pulumirpc.UpdateRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getProject();
+  f = message.getId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getStack();
+  f = message.getUrn();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getConfigMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(3, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
-  }
-  f = message.getDryrun();
-  if (f) {
-    writer.writeBool(
-      4,
-      f
-    );
-  }
-  f = message.getParallel();
-  if (f !== 0) {
-    writer.writeInt32(
-      5,
-      f
-    );
-  }
-  f = message.getMonitorendpoint();
-  if (f.length > 0) {
-    writer.writeString(
-      6,
-      f
-    );
-  }
-  f = message.getType();
-  if (f.length > 0) {
-    writer.writeString(
-      7,
-      f
-    );
-  }
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
-      8,
-      f
-    );
-  }
-  f = message.getParent();
-  if (f.length > 0) {
-    writer.writeString(
-      9,
-      f
+  f = message.getOlds();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
     );
   }
-  f = message.getInputs();
+  f = message.getNews();
   if (f != null) {
     writer.writeMessage(
-      10,
+      4,
       f,
       google_protobuf_struct_pb.Struct.serializeBinaryToWriter
     );
   }
-  f = message.getInputdependenciesMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(11, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.pulumirpc.ConstructRequest.PropertyDependencies.serializeBinaryToWriter);
-  }
-  f = message.getProtect();
-  if (f) {
-    writer.writeBool(
-      12,
+  f = message.getTimeout();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      5,
       f
     );
   }
-  f = message.getProvidersMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(13, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
-  }
-  f = message.getAliasesList();
+  f = message.getIgnorechangesList();
   if (f.length > 0) {
     writer.writeRepeatedString(
-      14,
+      6,
       f
     );
   }
-  f = message.getDependenciesList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
-      15,
+  f = message.getPreview();
+  if (f) {
+    writer.writeBool(
+      7,
       f
     );
   }
 };
 
 
-
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * optional string id = 1;
+ * @return {string}
  */
-proto.pulumirpc.ConstructRequest.PropertyDependencies.repeatedFields_ = [1];
-
+proto.pulumirpc.UpdateRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
 
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * @param {string} value
+ * @return {!proto.pulumirpc.UpdateRequest} returns this
  */
-proto.pulumirpc.ConstructRequest.PropertyDependencies.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.ConstructRequest.PropertyDependencies.toObject(opt_includeInstance, this);
+proto.pulumirpc.UpdateRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.ConstructRequest.PropertyDependencies} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional string urn = 2;
+ * @return {string}
  */
-proto.pulumirpc.ConstructRequest.PropertyDependencies.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    urnsList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.pulumirpc.UpdateRequest.prototype.getUrn = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.ConstructRequest.PropertyDependencies}
+ * @param {string} value
+ * @return {!proto.pulumirpc.UpdateRequest} returns this
  */
-proto.pulumirpc.ConstructRequest.PropertyDependencies.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.ConstructRequest.PropertyDependencies;
-  return proto.pulumirpc.ConstructRequest.PropertyDependencies.deserializeBinaryFromReader(msg, reader);
+proto.pulumirpc.UpdateRequest.prototype.setUrn = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.pulumirpc.ConstructRequest.PropertyDependencies} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.ConstructRequest.PropertyDependencies}
+ * optional google.protobuf.Struct olds = 3;
+ * @return {?proto.google.protobuf.Struct}
  */
-proto.pulumirpc.ConstructRequest.PropertyDependencies.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addUrns(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.pulumirpc.UpdateRequest.prototype.getOlds = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 3));
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
- */
-proto.pulumirpc.ConstructRequest.PropertyDependencies.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.ConstructRequest.PropertyDependencies.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.pulumirpc.UpdateRequest} returns this
+*/
+proto.pulumirpc.UpdateRequest.prototype.setOlds = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.ConstructRequest.PropertyDependencies} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Clears the message field making it undefined.
+ * @return {!proto.pulumirpc.UpdateRequest} returns this
  */
-proto.pulumirpc.ConstructRequest.PropertyDependencies.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getUrnsList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
-      1,
-      f
-    );
-  }
+proto.pulumirpc.UpdateRequest.prototype.clearOlds = function() {
+  return this.setOlds(undefined);
 };
 
 
 /**
- * repeated string urns = 1;
- * @return {!Array<string>}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.pulumirpc.ConstructRequest.PropertyDependencies.prototype.getUrnsList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+proto.pulumirpc.UpdateRequest.prototype.hasOlds = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.pulumirpc.ConstructRequest.PropertyDependencies} returns this
+ * optional google.protobuf.Struct news = 4;
+ * @return {?proto.google.protobuf.Struct}
  */
-proto.pulumirpc.ConstructRequest.PropertyDependencies.prototype.setUrnsList = function(value) {
-  return jspb.Message.setField(this, 1, value || []);
+proto.pulumirpc.UpdateRequest.prototype.getNews = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 4));
 };
 
 
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.pulumirpc.ConstructRequest.PropertyDependencies} returns this
- */
-proto.pulumirpc.ConstructRequest.PropertyDependencies.prototype.addUrns = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.pulumirpc.UpdateRequest} returns this
+*/
+proto.pulumirpc.UpdateRequest.prototype.setNews = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.pulumirpc.ConstructRequest.PropertyDependencies} returns this
+ * Clears the message field making it undefined.
+ * @return {!proto.pulumirpc.UpdateRequest} returns this
  */
-proto.pulumirpc.ConstructRequest.PropertyDependencies.prototype.clearUrnsList = function() {
-  return this.setUrnsList([]);
+proto.pulumirpc.UpdateRequest.prototype.clearNews = function() {
+  return this.setNews(undefined);
 };
 
 
 /**
- * optional string project = 1;
- * @return {string}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.pulumirpc.ConstructRequest.prototype.getProject = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.pulumirpc.UpdateRequest.prototype.hasNews = function() {
+  return jspb.Message.getField(this, 4) != null;
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.pulumirpc.ConstructRequest} returns this
+ * optional double timeout = 5;
+ * @return {number}
  */
-proto.pulumirpc.ConstructRequest.prototype.setProject = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.pulumirpc.UpdateRequest.prototype.getTimeout = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 5, 0.0));
 };
 
 
 /**
- * optional string stack = 2;
- * @return {string}
+ * @param {number} value
+ * @return {!proto.pulumirpc.UpdateRequest} returns this
  */
-proto.pulumirpc.ConstructRequest.prototype.getStack = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.pulumirpc.UpdateRequest.prototype.setTimeout = function(value) {
+  return jspb.Message.setProto3FloatField(this, 5, value);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.pulumirpc.ConstructRequest} returns this
+ * repeated string ignoreChanges = 6;
+ * @return {!Array<string>}
  */
-proto.pulumirpc.ConstructRequest.prototype.setStack = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.pulumirpc.UpdateRequest.prototype.getIgnorechangesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 6));
 };
 
 
 /**
- * map<string, string> config = 3;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,string>}
+ * @param {!Array<string>} value
+ * @return {!proto.pulumirpc.UpdateRequest} returns this
  */
-proto.pulumirpc.ConstructRequest.prototype.getConfigMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,string>} */ (
-      jspb.Message.getMapField(this, 3, opt_noLazyCreate,
-      null));
+proto.pulumirpc.UpdateRequest.prototype.setIgnorechangesList = function(value) {
+  return jspb.Message.setField(this, 6, value || []);
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.pulumirpc.ConstructRequest} returns this
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.pulumirpc.UpdateRequest} returns this
  */
-proto.pulumirpc.ConstructRequest.prototype.clearConfigMap = function() {
-  this.getConfigMap().clear();
-  return this;};
+proto.pulumirpc.UpdateRequest.prototype.addIgnorechanges = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 6, value, opt_index);
+};
 
 
 /**
- * optional bool dryRun = 4;
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pulumirpc.UpdateRequest} returns this
  */
-proto.pulumirpc.ConstructRequest.prototype.getDryrun = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+proto.pulumirpc.UpdateRequest.prototype.clearIgnorechangesList = function() {
+  return this.setIgnorechangesList([]);
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.pulumirpc.ConstructRequest} returns this
+ * optional bool preview = 7;
+ * @return {boolean}
  */
-proto.pulumirpc.ConstructRequest.prototype.setDryrun = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 4, value);
+proto.pulumirpc.UpdateRequest.prototype.getPreview = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 7, false));
 };
 
 
 /**
- * optional int32 parallel = 5;
- * @return {number}
+ * @param {boolean} value
+ * @return {!proto.pulumirpc.UpdateRequest} returns this
  */
-proto.pulumirpc.ConstructRequest.prototype.getParallel = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.pulumirpc.UpdateRequest.prototype.setPreview = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 7, value);
 };
 
 
-/**
- * @param {number} value
- * @return {!proto.pulumirpc.ConstructRequest} returns this
- */
-proto.pulumirpc.ConstructRequest.prototype.setParallel = function(value) {
-  return jspb.Message.setProto3IntField(this, 5, value);
-};
 
 
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional string monitorEndpoint = 6;
- * @return {string}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.pulumirpc.ConstructRequest.prototype.getMonitorendpoint = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+proto.pulumirpc.UpdateResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.UpdateResponse.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.pulumirpc.ConstructRequest} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pulumirpc.UpdateResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.ConstructRequest.prototype.setMonitorendpoint = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
+proto.pulumirpc.UpdateResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    properties: (f = msg.getProperties()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional string type = 7;
- * @return {string}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pulumirpc.UpdateResponse}
  */
-proto.pulumirpc.ConstructRequest.prototype.getType = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+proto.pulumirpc.UpdateResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pulumirpc.UpdateResponse;
+  return proto.pulumirpc.UpdateResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.pulumirpc.ConstructRequest} returns this
- */
-proto.pulumirpc.ConstructRequest.prototype.setType = function(value) {
-  return jspb.Message.setProto3StringField(this, 7, value);
-};
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pulumirpc.UpdateResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pulumirpc.UpdateResponse}
+ */
+proto.pulumirpc.UpdateResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setProperties(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pulumirpc.UpdateResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pulumirpc.UpdateResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pulumirpc.UpdateResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.UpdateResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getProperties();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional google.protobuf.Struct properties = 1;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.pulumirpc.UpdateResponse.prototype.getProperties = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 1));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.pulumirpc.UpdateResponse} returns this
+*/
+proto.pulumirpc.UpdateResponse.prototype.setProperties = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.pulumirpc.UpdateResponse} returns this
+ */
+proto.pulumirpc.UpdateResponse.prototype.clearProperties = function() {
+  return this.setProperties(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.pulumirpc.UpdateResponse.prototype.hasProperties = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pulumirpc.DeleteRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.DeleteRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pulumirpc.DeleteRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.DeleteRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    urn: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    properties: (f = msg.getProperties()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
+    timeout: jspb.Message.getFloatingPointFieldWithDefault(msg, 4, 0.0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pulumirpc.DeleteRequest}
+ */
+proto.pulumirpc.DeleteRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pulumirpc.DeleteRequest;
+  return proto.pulumirpc.DeleteRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pulumirpc.DeleteRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pulumirpc.DeleteRequest}
+ */
+proto.pulumirpc.DeleteRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUrn(value);
+      break;
+    case 3:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setProperties(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setTimeout(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pulumirpc.DeleteRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pulumirpc.DeleteRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pulumirpc.DeleteRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.DeleteRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getUrn();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getProperties();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+  f = message.getTimeout();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.pulumirpc.DeleteRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.DeleteRequest} returns this
+ */
+proto.pulumirpc.DeleteRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string urn = 2;
+ * @return {string}
+ */
+proto.pulumirpc.DeleteRequest.prototype.getUrn = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.DeleteRequest} returns this
+ */
+proto.pulumirpc.DeleteRequest.prototype.setUrn = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional google.protobuf.Struct properties = 3;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.pulumirpc.DeleteRequest.prototype.getProperties = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 3));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.pulumirpc.DeleteRequest} returns this
+*/
+proto.pulumirpc.DeleteRequest.prototype.setProperties = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.pulumirpc.DeleteRequest} returns this
+ */
+proto.pulumirpc.DeleteRequest.prototype.clearProperties = function() {
+  return this.setProperties(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.pulumirpc.DeleteRequest.prototype.hasProperties = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional double timeout = 4;
+ * @return {number}
+ */
+proto.pulumirpc.DeleteRequest.prototype.getTimeout = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 4, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pulumirpc.DeleteRequest} returns this
+ */
+proto.pulumirpc.DeleteRequest.prototype.setTimeout = function(value) {
+  return jspb.Message.setProto3FloatField(this, 4, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.pulumirpc.ConstructRequest.repeatedFields_ = [14,15];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pulumirpc.ConstructRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.ConstructRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pulumirpc.ConstructRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.ConstructRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    project: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    stack: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    configMap: (f = msg.getConfigMap()) ? f.toObject(includeInstance, undefined) : [],
+    dryrun: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
+    parallel: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    monitorendpoint: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    type: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 8, ""),
+    parent: jspb.Message.getFieldWithDefault(msg, 9, ""),
+    inputs: (f = msg.getInputs()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
+    inputdependenciesMap: (f = msg.getInputdependenciesMap()) ? f.toObject(includeInstance, proto.pulumirpc.ConstructRequest.PropertyDependencies.toObject) : [],
+    protect: jspb.Message.getBooleanFieldWithDefault(msg, 12, false),
+    providersMap: (f = msg.getProvidersMap()) ? f.toObject(includeInstance, undefined) : [],
+    aliasesList: (f = jspb.Message.getRepeatedField(msg, 14)) == null ? undefined : f,
+    dependenciesList: (f = jspb.Message.getRepeatedField(msg, 15)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pulumirpc.ConstructRequest}
+ */
+proto.pulumirpc.ConstructRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pulumirpc.ConstructRequest;
+  return proto.pulumirpc.ConstructRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pulumirpc.ConstructRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pulumirpc.ConstructRequest}
+ */
+proto.pulumirpc.ConstructRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setProject(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setStack(value);
+      break;
+    case 3:
+      var value = msg.getConfigMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setDryrun(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setParallel(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMonitorendpoint(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setType(value);
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 9:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setParent(value);
+      break;
+    case 10:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setInputs(value);
+      break;
+    case 11:
+      var value = msg.getInputdependenciesMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.pulumirpc.ConstructRequest.PropertyDependencies.deserializeBinaryFromReader, "", new proto.pulumirpc.ConstructRequest.PropertyDependencies());
+         });
+      break;
+    case 12:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setProtect(value);
+      break;
+    case 13:
+      var value = msg.getProvidersMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    case 14:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addAliases(value);
+      break;
+    case 15:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addDependencies(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pulumirpc.ConstructRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pulumirpc.ConstructRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pulumirpc.ConstructRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.ConstructRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getProject();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getStack();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getConfigMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(3, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+  f = message.getDryrun();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
+  f = message.getParallel();
+  if (f !== 0) {
+    writer.writeInt32(
+      5,
+      f
+    );
+  }
+  f = message.getMonitorendpoint();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getType();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      8,
+      f
+    );
+  }
+  f = message.getParent();
+  if (f.length > 0) {
+    writer.writeString(
+      9,
+      f
+    );
+  }
+  f = message.getInputs();
+  if (f != null) {
+    writer.writeMessage(
+      10,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+  f = message.getInputdependenciesMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(11, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.pulumirpc.ConstructRequest.PropertyDependencies.serializeBinaryToWriter);
+  }
+  f = message.getProtect();
+  if (f) {
+    writer.writeBool(
+      12,
+      f
+    );
+  }
+  f = message.getProvidersMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(13, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+  f = message.getAliasesList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      14,
+      f
+    );
+  }
+  f = message.getDependenciesList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      15,
+      f
+    );
+  }
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.pulumirpc.ConstructRequest.PropertyDependencies.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pulumirpc.ConstructRequest.PropertyDependencies.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.ConstructRequest.PropertyDependencies.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pulumirpc.ConstructRequest.PropertyDependencies} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.ConstructRequest.PropertyDependencies.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    urnsList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pulumirpc.ConstructRequest.PropertyDependencies}
+ */
+proto.pulumirpc.ConstructRequest.PropertyDependencies.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pulumirpc.ConstructRequest.PropertyDependencies;
+  return proto.pulumirpc.ConstructRequest.PropertyDependencies.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pulumirpc.ConstructRequest.PropertyDependencies} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pulumirpc.ConstructRequest.PropertyDependencies}
+ */
+proto.pulumirpc.ConstructRequest.PropertyDependencies.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addUrns(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pulumirpc.ConstructRequest.PropertyDependencies.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pulumirpc.ConstructRequest.PropertyDependencies.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pulumirpc.ConstructRequest.PropertyDependencies} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.ConstructRequest.PropertyDependencies.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUrnsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated string urns = 1;
+ * @return {!Array<string>}
+ */
+proto.pulumirpc.ConstructRequest.PropertyDependencies.prototype.getUrnsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.pulumirpc.ConstructRequest.PropertyDependencies} returns this
+ */
+proto.pulumirpc.ConstructRequest.PropertyDependencies.prototype.setUrnsList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.pulumirpc.ConstructRequest.PropertyDependencies} returns this
+ */
+proto.pulumirpc.ConstructRequest.PropertyDependencies.prototype.addUrns = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pulumirpc.ConstructRequest.PropertyDependencies} returns this
+ */
+proto.pulumirpc.ConstructRequest.PropertyDependencies.prototype.clearUrnsList = function() {
+  return this.setUrnsList([]);
+};
+
+
+/**
+ * optional string project = 1;
+ * @return {string}
+ */
+proto.pulumirpc.ConstructRequest.prototype.getProject = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
+ */
+proto.pulumirpc.ConstructRequest.prototype.setProject = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string stack = 2;
+ * @return {string}
+ */
+proto.pulumirpc.ConstructRequest.prototype.getStack = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
+ */
+proto.pulumirpc.ConstructRequest.prototype.setStack = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * map<string, string> config = 3;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.pulumirpc.ConstructRequest.prototype.getConfigMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 3, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
+ */
+proto.pulumirpc.ConstructRequest.prototype.clearConfigMap = function() {
+  this.getConfigMap().clear();
+  return this;};
+
+
+/**
+ * optional bool dryRun = 4;
+ * @return {boolean}
+ */
+proto.pulumirpc.ConstructRequest.prototype.getDryrun = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
+ */
+proto.pulumirpc.ConstructRequest.prototype.setDryrun = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
+};
+
+
+/**
+ * optional int32 parallel = 5;
+ * @return {number}
+ */
+proto.pulumirpc.ConstructRequest.prototype.getParallel = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
+ */
+proto.pulumirpc.ConstructRequest.prototype.setParallel = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional string monitorEndpoint = 6;
+ * @return {string}
+ */
+proto.pulumirpc.ConstructRequest.prototype.getMonitorendpoint = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
+ */
+proto.pulumirpc.ConstructRequest.prototype.setMonitorendpoint = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * optional string type = 7;
+ * @return {string}
+ */
+proto.pulumirpc.ConstructRequest.prototype.getType = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
+ */
+proto.pulumirpc.ConstructRequest.prototype.setType = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+/**
+ * optional string name = 8;
+ * @return {string}
+ */
+proto.pulumirpc.ConstructRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
+ */
+proto.pulumirpc.ConstructRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 8, value);
+};
+
+
+/**
+ * optional string parent = 9;
+ * @return {string}
+ */
+proto.pulumirpc.ConstructRequest.prototype.getParent = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
+ */
+proto.pulumirpc.ConstructRequest.prototype.setParent = function(value) {
+  return jspb.Message.setProto3StringField(this, 9, value);
+};
+
+
+/**
+ * optional google.protobuf.Struct inputs = 10;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.pulumirpc.ConstructRequest.prototype.getInputs = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 10));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
+*/
+proto.pulumirpc.ConstructRequest.prototype.setInputs = function(value) {
+  return jspb.Message.setWrapperField(this, 10, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
+ */
+proto.pulumirpc.ConstructRequest.prototype.clearInputs = function() {
+  return this.setInputs(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.pulumirpc.ConstructRequest.prototype.hasInputs = function() {
+  return jspb.Message.getField(this, 10) != null;
+};
+
+
+/**
+ * map<string, PropertyDependencies> inputDependencies = 11;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.pulumirpc.ConstructRequest.PropertyDependencies>}
+ */
+proto.pulumirpc.ConstructRequest.prototype.getInputdependenciesMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.pulumirpc.ConstructRequest.PropertyDependencies>} */ (
+      jspb.Message.getMapField(this, 11, opt_noLazyCreate,
+      proto.pulumirpc.ConstructRequest.PropertyDependencies));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
+ */
+proto.pulumirpc.ConstructRequest.prototype.clearInputdependenciesMap = function() {
+  this.getInputdependenciesMap().clear();
+  return this;};
+
+
+/**
+ * optional bool protect = 12;
+ * @return {boolean}
+ */
+proto.pulumirpc.ConstructRequest.prototype.getProtect = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 12, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
+ */
+proto.pulumirpc.ConstructRequest.prototype.setProtect = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 12, value);
+};
+
+
+/**
+ * map<string, string> providers = 13;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.pulumirpc.ConstructRequest.prototype.getProvidersMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 13, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
+ */
+proto.pulumirpc.ConstructRequest.prototype.clearProvidersMap = function() {
+  this.getProvidersMap().clear();
+  return this;};
+
+
+/**
+ * repeated string aliases = 14;
+ * @return {!Array<string>}
+ */
+proto.pulumirpc.ConstructRequest.prototype.getAliasesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 14));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
+ */
+proto.pulumirpc.ConstructRequest.prototype.setAliasesList = function(value) {
+  return jspb.Message.setField(this, 14, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
+ */
+proto.pulumirpc.ConstructRequest.prototype.addAliases = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 14, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
+ */
+proto.pulumirpc.ConstructRequest.prototype.clearAliasesList = function() {
+  return this.setAliasesList([]);
+};
+
+
+/**
+ * repeated string dependencies = 15;
+ * @return {!Array<string>}
+ */
+proto.pulumirpc.ConstructRequest.prototype.getDependenciesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 15));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
+ */
+proto.pulumirpc.ConstructRequest.prototype.setDependenciesList = function(value) {
+  return jspb.Message.setField(this, 15, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
+ */
+proto.pulumirpc.ConstructRequest.prototype.addDependencies = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 15, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
+ */
+proto.pulumirpc.ConstructRequest.prototype.clearDependenciesList = function() {
+  return this.setDependenciesList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pulumirpc.ConstructResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.ConstructResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pulumirpc.ConstructResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.ConstructResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    urn: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    state: (f = msg.getState()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
+    statedependenciesMap: (f = msg.getStatedependenciesMap()) ? f.toObject(includeInstance, proto.pulumirpc.ConstructResponse.PropertyDependencies.toObject) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
 
 
 /**
- * optional string name = 8;
- * @return {string}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pulumirpc.ConstructResponse}
  */
-proto.pulumirpc.ConstructRequest.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+proto.pulumirpc.ConstructResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pulumirpc.ConstructResponse;
+  return proto.pulumirpc.ConstructResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.pulumirpc.ConstructRequest} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pulumirpc.ConstructResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pulumirpc.ConstructResponse}
  */
-proto.pulumirpc.ConstructRequest.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 8, value);
+proto.pulumirpc.ConstructResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUrn(value);
+      break;
+    case 2:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setState(value);
+      break;
+    case 3:
+      var value = msg.getStatedependenciesMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.pulumirpc.ConstructResponse.PropertyDependencies.deserializeBinaryFromReader, "", new proto.pulumirpc.ConstructResponse.PropertyDependencies());
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional string parent = 9;
- * @return {string}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.pulumirpc.ConstructRequest.prototype.getParent = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+proto.pulumirpc.ConstructResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pulumirpc.ConstructResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.pulumirpc.ConstructRequest} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pulumirpc.ConstructResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.ConstructRequest.prototype.setParent = function(value) {
-  return jspb.Message.setProto3StringField(this, 9, value);
+proto.pulumirpc.ConstructResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUrn();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getState();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+  f = message.getStatedependenciesMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(3, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.pulumirpc.ConstructResponse.PropertyDependencies.serializeBinaryToWriter);
+  }
 };
 
 
+
 /**
- * optional google.protobuf.Struct inputs = 10;
- * @return {?proto.google.protobuf.Struct}
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.pulumirpc.ConstructRequest.prototype.getInputs = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 10));
-};
+proto.pulumirpc.ConstructResponse.PropertyDependencies.repeatedFields_ = [1];
 
 
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.pulumirpc.ConstructRequest} returns this
-*/
-proto.pulumirpc.ConstructRequest.prototype.setInputs = function(value) {
-  return jspb.Message.setWrapperField(this, 10, value);
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pulumirpc.ConstructResponse.PropertyDependencies.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.ConstructResponse.PropertyDependencies.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.pulumirpc.ConstructRequest} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pulumirpc.ConstructResponse.PropertyDependencies} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.ConstructRequest.prototype.clearInputs = function() {
-  return this.setInputs(undefined);
+proto.pulumirpc.ConstructResponse.PropertyDependencies.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    urnsList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pulumirpc.ConstructResponse.PropertyDependencies}
  */
-proto.pulumirpc.ConstructRequest.prototype.hasInputs = function() {
-  return jspb.Message.getField(this, 10) != null;
+proto.pulumirpc.ConstructResponse.PropertyDependencies.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pulumirpc.ConstructResponse.PropertyDependencies;
+  return proto.pulumirpc.ConstructResponse.PropertyDependencies.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * map<string, PropertyDependencies> inputDependencies = 11;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.pulumirpc.ConstructRequest.PropertyDependencies>}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pulumirpc.ConstructResponse.PropertyDependencies} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pulumirpc.ConstructResponse.PropertyDependencies}
  */
-proto.pulumirpc.ConstructRequest.prototype.getInputdependenciesMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.pulumirpc.ConstructRequest.PropertyDependencies>} */ (
-      jspb.Message.getMapField(this, 11, opt_noLazyCreate,
-      proto.pulumirpc.ConstructRequest.PropertyDependencies));
+proto.pulumirpc.ConstructResponse.PropertyDependencies.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addUrns(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.pulumirpc.ConstructRequest} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.pulumirpc.ConstructRequest.prototype.clearInputdependenciesMap = function() {
-  this.getInputdependenciesMap().clear();
-  return this;};
+proto.pulumirpc.ConstructResponse.PropertyDependencies.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pulumirpc.ConstructResponse.PropertyDependencies.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
 
 
 /**
- * optional bool protect = 12;
- * @return {boolean}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pulumirpc.ConstructResponse.PropertyDependencies} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.ConstructRequest.prototype.getProtect = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 12, false));
+proto.pulumirpc.ConstructResponse.PropertyDependencies.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUrnsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.pulumirpc.ConstructRequest} returns this
+ * repeated string urns = 1;
+ * @return {!Array<string>}
  */
-proto.pulumirpc.ConstructRequest.prototype.setProtect = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 12, value);
+proto.pulumirpc.ConstructResponse.PropertyDependencies.prototype.getUrnsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
 };
 
 
 /**
- * map<string, string> providers = 13;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,string>}
+ * @param {!Array<string>} value
+ * @return {!proto.pulumirpc.ConstructResponse.PropertyDependencies} returns this
  */
-proto.pulumirpc.ConstructRequest.prototype.getProvidersMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,string>} */ (
-      jspb.Message.getMapField(this, 13, opt_noLazyCreate,
-      null));
+proto.pulumirpc.ConstructResponse.PropertyDependencies.prototype.setUrnsList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.pulumirpc.ConstructRequest} returns this
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.pulumirpc.ConstructResponse.PropertyDependencies} returns this
  */
-proto.pulumirpc.ConstructRequest.prototype.clearProvidersMap = function() {
-  this.getProvidersMap().clear();
-  return this;};
+proto.pulumirpc.ConstructResponse.PropertyDependencies.prototype.addUrns = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
 
 
 /**
- * repeated string aliases = 14;
- * @return {!Array<string>}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pulumirpc.ConstructResponse.PropertyDependencies} returns this
  */
-proto.pulumirpc.ConstructRequest.prototype.getAliasesList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 14));
+proto.pulumirpc.ConstructResponse.PropertyDependencies.prototype.clearUrnsList = function() {
+  return this.setUrnsList([]);
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.pulumirpc.ConstructRequest} returns this
+ * optional string urn = 1;
+ * @return {string}
  */
-proto.pulumirpc.ConstructRequest.prototype.setAliasesList = function(value) {
-  return jspb.Message.setField(this, 14, value || []);
+proto.pulumirpc.ConstructResponse.prototype.getUrn = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.pulumirpc.ConstructRequest} returns this
+ * @return {!proto.pulumirpc.ConstructResponse} returns this
  */
-proto.pulumirpc.ConstructRequest.prototype.addAliases = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 14, value, opt_index);
+proto.pulumirpc.ConstructResponse.prototype.setUrn = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.pulumirpc.ConstructRequest} returns this
+ * optional google.protobuf.Struct state = 2;
+ * @return {?proto.google.protobuf.Struct}
  */
-proto.pulumirpc.ConstructRequest.prototype.clearAliasesList = function() {
-  return this.setAliasesList([]);
+proto.pulumirpc.ConstructResponse.prototype.getState = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 2));
 };
 
 
 /**
- * repeated string dependencies = 15;
- * @return {!Array<string>}
- */
-proto.pulumirpc.ConstructRequest.prototype.getDependenciesList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 15));
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.pulumirpc.ConstructResponse} returns this
+*/
+proto.pulumirpc.ConstructResponse.prototype.setState = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.pulumirpc.ConstructRequest} returns this
+ * Clears the message field making it undefined.
+ * @return {!proto.pulumirpc.ConstructResponse} returns this
  */
-proto.pulumirpc.ConstructRequest.prototype.setDependenciesList = function(value) {
-  return jspb.Message.setField(this, 15, value || []);
+proto.pulumirpc.ConstructResponse.prototype.clearState = function() {
+  return this.setState(undefined);
 };
 
 
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.pulumirpc.ConstructRequest} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.pulumirpc.ConstructRequest.prototype.addDependencies = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 15, value, opt_index);
+proto.pulumirpc.ConstructResponse.prototype.hasState = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.pulumirpc.ConstructRequest} returns this
+ * map<string, PropertyDependencies> stateDependencies = 3;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.pulumirpc.ConstructResponse.PropertyDependencies>}
  */
-proto.pulumirpc.ConstructRequest.prototype.clearDependenciesList = function() {
-  return this.setDependenciesList([]);
+proto.pulumirpc.ConstructResponse.prototype.getStatedependenciesMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.pulumirpc.ConstructResponse.PropertyDependencies>} */ (
+      jspb.Message.getMapField(this, 3, opt_noLazyCreate,
+      proto.pulumirpc.ConstructResponse.PropertyDependencies));
 };
 
 
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.pulumirpc.ConstructResponse} returns this
+ */
+proto.pulumirpc.ConstructResponse.prototype.clearStatedependenciesMap = function() {
+  this.getStatedependenciesMap().clear();
+  return this;};
+
+
 
 
 
@@ -6050,8 +7565,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pulumirpc.ConstructResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.ConstructResponse.toObject(opt_includeInstance, this);
+proto.pulumirpc.ListRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.ListRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -6060,15 +7575,14 @@ proto.pulumirpc.ConstructResponse.prototype.toObject = function(opt_includeInsta
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.ConstructResponse} msg The msg instance to transform.
+ * @param {!proto.pulumirpc.ListRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.ConstructResponse.toObject = function(includeInstance, msg) {
+proto.pulumirpc.ListRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    urn: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    state: (f = msg.getState()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
-    statedependenciesMap: (f = msg.getStatedependenciesMap()) ? f.toObject(includeInstance, proto.pulumirpc.ConstructResponse.PropertyDependencies.toObject) : []
+    type: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    filtersMap: (f = msg.getFiltersMap()) ? f.toObject(includeInstance, undefined) : []
   };
 
   if (includeInstance) {
@@ -6082,23 +7596,23 @@ proto.pulumirpc.ConstructResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.ConstructResponse}
+ * @return {!proto.pulumirpc.ListRequest}
  */
-proto.pulumirpc.ConstructResponse.deserializeBinary = function(bytes) {
+proto.pulumirpc.ListRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.ConstructResponse;
-  return proto.pulumirpc.ConstructResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pulumirpc.ListRequest;
+  return proto.pulumirpc.ListRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pulumirpc.ConstructResponse} msg The message object to deserialize into.
+ * @param {!proto.pulumirpc.ListRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.ConstructResponse}
+ * @return {!proto.pulumirpc.ListRequest}
  */
-proto.pulumirpc.ConstructResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.pulumirpc.ListRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6107,17 +7621,12 @@ proto.pulumirpc.ConstructResponse.deserializeBinaryFromReader = function(msg, re
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setUrn(value);
+      msg.setType(value);
       break;
     case 2:
-      var value = new google_protobuf_struct_pb.Struct;
-      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setState(value);
-      break;
-    case 3:
-      var value = msg.getStatedependenciesMap();
+      var value = msg.getFiltersMap();
       reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.pulumirpc.ConstructResponse.PropertyDependencies.deserializeBinaryFromReader, "", new proto.pulumirpc.ConstructResponse.PropertyDependencies());
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
          });
       break;
     default:
@@ -6133,9 +7642,9 @@ proto.pulumirpc.ConstructResponse.deserializeBinaryFromReader = function(msg, re
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pulumirpc.ConstructResponse.prototype.serializeBinary = function() {
+proto.pulumirpc.ListRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.ConstructResponse.serializeBinaryToWriter(this, writer);
+  proto.pulumirpc.ListRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6143,41 +7652,73 @@ proto.pulumirpc.ConstructResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.ConstructResponse} message
+ * @param {!proto.pulumirpc.ListRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.ConstructResponse.serializeBinaryToWriter = function(message, writer) {
+proto.pulumirpc.ListRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUrn();
+  f = message.getType();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getState();
-  if (f != null) {
-    writer.writeMessage(
-      2,
-      f,
-      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
-    );
-  }
-  f = message.getStatedependenciesMap(true);
+  f = message.getFiltersMap(true);
   if (f && f.getLength() > 0) {
-    f.serializeBinary(3, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.pulumirpc.ConstructResponse.PropertyDependencies.serializeBinaryToWriter);
+    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
   }
 };
 
 
+/**
+ * optional string type = 1;
+ * @return {string}
+ */
+proto.pulumirpc.ListRequest.prototype.getType = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.ListRequest} returns this
+ */
+proto.pulumirpc.ListRequest.prototype.setType = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * map<string, string> filters = 2;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.pulumirpc.ListRequest.prototype.getFiltersMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.pulumirpc.ListRequest} returns this
+ */
+proto.pulumirpc.ListRequest.prototype.clearFiltersMap = function() {
+  this.getFiltersMap().clear();
+  return this;};
+
+
 
 /**
  * List of repeated fields within this message type.
  * @private {!Array<number>}
  * @const
  */
-proto.pulumirpc.ConstructResponse.PropertyDependencies.repeatedFields_ = [1];
+proto.pulumirpc.ListResponse.repeatedFields_ = [1];
 
 
 
@@ -6194,8 +7735,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pulumirpc.ConstructResponse.PropertyDependencies.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.ConstructResponse.PropertyDependencies.toObject(opt_includeInstance, this);
+proto.pulumirpc.ListResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.ListResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -6204,13 +7745,13 @@ proto.pulumirpc.ConstructResponse.PropertyDependencies.prototype.toObject = func
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.ConstructResponse.PropertyDependencies} msg The msg instance to transform.
+ * @param {!proto.pulumirpc.ListResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.ConstructResponse.PropertyDependencies.toObject = function(includeInstance, msg) {
+proto.pulumirpc.ListResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    urnsList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
+    idsList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -6224,23 +7765,23 @@ proto.pulumirpc.ConstructResponse.PropertyDependencies.toObject = function(inclu
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.ConstructResponse.PropertyDependencies}
+ * @return {!proto.pulumirpc.ListResponse}
  */
-proto.pulumirpc.ConstructResponse.PropertyDependencies.deserializeBinary = function(bytes) {
+proto.pulumirpc.ListResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.ConstructResponse.PropertyDependencies;
-  return proto.pulumirpc.ConstructResponse.PropertyDependencies.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pulumirpc.ListResponse;
+  return proto.pulumirpc.ListResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pulumirpc.ConstructResponse.PropertyDependencies} msg The message object to deserialize into.
+ * @param {!proto.pulumirpc.ListResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.ConstructResponse.PropertyDependencies}
+ * @return {!proto.pulumirpc.ListResponse}
  */
-proto.pulumirpc.ConstructResponse.PropertyDependencies.deserializeBinaryFromReader = function(msg, reader) {
+proto.pulumirpc.ListResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6249,7 +7790,7 @@ proto.pulumirpc.ConstructResponse.PropertyDependencies.deserializeBinaryFromRead
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.addUrns(value);
+      msg.addIds(value);
       break;
     default:
       reader.skipField();
@@ -6264,9 +7805,9 @@ proto.pulumirpc.ConstructResponse.PropertyDependencies.deserializeBinaryFromRead
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pulumirpc.ConstructResponse.PropertyDependencies.prototype.serializeBinary = function() {
+proto.pulumirpc.ListResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.ConstructResponse.PropertyDependencies.serializeBinaryToWriter(this, writer);
+  proto.pulumirpc.ListResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6274,13 +7815,13 @@ proto.pulumirpc.ConstructResponse.PropertyDependencies.prototype.serializeBinary
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.ConstructResponse.PropertyDependencies} message
+ * @param {!proto.pulumirpc.ListResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.ConstructResponse.PropertyDependencies.serializeBinaryToWriter = function(message, writer) {
+proto.pulumirpc.ListResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUrnsList();
+  f = message.getIdsList();
   if (f.length > 0) {
     writer.writeRepeatedString(
       1,
@@ -6291,19 +7832,19 @@ proto.pulumirpc.ConstructResponse.PropertyDependencies.serializeBinaryToWriter =
 
 
 /**
- * repeated string urns = 1;
+ * repeated string ids = 1;
  * @return {!Array<string>}
  */
-proto.pulumirpc.ConstructResponse.PropertyDependencies.prototype.getUrnsList = function() {
+proto.pulumirpc.ListResponse.prototype.getIdsList = function() {
   return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
 };
 
 
 /**
  * @param {!Array<string>} value
- * @return {!proto.pulumirpc.ConstructResponse.PropertyDependencies} returns this
+ * @return {!proto.pulumirpc.ListResponse} returns this
  */
-proto.pulumirpc.ConstructResponse.PropertyDependencies.prototype.setUrnsList = function(value) {
+proto.pulumirpc.ListResponse.prototype.setIdsList = function(value) {
   return jspb.Message.setField(this, 1, value || []);
 };
 
@@ -6311,99 +7852,22 @@ proto.pulumirpc.ConstructResponse.PropertyDependencies.prototype.setUrnsList = f
 /**
  * @param {string} value
  * @param {number=} opt_index
- * @return {!proto.pulumirpc.ConstructResponse.PropertyDependencies} returns this
+ * @return {!proto.pulumirpc.ListResponse} returns this
  */
-proto.pulumirpc.ConstructResponse.PropertyDependencies.prototype.addUrns = function(value, opt_index) {
+proto.pulumirpc.ListResponse.prototype.addIds = function(value, opt_index) {
   return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.pulumirpc.ConstructResponse.PropertyDependencies} returns this
- */
-proto.pulumirpc.ConstructResponse.PropertyDependencies.prototype.clearUrnsList = function() {
-  return this.setUrnsList([]);
-};
-
-
-/**
- * optional string urn = 1;
- * @return {string}
- */
-proto.pulumirpc.ConstructResponse.prototype.getUrn = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.pulumirpc.ConstructResponse} returns this
- */
-proto.pulumirpc.ConstructResponse.prototype.setUrn = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
-
-
-/**
- * optional google.protobuf.Struct state = 2;
- * @return {?proto.google.protobuf.Struct}
- */
-proto.pulumirpc.ConstructResponse.prototype.getState = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 2));
-};
-
-
-/**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.pulumirpc.ConstructResponse} returns this
-*/
-proto.pulumirpc.ConstructResponse.prototype.setState = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.pulumirpc.ConstructResponse} returns this
- */
-proto.pulumirpc.ConstructResponse.prototype.clearState = function() {
-  return this.setState(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.pulumirpc.ConstructResponse.prototype.hasState = function() {
-  return jspb.Message.getField(this, 2) != null;
-};
-
-
-/**
- * map<string, PropertyDependencies> stateDependencies = 3;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.pulumirpc.ConstructResponse.PropertyDependencies>}
+ * @return {!proto.pulumirpc.ListResponse} returns this
  */
-proto.pulumirpc.ConstructResponse.prototype.getStatedependenciesMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.pulumirpc.ConstructResponse.PropertyDependencies>} */ (
-      jspb.Message.getMapField(this, 3, opt_noLazyCreate,
-      proto.pulumirpc.ConstructResponse.PropertyDependencies));
+proto.pulumirpc.ListResponse.prototype.clearIdsList = function() {
+  return this.setIdsList([]);
 };
 
 
-/**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.pulumirpc.ConstructResponse} returns this
- */
-proto.pulumirpc.ConstructResponse.prototype.clearStatedependenciesMap = function() {
-  this.getStatedependenciesMap().clear();
-  return this;};
-
-
 
 /**
  * List of repeated fields within this message type.
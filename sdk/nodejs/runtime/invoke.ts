@@ -23,7 +23,13 @@ import { InvokeOptions } from "../invoke";
 import * as log from "../log";
 import { Inputs, Output } from "../output";
 import { debuggablePromise } from "./debuggable";
-import { deserializeProperties, serializeProperties } from "./rpc";
+import {
+    deserializeProperties,
+    isRpcSecret,
+    serializeProperties,
+    serializePropertiesReturnDeps,
+    unwrapRpcSecret,
+} from "./rpc";
 import {
     excessiveDebugOutput,
     getMonitor,
@@ -31,7 +37,7 @@ import {
     terminateRpcs,
 } from "./settings";
 
-import { ProviderResource, Resource } from "../resource";
+import { DependencyResource, ProviderResource, Resource } from "../resource";
 import * as utils from "../utils";
 import { PushableAsyncIterable } from "./asyncIterableUtil";
 
@@ -173,6 +179,88 @@ async function invokeAsync(tok: string, props: Inputs, opts: InvokeOptions): Pro
     }
 }
 
+/**
+ * `call` dynamically calls the method, `tok`, which is offered by a provider plugin. The resource on which the method
+ * is called is passed in `props` as `__self__`, and `res` is that resource, if any. Unlike `invoke`, the inputs may
+ * contain Outputs, whose dependencies are forwarded to the provider. The result is an Output that resolves when the
+ * call finishes and that depends on `res` and on any resources the provider reports for the returned values.
+ */
+export function call<T>(tok: string, props: Inputs, res?: Resource): Output<T> {
+    const label = `Calling function: tok=${tok}`;
+    log.debug(label + (excessiveDebugOutput ? `, props=${JSON.stringify(props)}` : ``));
+
+    const result = debuggablePromise(callAsync(tok, props, res), label);
+
+    // Unknown values in the result mark the output as unknown on their own, so the output is otherwise known.
+    return new Output(
+        res ? [res] : [],
+        result.then(r => <T>r.value),
+        result.then(_ => true),
+        result.then(r => r.isSecret),
+        result.then(r => r.deps));
+}
+
+async function callAsync(tok: string, props: Inputs, res: Resource | undefined) {
+    const label = `Calling function: tok=${tok}`;
+
+    // Wait for all values to be available, and then perform the RPC.
+    const done = rpcKeepAlive();
+    try {
+        const [serialized, propertyDepsResources] = await serializePropertiesReturnDeps(`call:${tok}`, props);
+        log.debug(`Call RPC prepared: tok=${tok}` + (excessiveDebugOutput ? `, obj=${JSON.stringify(serialized)}` : ``));
+
+        // Fetch the monitor and make an RPC request. The provider for the call is the one that manages the resource
+        // on which the method is called, if any.
+        const monitor: any = getMonitor();
+
+        const provider = await ProviderResource.register(res ? res.getProvider(tok) : undefined);
+        const req = await createCallRequest(tok, serialized, propertyDepsResources, provider);
+
+        const resp: any = await debuggablePromise(new Promise((innerResolve, innerReject) =>
+            monitor.call(req, (err: grpc.ServiceError, innerResponse: any) => {
+                log.debug(`Call RPC finished: tok=${tok}; err: ${err}, resp: ${innerResponse}`);
+                if (err) {
+                    // If the monitor is unavailable, it is in the process of shutting down or has already
+                    // shut down. Don't emit an error and don't do any more RPCs, just exit.
+                    if (err.code === grpc.status.UNAVAILABLE || err.code === grpc.status.CANCELLED) {
+                        terminateRpcs();
+                        err.message = "Resource monitor is terminating";
+                        innerReject(err);
+                        return;
+                    }
+
+                    // If the RPC failed, rethrow the error with a native exception and the message that
+                    // the engine provided - it's suitable for user presentation.
+                    innerReject(new Error(err.details));
+                }
+                else {
+                    innerResolve(innerResponse);
+                }
+            })), label);
+
+        // Secretness is tracked for the result as a whole, since the individual values are not Outputs.
+        const ret = deserializeResponse(tok, resp, "Call") || {};
+        const value: any = {};
+        let isSecret = false;
+        for (const k of Object.keys(ret)) {
+            isSecret = isSecret || isRpcSecret(ret[k]);
+            value[k] = unwrapRpcSecret(ret[k]);
+        }
+
+        const deps: Resource[] = res ? [res] : [];
+        for (const [, returnDeps] of resp.getReturndependenciesMap().entries()) {
+            for (const urn of returnDeps.getUrnsList()) {
+                deps.push(new DependencyResource(urn));
+            }
+        }
+
+        return { value, isSecret, deps };
+    }
+    finally {
+        done();
+    }
+}
+
 // StreamInvokeResponse represents a (potentially infinite) streaming response to `streamInvoke`,
 // with facilities to gracefully cancel and clean up the stream.
 export class StreamInvokeResponse<T> implements AsyncIterable<T> {
@@ -206,12 +294,38 @@ function createInvokeRequest(tok: string, serialized: any, provider: string | un
     return req;
 }
 
+async function createCallRequest(tok: string, serialized: any, propertyDepsResources: Map<string, Set<Resource>>,
+                                 provider: string | undefined) {
+    if (provider !== undefined && typeof provider !== "string") {
+        throw new Error("Incorrect provider type.");
+    }
+
+    const obj = gstruct.Struct.fromJavaScript(serialized);
+
+    const req = new providerproto.CallRequest();
+    req.setTok(tok);
+    req.setArgs(obj);
+    req.setProvider(provider);
+
+    const argDependencies = req.getArgdependenciesMap();
+    for (const [key, resources] of propertyDepsResources) {
+        const urns = new Set<string>();
+        for (const resource of resources) {
+            urns.add(await resource.urn.promise());
+        }
+        const deps = new providerproto.CallRequest.ArgumentDependencies();
+        deps.setUrnsList(Array.from(urns));
+        argDependencies.set(key, deps);
+    }
+    return req;
+}
+
 function getProvider(tok: string, opts: InvokeOptions) {
     return opts.provider ? opts.provider :
            opts.parent ? opts.parent.getProvider(tok) : undefined;
 }
 
-function deserializeResponse(tok: string, resp: any): any {
+function deserializeResponse(tok: string, resp: any, operation = "Invoke"): any {
     const failures: any = resp.getFailuresList();
     if (failures && failures.length) {
         let reasons = "";
@@ -223,7 +337,7 @@ function deserializeResponse(tok: string, resp: any): any {
             reasons += `${failures[i].getReason()} (${failures[i].getProperty()})`;
         }
 
-        throw new Error(`Invoke of '${tok}' failed: ${reasons}`);
+        throw new Error(`${operation} of '${tok}' failed: ${reasons}`);
     }
 
     const ret = resp.getReturn();
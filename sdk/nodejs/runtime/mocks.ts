@@ -74,6 +74,19 @@ export class MockMonitor {
         }
     }
 
+    public async call(req: any, callback: (err: any, innerResponse: any) => void) {
+        try {
+            // Method calls are mocked in the same way as invokes: the resource on which the method is called is
+            // available to the mocks as the `__self__` argument.
+            const result = this.mocks.call(req.getTok(), deserializeProperties(req.getArgs()), req.getProvider());
+            const response = new provproto.CallResponse();
+            response.setReturn(structproto.Struct.fromJavaScript(await serializeProperties("", result)));
+            callback(null, response);
+        } catch (err) {
+            callback(err, undefined);
+        }
+    }
+
     public async readResource(req: any, callback: (err: any, innterResponse: any) => void) {
         try {
             const result = this.mocks.newResource(
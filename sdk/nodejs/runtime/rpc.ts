@@ -129,6 +129,14 @@ export async function serializeProperties(label: string, props: Inputs) {
     return result;
 }
 
+/**
+ * serializePropertiesReturnDeps walks the props object passed in, awaiting all interior promises, creating a
+ * reasonable POJO object that can be remoted over to call, and returns the resources each property depends on.
+ */
+export async function serializePropertiesReturnDeps(label: string, props: Inputs) {
+    return serializeFilteredProperties(label, props, _ => true);
+}
+
 /**
  * deserializeProperties fetches the raw outputs and deserializes them from a gRPC call result.
  */
@@ -54,6 +54,17 @@ export class StackReference extends CustomResource {
             outputs: undefined,
             secretOutputNames: undefined,
         }, { ...opts, id: stackReferenceName });
+
+        // The types that a stack records for its typed outputs are not outputs themselves. Newer engines return them
+        // separately, but older ones include them in the outputs, so remove them here.
+        this.outputs = this.outputs.apply(os => {
+            if (os === undefined || !os.hasOwnProperty("__outputTypes")) {
+                return os;
+            }
+            const filtered = { ...os };
+            delete filtered["__outputTypes"];
+            return filtered;
+        });
     }
 
     /**
@@ -60,7 +60,7 @@ func (x PropertyDiff_Kind) String() string {
 }
 
 func (PropertyDiff_Kind) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_c6a9f3c02af3d1c8, []int{11, 0}
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{13, 0}
 }
 
 type DiffResponse_DiffChanges int32
@@ -88,7 +88,7 @@ func (x DiffResponse_DiffChanges) String() string {
 }
 
 func (DiffResponse_DiffChanges) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_c6a9f3c02af3d1c8, []int{12, 0}
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{14, 0}
 }
 
 type GetSchemaRequest struct {
@@ -492,6 +492,260 @@ func (m *InvokeResponse) GetFailures() []*CheckFailure {
 	return nil
 }
 
+type CallRequest struct {
+	Tok                  string                                       `protobuf:"bytes,1,opt,name=tok,proto3" json:"tok,omitempty"`
+	Args                 *_struct.Struct                              `protobuf:"bytes,2,opt,name=args,proto3" json:"args,omitempty"`
+	ArgDependencies      map[string]*CallRequest_ArgumentDependencies `protobuf:"bytes,3,rep,name=argDependencies,proto3" json:"argDependencies,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Provider             string                                       `protobuf:"bytes,4,opt,name=provider,proto3" json:"provider,omitempty"`
+	Version              string                                       `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`
+	Project              string                                       `protobuf:"bytes,6,opt,name=project,proto3" json:"project,omitempty"`
+	Stack                string                                       `protobuf:"bytes,7,opt,name=stack,proto3" json:"stack,omitempty"`
+	Config               map[string]string                            `protobuf:"bytes,8,rep,name=config,proto3" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	DryRun               bool                                         `protobuf:"varint,9,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	Parallel             int32                                        `protobuf:"varint,10,opt,name=parallel,proto3" json:"parallel,omitempty"`
+	MonitorEndpoint      string                                       `protobuf:"bytes,11,opt,name=monitorEndpoint,proto3" json:"monitorEndpoint,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
+	XXX_unrecognized     []byte                                       `json:"-"`
+	XXX_sizecache        int32                                        `json:"-"`
+}
+
+func (m *CallRequest) Reset()         { *m = CallRequest{} }
+func (m *CallRequest) String() string { return proto.CompactTextString(m) }
+func (*CallRequest) ProtoMessage()    {}
+func (*CallRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{7}
+}
+
+func (m *CallRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CallRequest.Unmarshal(m, b)
+}
+func (m *CallRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CallRequest.Marshal(b, m, deterministic)
+}
+func (m *CallRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallRequest.Merge(m, src)
+}
+func (m *CallRequest) XXX_Size() int {
+	return xxx_messageInfo_CallRequest.Size(m)
+}
+func (m *CallRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallRequest proto.InternalMessageInfo
+
+func (m *CallRequest) GetTok() string {
+	if m != nil {
+		return m.Tok
+	}
+	return ""
+}
+
+func (m *CallRequest) GetArgs() *_struct.Struct {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *CallRequest) GetArgDependencies() map[string]*CallRequest_ArgumentDependencies {
+	if m != nil {
+		return m.ArgDependencies
+	}
+	return nil
+}
+
+func (m *CallRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *CallRequest) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *CallRequest) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+func (m *CallRequest) GetStack() string {
+	if m != nil {
+		return m.Stack
+	}
+	return ""
+}
+
+func (m *CallRequest) GetConfig() map[string]string {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (m *CallRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *CallRequest) GetParallel() int32 {
+	if m != nil {
+		return m.Parallel
+	}
+	return 0
+}
+
+func (m *CallRequest) GetMonitorEndpoint() string {
+	if m != nil {
+		return m.MonitorEndpoint
+	}
+	return ""
+}
+
+// ArgumentDependencies describes the resources that a particular argument depends on.
+type CallRequest_ArgumentDependencies struct {
+	Urns                 []string `protobuf:"bytes,1,rep,name=urns,proto3" json:"urns,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CallRequest_ArgumentDependencies) Reset()         { *m = CallRequest_ArgumentDependencies{} }
+func (m *CallRequest_ArgumentDependencies) String() string { return proto.CompactTextString(m) }
+func (*CallRequest_ArgumentDependencies) ProtoMessage()    {}
+func (*CallRequest_ArgumentDependencies) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{7, 0}
+}
+
+func (m *CallRequest_ArgumentDependencies) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CallRequest_ArgumentDependencies.Unmarshal(m, b)
+}
+func (m *CallRequest_ArgumentDependencies) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CallRequest_ArgumentDependencies.Marshal(b, m, deterministic)
+}
+func (m *CallRequest_ArgumentDependencies) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallRequest_ArgumentDependencies.Merge(m, src)
+}
+func (m *CallRequest_ArgumentDependencies) XXX_Size() int {
+	return xxx_messageInfo_CallRequest_ArgumentDependencies.Size(m)
+}
+func (m *CallRequest_ArgumentDependencies) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallRequest_ArgumentDependencies.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallRequest_ArgumentDependencies proto.InternalMessageInfo
+
+func (m *CallRequest_ArgumentDependencies) GetUrns() []string {
+	if m != nil {
+		return m.Urns
+	}
+	return nil
+}
+
+type CallResponse struct {
+	Return               *_struct.Struct                             `protobuf:"bytes,1,opt,name=return,proto3" json:"return,omitempty"`
+	ReturnDependencies   map[string]*CallResponse_ReturnDependencies `protobuf:"bytes,2,rep,name=returnDependencies,proto3" json:"returnDependencies,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Failures             []*CheckFailure                             `protobuf:"bytes,3,rep,name=failures,proto3" json:"failures,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                    `json:"-"`
+	XXX_unrecognized     []byte                                      `json:"-"`
+	XXX_sizecache        int32                                       `json:"-"`
+}
+
+func (m *CallResponse) Reset()         { *m = CallResponse{} }
+func (m *CallResponse) String() string { return proto.CompactTextString(m) }
+func (*CallResponse) ProtoMessage()    {}
+func (*CallResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{8}
+}
+
+func (m *CallResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CallResponse.Unmarshal(m, b)
+}
+func (m *CallResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CallResponse.Marshal(b, m, deterministic)
+}
+func (m *CallResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallResponse.Merge(m, src)
+}
+func (m *CallResponse) XXX_Size() int {
+	return xxx_messageInfo_CallResponse.Size(m)
+}
+func (m *CallResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallResponse proto.InternalMessageInfo
+
+func (m *CallResponse) GetReturn() *_struct.Struct {
+	if m != nil {
+		return m.Return
+	}
+	return nil
+}
+
+func (m *CallResponse) GetReturnDependencies() map[string]*CallResponse_ReturnDependencies {
+	if m != nil {
+		return m.ReturnDependencies
+	}
+	return nil
+}
+
+func (m *CallResponse) GetFailures() []*CheckFailure {
+	if m != nil {
+		return m.Failures
+	}
+	return nil
+}
+
+// ReturnDependencies describes the resources that a particular return value depends on.
+type CallResponse_ReturnDependencies struct {
+	Urns                 []string `protobuf:"bytes,1,rep,name=urns,proto3" json:"urns,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CallResponse_ReturnDependencies) Reset()         { *m = CallResponse_ReturnDependencies{} }
+func (m *CallResponse_ReturnDependencies) String() string { return proto.CompactTextString(m) }
+func (*CallResponse_ReturnDependencies) ProtoMessage()    {}
+func (*CallResponse_ReturnDependencies) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{8, 0}
+}
+
+func (m *CallResponse_ReturnDependencies) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CallResponse_ReturnDependencies.Unmarshal(m, b)
+}
+func (m *CallResponse_ReturnDependencies) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CallResponse_ReturnDependencies.Marshal(b, m, deterministic)
+}
+func (m *CallResponse_ReturnDependencies) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallResponse_ReturnDependencies.Merge(m, src)
+}
+func (m *CallResponse_ReturnDependencies) XXX_Size() int {
+	return xxx_messageInfo_CallResponse_ReturnDependencies.Size(m)
+}
+func (m *CallResponse_ReturnDependencies) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallResponse_ReturnDependencies.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallResponse_ReturnDependencies proto.InternalMessageInfo
+
+func (m *CallResponse_ReturnDependencies) GetUrns() []string {
+	if m != nil {
+		return m.Urns
+	}
+	return nil
+}
+
 type CheckRequest struct {
 	Urn                  string          `protobuf:"bytes,1,opt,name=urn,proto3" json:"urn,omitempty"`
 	Olds                 *_struct.Struct `protobuf:"bytes,2,opt,name=olds,proto3" json:"olds,omitempty"`
@@ -505,7 +759,7 @@ func (m *CheckRequest) Reset()         { *m = CheckRequest{} }
 func (m *CheckRequest) String() string { return proto.CompactTextString(m) }
 func (*CheckRequest) ProtoMessage()    {}
 func (*CheckRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a9f3c02af3d1c8, []int{7}
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{9}
 }
 
 func (m *CheckRequest) XXX_Unmarshal(b []byte) error {
@@ -559,7 +813,7 @@ func (m *CheckResponse) Reset()         { *m = CheckResponse{} }
 func (m *CheckResponse) String() string { return proto.CompactTextString(m) }
 func (*CheckResponse) ProtoMessage()    {}
 func (*CheckResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a9f3c02af3d1c8, []int{8}
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{10}
 }
 
 func (m *CheckResponse) XXX_Unmarshal(b []byte) error {
@@ -606,7 +860,7 @@ func (m *CheckFailure) Reset()         { *m = CheckFailure{} }
 func (m *CheckFailure) String() string { return proto.CompactTextString(m) }
 func (*CheckFailure) ProtoMessage()    {}
 func (*CheckFailure) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a9f3c02af3d1c8, []int{9}
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{11}
 }
 
 func (m *CheckFailure) XXX_Unmarshal(b []byte) error {
@@ -656,7 +910,7 @@ func (m *DiffRequest) Reset()         { *m = DiffRequest{} }
 func (m *DiffRequest) String() string { return proto.CompactTextString(m) }
 func (*DiffRequest) ProtoMessage()    {}
 func (*DiffRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a9f3c02af3d1c8, []int{10}
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{12}
 }
 
 func (m *DiffRequest) XXX_Unmarshal(b []byte) error {
@@ -724,7 +978,7 @@ func (m *PropertyDiff) Reset()         { *m = PropertyDiff{} }
 func (m *PropertyDiff) String() string { return proto.CompactTextString(m) }
 func (*PropertyDiff) ProtoMessage()    {}
 func (*PropertyDiff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a9f3c02af3d1c8, []int{11}
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{13}
 }
 
 func (m *PropertyDiff) XXX_Unmarshal(b []byte) error {
@@ -806,7 +1060,7 @@ func (m *DiffResponse) Reset()         { *m = DiffResponse{} }
 func (m *DiffResponse) String() string { return proto.CompactTextString(m) }
 func (*DiffResponse) ProtoMessage()    {}
 func (*DiffResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a9f3c02af3d1c8, []int{12}
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{14}
 }
 
 func (m *DiffResponse) XXX_Unmarshal(b []byte) error {
@@ -890,7 +1144,7 @@ func (m *CreateRequest) Reset()         { *m = CreateRequest{} }
 func (m *CreateRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateRequest) ProtoMessage()    {}
 func (*CreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a9f3c02af3d1c8, []int{13}
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{15}
 }
 
 func (m *CreateRequest) XXX_Unmarshal(b []byte) error {
@@ -951,7 +1205,7 @@ func (m *CreateResponse) Reset()         { *m = CreateResponse{} }
 func (m *CreateResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateResponse) ProtoMessage()    {}
 func (*CreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a9f3c02af3d1c8, []int{14}
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{16}
 }
 
 func (m *CreateResponse) XXX_Unmarshal(b []byte) error {
@@ -1000,7 +1254,7 @@ func (m *ReadRequest) Reset()         { *m = ReadRequest{} }
 func (m *ReadRequest) String() string { return proto.CompactTextString(m) }
 func (*ReadRequest) ProtoMessage()    {}
 func (*ReadRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a9f3c02af3d1c8, []int{15}
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{17}
 }
 
 func (m *ReadRequest) XXX_Unmarshal(b []byte) error {
@@ -1062,7 +1316,7 @@ func (m *ReadResponse) Reset()         { *m = ReadResponse{} }
 func (m *ReadResponse) String() string { return proto.CompactTextString(m) }
 func (*ReadResponse) ProtoMessage()    {}
 func (*ReadResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a9f3c02af3d1c8, []int{16}
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{18}
 }
 
 func (m *ReadResponse) XXX_Unmarshal(b []byte) error {
@@ -1121,7 +1375,7 @@ func (m *UpdateRequest) Reset()         { *m = UpdateRequest{} }
 func (m *UpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateRequest) ProtoMessage()    {}
 func (*UpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a9f3c02af3d1c8, []int{17}
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{19}
 }
 
 func (m *UpdateRequest) XXX_Unmarshal(b []byte) error {
@@ -1202,7 +1456,7 @@ func (m *UpdateResponse) Reset()         { *m = UpdateResponse{} }
 func (m *UpdateResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdateResponse) ProtoMessage()    {}
 func (*UpdateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a9f3c02af3d1c8, []int{18}
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{20}
 }
 
 func (m *UpdateResponse) XXX_Unmarshal(b []byte) error {
@@ -1244,7 +1498,7 @@ func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
 func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRequest) ProtoMessage()    {}
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a9f3c02af3d1c8, []int{19}
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{21}
 }
 
 func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
@@ -1318,7 +1572,7 @@ func (m *ConstructRequest) Reset()         { *m = ConstructRequest{} }
 func (m *ConstructRequest) String() string { return proto.CompactTextString(m) }
 func (*ConstructRequest) ProtoMessage()    {}
 func (*ConstructRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a9f3c02af3d1c8, []int{20}
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{22}
 }
 
 func (m *ConstructRequest) XXX_Unmarshal(b []byte) error {
@@ -1456,7 +1710,7 @@ func (m *ConstructRequest_PropertyDependencies) Reset()         { *m = Construct
 func (m *ConstructRequest_PropertyDependencies) String() string { return proto.CompactTextString(m) }
 func (*ConstructRequest_PropertyDependencies) ProtoMessage()    {}
 func (*ConstructRequest_PropertyDependencies) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a9f3c02af3d1c8, []int{20, 0}
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{22, 0}
 }
 
 func (m *ConstructRequest_PropertyDependencies) XXX_Unmarshal(b []byte) error {
@@ -1497,7 +1751,7 @@ func (m *ConstructResponse) Reset()         { *m = ConstructResponse{} }
 func (m *ConstructResponse) String() string { return proto.CompactTextString(m) }
 func (*ConstructResponse) ProtoMessage()    {}
 func (*ConstructResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a9f3c02af3d1c8, []int{21}
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{23}
 }
 
 func (m *ConstructResponse) XXX_Unmarshal(b []byte) error {
@@ -1553,7 +1807,7 @@ func (m *ConstructResponse_PropertyDependencies) Reset() {
 func (m *ConstructResponse_PropertyDependencies) String() string { return proto.CompactTextString(m) }
 func (*ConstructResponse_PropertyDependencies) ProtoMessage()    {}
 func (*ConstructResponse_PropertyDependencies) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a9f3c02af3d1c8, []int{21, 0}
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{23, 0}
 }
 
 func (m *ConstructResponse_PropertyDependencies) XXX_Unmarshal(b []byte) error {
@@ -1581,6 +1835,92 @@ func (m *ConstructResponse_PropertyDependencies) GetUrns() []string {
 	return nil
 }
 
+type ListRequest struct {
+	Type                 string            `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Filters              map[string]string `protobuf:"bytes,2,rep,name=filters,proto3" json:"filters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListRequest) Reset()         { *m = ListRequest{} }
+func (m *ListRequest) String() string { return proto.CompactTextString(m) }
+func (*ListRequest) ProtoMessage()    {}
+func (*ListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{24}
+}
+
+func (m *ListRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRequest.Unmarshal(m, b)
+}
+func (m *ListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRequest.Marshal(b, m, deterministic)
+}
+func (m *ListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRequest.Merge(m, src)
+}
+func (m *ListRequest) XXX_Size() int {
+	return xxx_messageInfo_ListRequest.Size(m)
+}
+func (m *ListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRequest proto.InternalMessageInfo
+
+func (m *ListRequest) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ListRequest) GetFilters() map[string]string {
+	if m != nil {
+		return m.Filters
+	}
+	return nil
+}
+
+type ListResponse struct {
+	Ids                  []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListResponse) Reset()         { *m = ListResponse{} }
+func (m *ListResponse) String() string { return proto.CompactTextString(m) }
+func (*ListResponse) ProtoMessage()    {}
+func (*ListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{25}
+}
+
+func (m *ListResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListResponse.Unmarshal(m, b)
+}
+func (m *ListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListResponse.Marshal(b, m, deterministic)
+}
+func (m *ListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListResponse.Merge(m, src)
+}
+func (m *ListResponse) XXX_Size() int {
+	return xxx_messageInfo_ListResponse.Size(m)
+}
+func (m *ListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListResponse proto.InternalMessageInfo
+
+func (m *ListResponse) GetIds() []string {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
 // ErrorResourceInitFailed is sent as a Detail `ResourceProvider.{Create, Update}` fail because a
 // resource was created successfully, but failed to initialize.
 type ErrorResourceInitFailed struct {
@@ -1597,7 +1937,7 @@ func (m *ErrorResourceInitFailed) Reset()         { *m = ErrorResourceInitFailed
 func (m *ErrorResourceInitFailed) String() string { return proto.CompactTextString(m) }
 func (*ErrorResourceInitFailed) ProtoMessage()    {}
 func (*ErrorResourceInitFailed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a9f3c02af3d1c8, []int{22}
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{26}
 }
 
 func (m *ErrorResourceInitFailed) XXX_Unmarshal(b []byte) error {
@@ -1658,6 +1998,13 @@ func init() {
 	proto.RegisterType((*ConfigureErrorMissingKeys_MissingKey)(nil), "pulumirpc.ConfigureErrorMissingKeys.MissingKey")
 	proto.RegisterType((*InvokeRequest)(nil), "pulumirpc.InvokeRequest")
 	proto.RegisterType((*InvokeResponse)(nil), "pulumirpc.InvokeResponse")
+	proto.RegisterType((*CallRequest)(nil), "pulumirpc.CallRequest")
+	proto.RegisterMapType((map[string]*CallRequest_ArgumentDependencies)(nil), "pulumirpc.CallRequest.ArgDependenciesEntry")
+	proto.RegisterMapType((map[string]string)(nil), "pulumirpc.CallRequest.ConfigEntry")
+	proto.RegisterType((*CallRequest_ArgumentDependencies)(nil), "pulumirpc.CallRequest.ArgumentDependencies")
+	proto.RegisterType((*CallResponse)(nil), "pulumirpc.CallResponse")
+	proto.RegisterMapType((map[string]*CallResponse_ReturnDependencies)(nil), "pulumirpc.CallResponse.ReturnDependenciesEntry")
+	proto.RegisterType((*CallResponse_ReturnDependencies)(nil), "pulumirpc.CallResponse.ReturnDependencies")
 	proto.RegisterType((*CheckRequest)(nil), "pulumirpc.CheckRequest")
 	proto.RegisterType((*CheckResponse)(nil), "pulumirpc.CheckResponse")
 	proto.RegisterType((*CheckFailure)(nil), "pulumirpc.CheckFailure")
@@ -1680,118 +2027,137 @@ func init() {
 	proto.RegisterType((*ConstructResponse)(nil), "pulumirpc.ConstructResponse")
 	proto.RegisterMapType((map[string]*ConstructResponse_PropertyDependencies)(nil), "pulumirpc.ConstructResponse.StateDependenciesEntry")
 	proto.RegisterType((*ConstructResponse_PropertyDependencies)(nil), "pulumirpc.ConstructResponse.PropertyDependencies")
+	proto.RegisterType((*ListRequest)(nil), "pulumirpc.ListRequest")
+	proto.RegisterMapType((map[string]string)(nil), "pulumirpc.ListRequest.FiltersEntry")
+	proto.RegisterType((*ListResponse)(nil), "pulumirpc.ListResponse")
 	proto.RegisterType((*ErrorResourceInitFailed)(nil), "pulumirpc.ErrorResourceInitFailed")
 }
 
 func init() { proto.RegisterFile("provider.proto", fileDescriptor_c6a9f3c02af3d1c8) }
 
 var fileDescriptor_c6a9f3c02af3d1c8 = []byte{
-	// 1670 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x72, 0x1b, 0x45,
-	0x10, 0xf6, 0x4a, 0xb2, 0x6c, 0xb5, 0x7e, 0x22, 0x0f, 0xc1, 0x51, 0x14, 0x1f, 0x5c, 0x0b, 0x55,
-	0x98, 0x84, 0xc8, 0xc6, 0x39, 0x40, 0x52, 0x4e, 0x05, 0xdb, 0x92, 0x8d, 0x2b, 0x89, 0x63, 0xd6,
-	0x09, 0x3f, 0xa7, 0x64, 0xb3, 0x1a, 0xc9, 0x8b, 0xa5, 0xdd, 0x65, 0x76, 0x56, 0x29, 0x73, 0xe6,
-	0xc0, 0x05, 0xae, 0x14, 0x0f, 0x01, 0x54, 0xf1, 0x04, 0x3c, 0x08, 0x1c, 0x79, 0x00, 0x8a, 0x17,
-	0xa0, 0xe6, 0x6f, 0x3d, 0x23, 0xad, 0x7f, 0x49, 0xc1, 0x6d, 0x7b, 0xba, 0xa7, 0xa7, 0xfb, 0x9b,
-	0x9e, 0xfe, 0x59, 0xa8, 0x45, 0x24, 0x1c, 0xf9, 0x5d, 0x4c, 0x5a, 0x11, 0x09, 0x69, 0x88, 0x4a,
-	0x51, 0x32, 0x48, 0x86, 0x3e, 0x89, 0xbc, 0x66, 0x25, 0x1a, 0x24, 0x7d, 0x3f, 0x10, 0x8c, 0xe6,
-	0x8d, 0x7e, 0x18, 0xf6, 0x07, 0x78, 0x99, 0x53, 0x2f, 0x93, 0xde, 0x32, 0x1e, 0x46, 0xf4, 0x48,
-	0x32, 0x17, 0xc6, 0x99, 0x31, 0x25, 0x89, 0x47, 0x05, 0xd7, 0x7e, 0x0f, 0xea, 0xdb, 0x98, 0xee,
-	0x7b, 0x07, 0x78, 0xe8, 0x3a, 0xf8, 0xab, 0x04, 0xc7, 0x14, 0x35, 0x60, 0x66, 0x84, 0x49, 0xec,
-	0x87, 0x41, 0xc3, 0x5a, 0xb4, 0x96, 0xa6, 0x1d, 0x45, 0xda, 0xb7, 0x60, 0x4e, 0x93, 0x8e, 0xa3,
-	0x30, 0x88, 0x31, 0x9a, 0x87, 0x62, 0xcc, 0x57, 0xb8, 0x74, 0xc9, 0x91, 0x94, 0xfd, 0x43, 0x0e,
-	0xea, 0x9b, 0x61, 0xd0, 0xf3, 0xfb, 0x09, 0xc1, 0x4a, 0xf7, 0xc7, 0x50, 0x1a, 0xb9, 0xc4, 0x77,
-	0x5f, 0x0e, 0x70, 0xdc, 0xb0, 0x16, 0xf3, 0x4b, 0xe5, 0xd5, 0x9b, 0xad, 0xd4, 0xaf, 0xd6, 0xb8,
-	0x7c, 0xeb, 0x53, 0x25, 0xdc, 0x09, 0x28, 0x39, 0x72, 0x8e, 0x37, 0xa3, 0x5b, 0x50, 0x70, 0x49,
-	0x3f, 0x6e, 0xe4, 0x16, 0xad, 0xa5, 0xf2, 0xea, 0xb5, 0x96, 0x70, 0xb3, 0xa5, 0xdc, 0x6c, 0xed,
-	0x73, 0x37, 0x1d, 0x2e, 0x84, 0xde, 0x86, 0xaa, 0xeb, 0x79, 0x38, 0xa2, 0xfb, 0xd8, 0x23, 0x98,
-	0xc6, 0x8d, 0xfc, 0xa2, 0xb5, 0x34, 0xeb, 0x98, 0x8b, 0x68, 0x09, 0xae, 0x88, 0x05, 0x07, 0xc7,
-	0x61, 0x42, 0x3c, 0x1c, 0x37, 0x0a, 0x5c, 0x6e, 0x7c, 0xb9, 0xb9, 0x06, 0x35, 0xd3, 0x32, 0x54,
-	0x87, 0xfc, 0x21, 0x3e, 0x92, 0x10, 0xb0, 0x4f, 0x74, 0x15, 0xa6, 0x47, 0xee, 0x20, 0xc1, 0xdc,
-	0xc2, 0x92, 0x23, 0x88, 0x7b, 0xb9, 0x0f, 0x2d, 0xfb, 0x3b, 0x0b, 0xe6, 0x34, 0x4f, 0x25, 0x8e,
-	0x13, 0x36, 0x5a, 0x27, 0xd8, 0x18, 0x27, 0x51, 0x14, 0x12, 0x1a, 0xef, 0x11, 0x3c, 0xf2, 0xf1,
-	0x2b, 0xae, 0x7f, 0xd6, 0x19, 0x5f, 0xce, 0xf2, 0x26, 0x9f, 0xe9, 0x8d, 0xfd, 0xab, 0x05, 0xd7,
-	0x53, 0x7b, 0x3a, 0x84, 0x84, 0xe4, 0xb1, 0x1f, 0xc7, 0x7e, 0xd0, 0x7f, 0x88, 0x8f, 0x62, 0xf4,
-	0x09, 0x94, 0x87, 0xc7, 0xa4, 0xbc, 0xb4, 0xe5, 0xac, 0x4b, 0x1b, 0xdf, 0xda, 0x3a, 0xfe, 0x76,
-	0x74, 0x1d, 0xcd, 0x0d, 0x80, 0x63, 0x16, 0x42, 0x50, 0x08, 0xdc, 0x21, 0x96, 0xd8, 0xf1, 0x6f,
-	0xb4, 0x08, 0xe5, 0x2e, 0x8e, 0x3d, 0xe2, 0x47, 0x94, 0xc5, 0xa1, 0x80, 0x50, 0x5f, 0xb2, 0x7f,
-	0xb6, 0xa0, 0xba, 0x13, 0x8c, 0xc2, 0xc3, 0x34, 0xb6, 0xea, 0x90, 0xa7, 0xe1, 0xa1, 0xba, 0x02,
-	0x1a, 0x1e, 0x5e, 0x2c, 0x46, 0x9a, 0x30, 0xab, 0x1e, 0x1c, 0x07, 0xaa, 0xe4, 0xa4, 0xb4, 0xfe,
-	0x24, 0x0a, 0x9c, 0xa5, 0xc8, 0x2c, 0x94, 0xa7, 0xb3, 0x51, 0x1e, 0x41, 0x4d, 0xd9, 0x2b, 0x6f,
-	0x7c, 0x19, 0x8a, 0x04, 0xd3, 0x84, 0x88, 0x77, 0x76, 0x8a, 0x81, 0x52, 0x0c, 0xdd, 0x81, 0xd9,
-	0x9e, 0xeb, 0x0f, 0x12, 0x82, 0x99, 0x4f, 0x79, 0xbe, 0x45, 0xbb, 0x87, 0x03, 0xec, 0x1d, 0x6e,
-	0x09, 0xbe, 0x93, 0x0a, 0xda, 0x5f, 0x43, 0x85, 0x73, 0x34, 0x98, 0xd4, 0x91, 0x25, 0x87, 0x7d,
-	0x32, 0x98, 0xc2, 0x41, 0xf7, 0x6c, 0x98, 0x98, 0x10, 0x13, 0x0e, 0xf0, 0x2b, 0x11, 0x4b, 0xa7,
-	0x09, 0x33, 0x21, 0x3b, 0x81, 0xaa, 0x3c, 0xfb, 0xd8, 0x65, 0x3f, 0x88, 0x12, 0x19, 0xdd, 0xa7,
-	0xb9, 0x2c, 0xc4, 0x2e, 0xe7, 0xf2, 0x86, 0x74, 0x59, 0x72, 0xe4, 0xd5, 0x46, 0x98, 0x50, 0xf5,
-	0x42, 0x53, 0x9a, 0xa5, 0x2f, 0x82, 0xdd, 0x38, 0x0d, 0x32, 0x49, 0xd9, 0xbf, 0x58, 0x50, 0x6e,
-	0xfb, 0xbd, 0x9e, 0x82, 0xad, 0x06, 0x39, 0xbf, 0x2b, 0x77, 0xe7, 0xfc, 0xae, 0x82, 0x31, 0x37,
-	0x09, 0x63, 0xfe, 0x22, 0x30, 0x16, 0xce, 0x01, 0x23, 0x4b, 0x0d, 0x7e, 0x3f, 0x08, 0x09, 0xde,
-	0x3c, 0x70, 0x83, 0x3e, 0x0f, 0xb1, 0xfc, 0x52, 0xc9, 0x31, 0x17, 0xed, 0xdf, 0x2c, 0xa8, 0xec,
-	0x49, 0xb7, 0x98, 0xe5, 0x68, 0x05, 0x0a, 0x87, 0x7e, 0x20, 0x8c, 0xae, 0xad, 0x2e, 0x68, 0xb8,
-	0xe9, 0x62, 0xad, 0x87, 0x7e, 0xd0, 0x75, 0xb8, 0x24, 0x5a, 0x80, 0x12, 0xc7, 0x9d, 0xad, 0xcb,
-	0xbc, 0x72, 0xbc, 0x60, 0xbf, 0x80, 0x02, 0x93, 0x45, 0x33, 0x90, 0x5f, 0x6f, 0xb7, 0xeb, 0x53,
-	0xe8, 0x0a, 0x94, 0xd7, 0xdb, 0xed, 0xe7, 0x4e, 0x67, 0xef, 0xd1, 0xfa, 0x66, 0xa7, 0x6e, 0x21,
-	0x80, 0x62, 0xbb, 0xf3, 0xa8, 0xf3, 0xb4, 0x53, 0xcf, 0x21, 0x04, 0x35, 0xf1, 0x9d, 0xf2, 0xf3,
-	0x8c, 0xff, 0x6c, 0xaf, 0xbd, 0xfe, 0xb4, 0x53, 0x2f, 0x30, 0xbe, 0xf8, 0x4e, 0xf9, 0xd3, 0xf6,
-	0x1f, 0x79, 0xa8, 0x08, 0xd0, 0x65, 0xbc, 0x34, 0x61, 0x96, 0xe0, 0x68, 0xe0, 0x7a, 0xb2, 0x5c,
-	0x94, 0x9c, 0x94, 0x66, 0x8f, 0x32, 0xa6, 0xa2, 0x92, 0xe4, 0x38, 0x4b, 0x91, 0x68, 0x05, 0xde,
-	0xe8, 0xe2, 0x01, 0xa6, 0x78, 0x03, 0xf7, 0x42, 0x96, 0x62, 0xf9, 0x0e, 0x99, 0xfe, 0xb2, 0x58,
-	0xe8, 0x3e, 0xcc, 0x78, 0x12, 0xdb, 0x02, 0x47, 0xeb, 0x2d, 0x0d, 0x2d, 0xdd, 0x22, 0x4e, 0x48,
-	0xc4, 0x1d, 0xb5, 0x87, 0xe5, 0xfa, 0xae, 0xdf, 0xeb, 0xa9, 0x8b, 0x11, 0x04, 0x7a, 0x0c, 0x95,
-	0x2e, 0xa6, 0xae, 0x3f, 0xc0, 0x5d, 0x0e, 0x68, 0x91, 0xc7, 0xef, 0xbb, 0x27, 0x6a, 0xd6, 0x64,
-	0x45, 0xb9, 0x33, 0xb6, 0xb3, 0x54, 0x73, 0xe0, 0xc6, 0xba, 0x54, 0x63, 0x46, 0xa4, 0x9a, 0xb1,
-	0xe5, 0xe6, 0xe7, 0x30, 0x37, 0xa1, 0x2c, 0xa3, 0x42, 0xdd, 0xd6, 0x2b, 0x94, 0xf9, 0xb0, 0xf4,
-	0x00, 0xd1, 0x4b, 0xd7, 0x7d, 0xf1, 0x28, 0x24, 0x00, 0xa8, 0x0e, 0x95, 0xf6, 0xce, 0xd6, 0xd6,
-	0xf3, 0x67, 0xbb, 0x0f, 0x77, 0x9f, 0x7c, 0xb6, 0x5b, 0x9f, 0x42, 0x55, 0x28, 0xf1, 0x95, 0xdd,
-	0x27, 0xbb, 0x2c, 0x20, 0x14, 0xb9, 0xff, 0xe4, 0x71, 0xa7, 0x9e, 0xb3, 0xbf, 0xb7, 0xa0, 0xba,
-	0x49, 0xb0, 0x4b, 0xf1, 0xc9, 0xd9, 0xe8, 0x03, 0x00, 0xf9, 0x38, 0x7d, 0x7c, 0x66, 0x4e, 0xd2,
-	0x44, 0x59, 0x3c, 0x50, 0x7f, 0x88, 0xc3, 0x84, 0xf2, 0x9b, 0xb6, 0x1c, 0x45, 0x32, 0x4e, 0x24,
-	0x8b, 0xa5, 0x28, 0xe8, 0x8a, 0xb4, 0xbf, 0x80, 0x9a, 0xb2, 0x47, 0x46, 0xdc, 0xf8, 0x3b, 0xbf,
-	0xac, 0x39, 0xf6, 0x8f, 0x16, 0x94, 0x1d, 0xec, 0x76, 0xcf, 0x9f, 0x40, 0xcc, 0xa3, 0xf2, 0xe7,
-	0xf7, 0xfc, 0x38, 0xab, 0x16, 0xce, 0x95, 0x55, 0xed, 0x6f, 0x2d, 0xa8, 0x08, 0xdb, 0x5e, 0xb3,
-	0xd7, 0x9a, 0x29, 0xf9, 0xf3, 0x99, 0xf2, 0xa7, 0x05, 0xd5, 0x67, 0x51, 0x57, 0x0b, 0x89, 0xff,
-	0x33, 0xd3, 0x6a, 0x31, 0x34, 0x6d, 0xc6, 0xd0, 0x44, 0x0e, 0x2e, 0x66, 0xe4, 0x60, 0x3d, 0xd2,
-	0x66, 0xcc, 0x48, 0xdb, 0x81, 0x9a, 0x72, 0x53, 0x62, 0x6e, 0x62, 0x6c, 0x9d, 0x3f, 0xb2, 0xbe,
-	0xb1, 0xa0, 0xda, 0xe6, 0x49, 0xec, 0x3f, 0x88, 0x2d, 0x0d, 0x91, 0x82, 0x81, 0x88, 0xfd, 0x77,
-	0x91, 0x37, 0xf8, 0x62, 0x9e, 0xd0, 0x86, 0x87, 0x88, 0x84, 0x5f, 0x62, 0x8f, 0x4a, 0x73, 0x14,
-	0xc9, 0x72, 0x64, 0x4c, 0x5d, 0xef, 0x50, 0xf5, 0xc3, 0x9c, 0x40, 0x0f, 0xa0, 0xe8, 0xf1, 0xfe,
-	0xb1, 0x91, 0xe7, 0xd9, 0xf1, 0x1d, 0xb3, 0xb1, 0x34, 0x94, 0xcb, 0x4e, 0x53, 0xe4, 0x46, 0xb9,
-	0x8d, 0xd5, 0xef, 0x2e, 0x39, 0x72, 0x92, 0x40, 0x3e, 0x6d, 0x49, 0xf1, 0x9a, 0xef, 0x12, 0x77,
-	0x30, 0xc0, 0x03, 0x7e, 0x95, 0xd3, 0x4e, 0x4a, 0xb3, 0x4c, 0x3a, 0x0c, 0x03, 0x9f, 0x86, 0xa4,
-	0x13, 0x74, 0xa3, 0xd0, 0x0f, 0x68, 0xa3, 0xc8, 0x8d, 0x1a, 0x5f, 0x66, 0xbd, 0x29, 0x3d, 0x8a,
-	0x30, 0xbf, 0xcc, 0x92, 0xc3, 0xbf, 0xd3, 0x7e, 0x75, 0x56, 0xeb, 0x57, 0xe7, 0xa1, 0x18, 0xb9,
-	0x04, 0x07, 0xb4, 0x51, 0x12, 0x5d, 0x84, 0xa0, 0xb4, 0xe7, 0x00, 0xe7, 0xeb, 0x77, 0x5e, 0xc0,
-	0x9c, 0x28, 0xb8, 0x38, 0xc2, 0x41, 0x17, 0x07, 0x1e, 0xbb, 0xae, 0x32, 0x87, 0x66, 0xf5, 0x34,
-	0x68, 0x76, 0xc6, 0x37, 0x09, 0x94, 0x26, 0x95, 0xc9, 0x1b, 0xa2, 0xec, 0x86, 0x2a, 0x2a, 0x44,
-	0x39, 0xc9, 0x86, 0x33, 0xd5, 0xf1, 0xc6, 0x8d, 0x6a, 0xd6, 0x70, 0x66, 0x9e, 0xb9, 0xa7, 0x84,
-	0xe5, 0x70, 0x96, 0x6e, 0x66, 0x67, 0xb8, 0x03, 0xdf, 0x8d, 0x71, 0xdc, 0xa8, 0x89, 0xd2, 0x2c,
-	0x49, 0x64, 0xb3, 0x9a, 0xa8, 0xb9, 0x76, 0x85, 0xb3, 0x8d, 0xb5, 0xe6, 0x4d, 0xb8, 0x9a, 0xd6,
-	0x1f, 0xdd, 0x72, 0x04, 0x85, 0x84, 0x04, 0xaa, 0x11, 0xe0, 0xdf, 0xcd, 0xbb, 0x50, 0xd6, 0xa2,
-	0xe2, 0x22, 0x63, 0x58, 0x73, 0x04, 0xf3, 0xd9, 0xa8, 0x65, 0x68, 0xd9, 0x32, 0x4b, 0xe5, 0xca,
-	0x19, 0xb0, 0x4c, 0xd8, 0xae, 0x9f, 0xbb, 0x06, 0x35, 0x13, 0xb9, 0x0b, 0x0d, 0x8f, 0xbf, 0xe7,
-	0xf8, 0xf0, 0xa8, 0x8e, 0x94, 0xb9, 0x64, 0xb2, 0x8c, 0xde, 0xe6, 0xcf, 0x8d, 0xe2, 0xb3, 0x92,
-	0xb7, 0x90, 0x42, 0x2e, 0xcc, 0xf1, 0x0f, 0x23, 0xee, 0xc4, 0x93, 0xbc, 0x93, 0xed, 0xac, 0xec,
-	0x5a, 0xf6, 0xc7, 0x77, 0xc9, 0xc0, 0x9b, 0xd0, 0x76, 0xa1, 0x6b, 0x7d, 0x05, 0xf3, 0xd9, 0x8a,
-	0x33, 0xb0, 0xda, 0x36, 0xef, 0xe6, 0xfd, 0x53, 0xcd, 0x3d, 0xe3, 0x72, 0xec, 0x9f, 0x2c, 0xb8,
-	0xc6, 0xe7, 0x58, 0x35, 0xb8, 0xed, 0x04, 0x3e, 0xdd, 0xe2, 0xad, 0xd4, 0xeb, 0x2b, 0x92, 0x0d,
-	0x98, 0x11, 0x53, 0x86, 0x80, 0xb8, 0xe4, 0x28, 0xf2, 0xc2, 0x95, 0x7c, 0xf5, 0xaf, 0x19, 0xa8,
-	0x2b, 0x53, 0x55, 0x54, 0xb1, 0x87, 0x9c, 0xfe, 0xa7, 0x41, 0x37, 0x34, 0x3c, 0xc6, 0xff, 0xf5,
-	0x34, 0x17, 0xb2, 0x99, 0x02, 0x2c, 0x7b, 0x0a, 0x6d, 0x40, 0x99, 0x4f, 0x52, 0xe2, 0x8d, 0xa1,
-	0x89, 0xd9, 0x4b, 0xe9, 0x69, 0x4c, 0x32, 0x52, 0x1d, 0x0f, 0x00, 0x78, 0xcf, 0x28, 0xf3, 0xf5,
-	0x44, 0xfb, 0x2b, 0x34, 0x5c, 0x3b, 0xa1, 0x2d, 0xb6, 0xa7, 0x98, 0x3b, 0xe9, 0x3f, 0x06, 0xc3,
-	0x9d, 0xf1, 0xdf, 0x45, 0x86, 0x3b, 0x13, 0x7f, 0x58, 0xb8, 0x29, 0x45, 0x31, 0x83, 0x23, 0xdd,
-	0x60, 0xe3, 0x37, 0x42, 0xf3, 0x7a, 0x06, 0x27, 0x55, 0xb0, 0x0d, 0x95, 0x7d, 0x4a, 0xb0, 0x3b,
-	0xfc, 0x57, 0x6a, 0x56, 0x2c, 0xb4, 0x06, 0xd3, 0x1c, 0xa7, 0xcb, 0x41, 0x7a, 0x17, 0x0a, 0x7c,
-	0x24, 0xb8, 0x04, 0x98, 0x0f, 0xa0, 0x28, 0x3a, 0x5e, 0xc3, 0x76, 0xa3, 0x29, 0x37, 0x6c, 0x37,
-	0xdb, 0x63, 0x71, 0x36, 0x6b, 0x1d, 0x8d, 0xb3, 0xb5, 0x3e, 0xd7, 0x38, 0x5b, 0xef, 0x31, 0xc5,
-	0xd9, 0xa2, 0x07, 0x32, 0xce, 0x36, 0xba, 0x3f, 0xe3, 0x6c, 0xb3, 0x61, 0xb2, 0xa7, 0xd0, 0x1a,
-	0x14, 0x45, 0xe3, 0x63, 0x28, 0x30, 0x7a, 0xa1, 0xe6, 0xfc, 0xc4, 0x93, 0xe9, 0x0c, 0x23, 0x7a,
-	0x94, 0xc6, 0x91, 0x48, 0x08, 0xe3, 0x71, 0x64, 0xa4, 0xf0, 0xf1, 0x38, 0x32, 0x73, 0x88, 0x3d,
-	0x85, 0xee, 0x41, 0x71, 0xd3, 0x0d, 0x3c, 0x3c, 0x40, 0x27, 0x9c, 0x76, 0x8a, 0x15, 0x1f, 0x41,
-	0x75, 0x1b, 0xd3, 0x3d, 0xfe, 0x03, 0x77, 0x27, 0xe8, 0x85, 0x27, 0xaa, 0x78, 0x53, 0x9f, 0xc7,
-	0x52, 0x71, 0x7b, 0xea, 0x65, 0x91, 0x0b, 0xde, 0xf9, 0x27, 0x00, 0x00, 0xff, 0xff, 0xac, 0xa3,
-	0xd1, 0x1f, 0x21, 0x16, 0x00, 0x00,
+	// 1923 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x5f, 0x73, 0xdb, 0x4a,
+	0x15, 0x8f, 0x6c, 0xc5, 0xb1, 0x8f, 0xff, 0xd4, 0x59, 0x2e, 0x89, 0xaa, 0x9b, 0x87, 0x8c, 0x60,
+	0x86, 0xd0, 0x72, 0xdd, 0x92, 0x3e, 0x40, 0x3b, 0xed, 0xf4, 0xa6, 0xb1, 0x53, 0x32, 0x6d, 0xd3,
+	0xa0, 0xdc, 0xf2, 0xe7, 0xa9, 0x57, 0xb5, 0xd7, 0xae, 0x88, 0x2c, 0xe9, 0xae, 0x56, 0xe9, 0x84,
+	0x67, 0x1e, 0x78, 0x81, 0x57, 0x86, 0x19, 0xbe, 0x02, 0x30, 0xc3, 0x27, 0xe0, 0x83, 0xc0, 0x23,
+	0xc3, 0x3b, 0x3c, 0xf2, 0xc2, 0xec, 0xae, 0x56, 0xde, 0xb5, 0x64, 0xc7, 0x09, 0x1d, 0xee, 0x9b,
+	0xce, 0x9e, 0xb3, 0xbb, 0xe7, 0xfc, 0xce, 0x9f, 0x3d, 0xbb, 0x82, 0x4e, 0x4c, 0xa2, 0x0b, 0x7f,
+	0x84, 0x49, 0x2f, 0x26, 0x11, 0x8d, 0x50, 0x23, 0x4e, 0x83, 0x74, 0xea, 0x93, 0x78, 0x68, 0xb7,
+	0xe2, 0x20, 0x9d, 0xf8, 0xa1, 0x60, 0xd8, 0x9f, 0x4e, 0xa2, 0x68, 0x12, 0xe0, 0x7b, 0x9c, 0x7a,
+	0x97, 0x8e, 0xef, 0xe1, 0x69, 0x4c, 0x2f, 0x33, 0xe6, 0xce, 0x3c, 0x33, 0xa1, 0x24, 0x1d, 0x52,
+	0xc1, 0x75, 0xbe, 0x07, 0xdd, 0xe7, 0x98, 0x9e, 0x0d, 0xdf, 0xe3, 0xa9, 0xe7, 0xe2, 0xaf, 0x52,
+	0x9c, 0x50, 0x64, 0xc1, 0xc6, 0x05, 0x26, 0x89, 0x1f, 0x85, 0x96, 0xb1, 0x6b, 0xec, 0xad, 0xbb,
+	0x92, 0x74, 0xee, 0xc2, 0xa6, 0x22, 0x9d, 0xc4, 0x51, 0x98, 0x60, 0xb4, 0x05, 0xb5, 0x84, 0x8f,
+	0x70, 0xe9, 0x86, 0x9b, 0x51, 0xce, 0xef, 0x2a, 0xd0, 0x3d, 0x8c, 0xc2, 0xb1, 0x3f, 0x49, 0x09,
+	0x96, 0x6b, 0xff, 0x08, 0x1a, 0x17, 0x1e, 0xf1, 0xbd, 0x77, 0x01, 0x4e, 0x2c, 0x63, 0xb7, 0xba,
+	0xd7, 0xdc, 0xbf, 0xd3, 0xcb, 0xed, 0xea, 0xcd, 0xcb, 0xf7, 0x7e, 0x22, 0x85, 0x07, 0x21, 0x25,
+	0x97, 0xee, 0x6c, 0x32, 0xba, 0x0b, 0xa6, 0x47, 0x26, 0x89, 0x55, 0xd9, 0x35, 0xf6, 0x9a, 0xfb,
+	0xdb, 0x3d, 0x61, 0x66, 0x4f, 0x9a, 0xd9, 0x3b, 0xe3, 0x66, 0xba, 0x5c, 0x08, 0x7d, 0x1b, 0xda,
+	0xde, 0x70, 0x88, 0x63, 0x7a, 0x86, 0x87, 0x04, 0xd3, 0xc4, 0xaa, 0xee, 0x1a, 0x7b, 0x75, 0x57,
+	0x1f, 0x44, 0x7b, 0x70, 0x4b, 0x0c, 0xb8, 0x38, 0x89, 0x52, 0x32, 0xc4, 0x89, 0x65, 0x72, 0xb9,
+	0xf9, 0x61, 0xfb, 0x31, 0x74, 0x74, 0xcd, 0x50, 0x17, 0xaa, 0xe7, 0xf8, 0x32, 0x83, 0x80, 0x7d,
+	0xa2, 0x4f, 0x60, 0xfd, 0xc2, 0x0b, 0x52, 0xcc, 0x35, 0x6c, 0xb8, 0x82, 0x78, 0x54, 0xf9, 0xa1,
+	0xe1, 0xfc, 0xc6, 0x80, 0x4d, 0xc5, 0xd2, 0x0c, 0xc7, 0x82, 0x8e, 0xc6, 0x02, 0x1d, 0x93, 0x34,
+	0x8e, 0x23, 0x42, 0x93, 0x53, 0x82, 0x2f, 0x7c, 0xfc, 0x81, 0xaf, 0x5f, 0x77, 0xe7, 0x87, 0xcb,
+	0xac, 0xa9, 0x96, 0x5a, 0xe3, 0xfc, 0xc5, 0x80, 0xdb, 0xb9, 0x3e, 0x03, 0x42, 0x22, 0xf2, 0xca,
+	0x4f, 0x12, 0x3f, 0x9c, 0xbc, 0xc0, 0x97, 0x09, 0xfa, 0x31, 0x34, 0xa7, 0x33, 0x32, 0x73, 0xda,
+	0xbd, 0x32, 0xa7, 0xcd, 0x4f, 0xed, 0xcd, 0xbe, 0x5d, 0x75, 0x0d, 0xfb, 0x19, 0xc0, 0x8c, 0x85,
+	0x10, 0x98, 0xa1, 0x37, 0xc5, 0x19, 0x76, 0xfc, 0x1b, 0xed, 0x42, 0x73, 0x84, 0x93, 0x21, 0xf1,
+	0x63, 0xca, 0xe2, 0x50, 0x40, 0xa8, 0x0e, 0x39, 0x7f, 0x32, 0xa0, 0x7d, 0x1c, 0x5e, 0x44, 0xe7,
+	0x79, 0x6c, 0x75, 0xa1, 0x4a, 0xa3, 0x73, 0xe9, 0x02, 0x1a, 0x9d, 0x5f, 0x2f, 0x46, 0x6c, 0xa8,
+	0xcb, 0x84, 0xe3, 0x40, 0x35, 0xdc, 0x9c, 0x56, 0x53, 0xc2, 0xe4, 0x2c, 0x49, 0x96, 0xa1, 0xbc,
+	0x5e, 0x8e, 0xf2, 0x05, 0x74, 0xa4, 0xbe, 0x99, 0xc7, 0xef, 0x41, 0x8d, 0x60, 0x9a, 0x12, 0x91,
+	0x67, 0x4b, 0x14, 0xcc, 0xc4, 0xd0, 0x03, 0xa8, 0x8f, 0x3d, 0x3f, 0x48, 0x09, 0x66, 0x36, 0x55,
+	0xf9, 0x14, 0xc5, 0x0f, 0xef, 0xf1, 0xf0, 0xfc, 0x48, 0xf0, 0xdd, 0x5c, 0xd0, 0xf9, 0x8f, 0x09,
+	0xcd, 0x43, 0x2f, 0x08, 0x3e, 0x12, 0x4c, 0x6f, 0xe0, 0x96, 0x47, 0x26, 0x7d, 0x1c, 0xe3, 0x70,
+	0x84, 0xc3, 0xa1, 0xcf, 0xc3, 0x8a, 0xa9, 0x72, 0x57, 0x55, 0x65, 0xb6, 0x5f, 0xef, 0x40, 0x97,
+	0x16, 0x89, 0x3c, 0xbf, 0x86, 0x86, 0xbe, 0xb9, 0x18, 0xfd, 0x75, 0x1d, 0x7d, 0x0b, 0x36, 0x62,
+	0x12, 0xfd, 0x02, 0x0f, 0xa9, 0x55, 0x13, 0x9c, 0x8c, 0x64, 0xd9, 0x97, 0x50, 0x6f, 0x78, 0x6e,
+	0x6d, 0x88, 0xec, 0xe3, 0x04, 0x7a, 0x04, 0xb5, 0x21, 0x8f, 0x56, 0xab, 0xce, 0x75, 0x76, 0x16,
+	0xe8, 0x2c, 0x42, 0x5a, 0xa8, 0x9a, 0xcd, 0x60, 0x75, 0x6e, 0x44, 0x2e, 0xdd, 0x34, 0xb4, 0x1a,
+	0xdc, 0xc1, 0x19, 0xc5, 0x35, 0xf7, 0x88, 0x17, 0x04, 0x38, 0xb0, 0x80, 0xd7, 0xcb, 0x9c, 0x66,
+	0xd1, 0x31, 0x8d, 0x42, 0x9f, 0x46, 0x64, 0x10, 0x8e, 0xe2, 0xc8, 0x0f, 0xa9, 0xd5, 0xe4, 0xfa,
+	0xcc, 0x0f, 0xdb, 0x77, 0xe0, 0x93, 0x03, 0x32, 0x49, 0xa7, 0x38, 0xa4, 0x1a, 0x2e, 0x08, 0xcc,
+	0x94, 0x84, 0x22, 0xed, 0x1a, 0x2e, 0xff, 0xb6, 0x23, 0x2e, 0x5b, 0x00, 0xb5, 0xa4, 0x06, 0x1d,
+	0xa8, 0x35, 0x68, 0xa9, 0x8b, 0x0a, 0x3b, 0x2b, 0x05, 0xcb, 0x7e, 0x08, 0x4d, 0x05, 0x91, 0x6b,
+	0xd5, 0xba, 0x7f, 0x55, 0xa0, 0x25, 0xb6, 0xba, 0x69, 0xd0, 0xbf, 0x05, 0x24, 0xbe, 0xb4, 0x98,
+	0xab, 0x14, 0xcb, 0x90, 0xb2, 0x4b, 0xcf, 0x2d, 0xcc, 0x10, 0xce, 0x2c, 0x59, 0x4a, 0xcb, 0xaa,
+	0xea, 0x8a, 0x59, 0x65, 0xef, 0x01, 0x2a, 0xee, 0x51, 0xea, 0xad, 0xaf, 0x60, 0x7b, 0x81, 0x36,
+	0x25, 0x40, 0x7e, 0xae, 0x3b, 0xec, 0xce, 0xea, 0xf6, 0xa9, 0xa0, 0xff, 0x12, 0x5a, 0x5c, 0x6d,
+	0x25, 0xe5, 0x25, 0xe0, 0x0d, 0x97, 0x7d, 0xb2, 0x94, 0x8f, 0x82, 0xd1, 0xd5, 0x29, 0xcf, 0x84,
+	0x98, 0x70, 0x88, 0x3f, 0x88, 0xe3, 0x63, 0x99, 0x30, 0x13, 0x72, 0x52, 0x68, 0x67, 0x7b, 0xcf,
+	0x1c, 0xee, 0x87, 0x71, 0x9a, 0x1d, 0x68, 0xcb, 0x1c, 0x2e, 0xc4, 0x6e, 0x56, 0xe5, 0x9e, 0x41,
+	0x4b, 0xe5, 0x64, 0xf5, 0x24, 0xc6, 0x84, 0x4a, 0x7c, 0x73, 0x9a, 0x65, 0x32, 0xc1, 0x5e, 0x92,
+	0x9f, 0x2b, 0x19, 0xe5, 0xfc, 0xd9, 0x80, 0x66, 0xdf, 0x1f, 0x8f, 0x25, 0x6c, 0x1d, 0xa8, 0xf8,
+	0xa3, 0x6c, 0x76, 0xc5, 0x1f, 0x49, 0x18, 0x2b, 0x45, 0x18, 0xab, 0xd7, 0x81, 0xd1, 0x5c, 0x01,
+	0x46, 0xd6, 0x0d, 0xf8, 0x93, 0x30, 0x22, 0xf8, 0xf0, 0xbd, 0x17, 0x4e, 0xf8, 0xa9, 0xc2, 0x42,
+	0x4a, 0x1f, 0x74, 0xfe, 0x6a, 0x40, 0xeb, 0x34, 0x33, 0x8b, 0x69, 0x8e, 0xee, 0x83, 0x79, 0xee,
+	0x87, 0x42, 0xe9, 0xce, 0xfe, 0x8e, 0x82, 0x9b, 0x2a, 0xd6, 0x7b, 0xe1, 0x87, 0x23, 0x97, 0x4b,
+	0xa2, 0x1d, 0x68, 0x70, 0xdc, 0xd9, 0x78, 0xd6, 0x4a, 0xcc, 0x06, 0x9c, 0x2f, 0xc1, 0x64, 0xb2,
+	0x68, 0x03, 0xaa, 0x07, 0xfd, 0x7e, 0x77, 0x0d, 0xdd, 0x82, 0xe6, 0x41, 0xbf, 0xff, 0xd6, 0x1d,
+	0x9c, 0xbe, 0x3c, 0x38, 0x1c, 0x74, 0x0d, 0x04, 0x50, 0xeb, 0x0f, 0x5e, 0x0e, 0xbe, 0x18, 0x74,
+	0x2b, 0x08, 0x41, 0x47, 0x7c, 0xe7, 0xfc, 0x2a, 0xe3, 0xbf, 0x39, 0xed, 0x1f, 0x7c, 0x31, 0xe8,
+	0x9a, 0x8c, 0x2f, 0xbe, 0x73, 0xfe, 0xba, 0xf3, 0xf7, 0x2a, 0xb4, 0x04, 0xe8, 0x59, 0xbc, 0xd8,
+	0x50, 0x27, 0x38, 0x0e, 0xbc, 0x21, 0x96, 0x79, 0x94, 0xd3, 0xac, 0xde, 0x27, 0x54, 0x34, 0x8f,
+	0x15, 0xce, 0x92, 0x24, 0xba, 0x0f, 0xdf, 0x18, 0xe1, 0x00, 0x53, 0xfc, 0x0c, 0x8f, 0x23, 0x82,
+	0x5d, 0x31, 0x23, 0xeb, 0x78, 0xca, 0x58, 0xe8, 0x09, 0x6c, 0x0c, 0x33, 0x6c, 0x4d, 0x8e, 0xd6,
+	0xb7, 0x14, 0xb4, 0x54, 0x8d, 0x38, 0x91, 0x21, 0xee, 0xca, 0x39, 0xac, 0xe4, 0x8d, 0xfc, 0xf1,
+	0x58, 0x3a, 0x46, 0x10, 0xe8, 0x15, 0xb4, 0x46, 0x98, 0x7a, 0x7e, 0x80, 0x47, 0x1c, 0xd0, 0x1a,
+	0x8f, 0xdf, 0xef, 0x2e, 0x5c, 0x59, 0x91, 0x15, 0x05, 0x4a, 0x9b, 0xce, 0xce, 0x8f, 0xf7, 0x5e,
+	0xa2, 0x4a, 0xf1, 0xf3, 0xac, 0xee, 0xce, 0x0f, 0xdb, 0x3f, 0x83, 0xcd, 0xc2, 0x62, 0x25, 0xf5,
+	0xe5, 0x33, 0xbd, 0xbe, 0x6c, 0x2f, 0x08, 0x10, 0xb5, 0x98, 0x3c, 0x81, 0xa6, 0x02, 0x00, 0xea,
+	0x42, 0xab, 0x7f, 0x7c, 0x74, 0xf4, 0xf6, 0xcd, 0xc9, 0x8b, 0x93, 0xd7, 0x3f, 0x3d, 0xe9, 0xae,
+	0xa1, 0x36, 0x34, 0xf8, 0xc8, 0xc9, 0xeb, 0x13, 0x16, 0x10, 0x92, 0x3c, 0x7b, 0xfd, 0x6a, 0xd0,
+	0xad, 0x38, 0xbf, 0x35, 0xa0, 0x7d, 0x48, 0xb0, 0x47, 0xf1, 0xe2, 0x6a, 0xf4, 0x03, 0x80, 0x2c,
+	0x39, 0x45, 0x69, 0x5f, 0x9a, 0x1f, 0x8a, 0x28, 0x8b, 0x07, 0xea, 0x4f, 0x71, 0x94, 0x52, 0xee,
+	0x69, 0xc3, 0x95, 0xa4, 0xe8, 0x0c, 0x44, 0x7f, 0x2c, 0x7a, 0x78, 0x49, 0x3a, 0x3f, 0x87, 0x8e,
+	0xd4, 0x27, 0x8b, 0xb8, 0xf9, 0x3c, 0xbf, 0xa9, 0x3a, 0xce, 0xef, 0x0d, 0x68, 0xba, 0xd8, 0x1b,
+	0xad, 0x5e, 0x40, 0xf4, 0xad, 0xaa, 0xab, 0x5b, 0x3e, 0xab, 0xaa, 0xe6, 0x4a, 0x55, 0xd5, 0xf9,
+	0xb5, 0x01, 0x2d, 0xa1, 0xdb, 0x47, 0xb6, 0x5a, 0x51, 0xa5, 0xba, 0x9a, 0x2a, 0xff, 0x30, 0xa0,
+	0xfd, 0x26, 0x1e, 0x29, 0x21, 0xf1, 0x75, 0x56, 0x5a, 0x25, 0x86, 0xd6, 0xf5, 0x18, 0x2a, 0xd4,
+	0xe0, 0x5a, 0x49, 0x0d, 0x56, 0x23, 0x6d, 0x43, 0x8f, 0xb4, 0x63, 0xe8, 0x48, 0x33, 0x33, 0xcc,
+	0x75, 0x8c, 0x8d, 0xd5, 0x23, 0xeb, 0x57, 0x06, 0xb4, 0xfb, 0xbc, 0x88, 0xfd, 0x1f, 0x62, 0x4b,
+	0x41, 0xc4, 0xd4, 0x10, 0x71, 0xfe, 0x5d, 0xe3, 0x77, 0x7a, 0xf1, 0x84, 0xa0, 0xbc, 0x17, 0xc8,
+	0x26, 0xdc, 0x58, 0xd0, 0x84, 0x57, 0xd4, 0x26, 0xfc, 0x69, 0xde, 0x84, 0x8b, 0x6e, 0xeb, 0x3b,
+	0xfa, 0x5d, 0x52, 0x5b, 0xfc, 0x8a, 0x4e, 0xdc, 0x5c, 0xd8, 0x89, 0xaf, 0x5f, 0xdd, 0x89, 0xd7,
+	0x4a, 0x3b, 0x71, 0xd6, 0xc3, 0xd1, 0xcb, 0x18, 0x67, 0x17, 0x07, 0xfe, 0x9d, 0x5f, 0x51, 0xeb,
+	0xca, 0x15, 0x75, 0x0b, 0x6a, 0xb1, 0x47, 0x70, 0x48, 0xf9, 0x7d, 0xa0, 0xe1, 0x66, 0x94, 0x92,
+	0x0e, 0xb0, 0x5a, 0xbf, 0xf3, 0x25, 0x6c, 0xf2, 0x2f, 0xad, 0xbf, 0x6d, 0x72, 0x68, 0xf6, 0x97,
+	0x41, 0x73, 0x3c, 0x3f, 0x49, 0xa0, 0x54, 0x5c, 0x2c, 0xf3, 0x10, 0x65, 0x1e, 0x6a, 0xc9, 0x10,
+	0xe5, 0x24, 0x7b, 0x8f, 0x91, 0xd7, 0xac, 0xc4, 0x6a, 0x97, 0xbd, 0xc7, 0xe8, 0x7b, 0x9e, 0x4a,
+	0xe1, 0xec, 0x3d, 0x26, 0x9f, 0xcc, 0xf6, 0xf0, 0x02, 0xdf, 0x4b, 0x70, 0x62, 0x75, 0xc4, 0xd1,
+	0x9c, 0x91, 0xc8, 0x61, 0x67, 0xa2, 0x62, 0xda, 0x2d, 0xce, 0xd6, 0xc6, 0xd8, 0xf5, 0x27, 0x3f,
+	0x7f, 0xae, 0x6a, 0xa8, 0x6f, 0x7e, 0x1b, 0xb1, 0x2f, 0x60, 0xab, 0x1c, 0xb5, 0x92, 0x55, 0x8e,
+	0xf4, 0xa3, 0xf2, 0xfe, 0x15, 0xb0, 0x14, 0x74, 0x57, 0xf7, 0x7d, 0x0c, 0x1d, 0x1d, 0xb9, 0x6b,
+	0xdd, 0xa1, 0xfe, 0x56, 0x81, 0x4d, 0x65, 0xcb, 0xac, 0x96, 0x14, 0x8f, 0xd1, 0xcf, 0x78, 0xba,
+	0x51, 0x7c, 0x55, 0xf1, 0x16, 0x52, 0xc8, 0x83, 0x4d, 0xfe, 0x51, 0x72, 0x97, 0x7f, 0x50, 0x6e,
+	0xac, 0xd8, 0xb9, 0x77, 0x36, 0x3f, 0x2b, 0x0b, 0xbc, 0xc2, 0x6a, 0xd7, 0x72, 0xeb, 0x07, 0xd8,
+	0x2a, 0x5f, 0xb8, 0x04, 0xab, 0xe7, 0xba, 0x6f, 0xbe, 0xbf, 0x54, 0xdd, 0x2b, 0x9c, 0xe3, 0xfc,
+	0xc1, 0x80, 0xe6, 0x4b, 0x3f, 0xc9, 0xeb, 0x99, 0x2c, 0x00, 0x86, 0x52, 0x00, 0x9e, 0xc0, 0xc6,
+	0xd8, 0x0f, 0x28, 0x26, 0xa2, 0xf1, 0x6c, 0x6a, 0xcd, 0xa2, 0x32, 0xb9, 0x77, 0x24, 0xa4, 0x04,
+	0x22, 0x72, 0x8e, 0xfd, 0x08, 0x5a, 0x2a, 0xe3, 0x5a, 0xde, 0xdf, 0x85, 0x96, 0xd8, 0x60, 0xe6,
+	0x77, 0x7f, 0x24, 0xa1, 0x63, 0x9f, 0xce, 0x1f, 0x0d, 0xd8, 0xe6, 0x6f, 0x6f, 0xf2, 0xb1, 0xe9,
+	0x38, 0xf4, 0xe9, 0x11, 0xef, 0x05, 0x3f, 0xde, 0x29, 0x6f, 0xc1, 0x86, 0xb8, 0x26, 0x89, 0x18,
+	0x69, 0xb8, 0x92, 0xbc, 0x76, 0x2b, 0xb2, 0xff, 0xcf, 0x3a, 0x74, 0xa5, 0xaa, 0x32, 0x2d, 0x58,
+	0x25, 0xca, 0xdf, 0x96, 0xd1, 0xa7, 0x0a, 0xba, 0xf3, 0xef, 0xd3, 0xf6, 0x4e, 0x39, 0x53, 0xc0,
+	0xe3, 0xac, 0xa1, 0x67, 0xd0, 0xe4, 0x57, 0x41, 0x51, 0x24, 0x50, 0xe1, 0xf2, 0x28, 0xd7, 0xb1,
+	0x8a, 0x8c, 0x7c, 0x8d, 0xa7, 0x00, 0xbc, 0xe9, 0xcd, 0x0e, 0x9c, 0x42, 0xff, 0x2e, 0x56, 0xd8,
+	0x5e, 0xd0, 0xd7, 0x3b, 0x6b, 0xcc, 0x9c, 0xfc, 0x5d, 0x54, 0x33, 0x67, 0xfe, 0x89, 0xdb, 0xde,
+	0x29, 0x67, 0x2a, 0xaa, 0xd4, 0xc4, 0xbb, 0x21, 0x52, 0x15, 0xd6, 0x9e, 0x3e, 0xed, 0xdb, 0x25,
+	0x9c, 0x7c, 0x81, 0xe7, 0xd0, 0x3a, 0xa3, 0x04, 0x7b, 0xd3, 0xff, 0x69, 0x99, 0xfb, 0x06, 0x7a,
+	0x08, 0x26, 0x7b, 0x84, 0xd0, 0xe0, 0x50, 0x9e, 0x91, 0xec, 0xed, 0xc2, 0x78, 0xae, 0xc3, 0x63,
+	0x58, 0xe7, 0x10, 0xdf, 0xcc, 0x1b, 0x0f, 0xc1, 0xe4, 0xd7, 0xa1, 0x1b, 0xf8, 0xe1, 0x29, 0xd4,
+	0x44, 0xb7, 0xaf, 0x99, 0xad, 0x5d, 0x48, 0xec, 0xdb, 0x25, 0x1c, 0x75, 0x6f, 0xd6, 0x36, 0x6b,
+	0x7b, 0x2b, 0x3d, 0xbe, 0xbd, 0x5d, 0x18, 0x57, 0xf7, 0x16, 0xfd, 0x9f, 0xb6, 0xb7, 0xd6, 0xf9,
+	0xda, 0xb7, 0x4b, 0x38, 0x0a, 0x6a, 0x35, 0xd1, 0xf4, 0x69, 0x0b, 0x68, 0x7d, 0xa0, 0xbd, 0x55,
+	0xc8, 0xb6, 0x01, 0xfb, 0xfb, 0x93, 0x87, 0xa0, 0x28, 0x86, 0xf3, 0x21, 0xa8, 0x1d, 0x5f, 0xf6,
+	0x4e, 0x39, 0x53, 0xc5, 0x80, 0x95, 0x20, 0x0d, 0x03, 0xa5, 0xe8, 0xd9, 0xdb, 0x85, 0xf1, 0x7c,
+	0xea, 0x23, 0xa8, 0x1d, 0x7a, 0xe1, 0x10, 0x07, 0x68, 0x81, 0xa2, 0x4b, 0x0c, 0xf8, 0x1c, 0xda,
+	0xcf, 0x31, 0x3d, 0xe5, 0xbf, 0xba, 0x8e, 0xc3, 0x71, 0xb4, 0x70, 0x89, 0x6f, 0xaa, 0xd7, 0xd8,
+	0x5c, 0xdc, 0x59, 0x7b, 0x57, 0xe3, 0x82, 0x0f, 0xfe, 0x3b, 0x00, 0x2a, 0x0c, 0xbc, 0x7d, 0x4b,
+	0x1b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1819,6 +2185,9 @@ type ResourceProviderClient interface {
 	// StreamInvoke dynamically executes a built-in function in the provider, which returns a stream
 	// of responses.
 	StreamInvoke(ctx context.Context, in *InvokeRequest, opts ...grpc.CallOption) (ResourceProvider_StreamInvokeClient, error)
+	// Call dynamically executes a method in the provider associated with a component resource. The resource on which
+	// the method is called is passed as a resource reference in the `__self__` argument.
+	Call(ctx context.Context, in *CallRequest, opts ...grpc.CallOption) (*CallResponse, error)
 	// Check validates that the given property bag is valid for a resource of the given type and returns the inputs
 	// that should be passed to successive calls to Diff, Create, or Update for this resource. As a rule, the provider
 	// inputs returned by a call to Check should preserve the original representation of the properties as present in
@@ -1839,6 +2208,10 @@ type ResourceProviderClient interface {
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Construct creates a new instance of the provided component resource and returns its state.
 	Construct(ctx context.Context, in *ConstructRequest, opts ...grpc.CallOption) (*ConstructResponse, error)
+	// List returns the IDs of the existing resources of the given type that match the given filters. The IDs may be
+	// used to import the resources. Providers are not required to implement List; those that do not return an
+	// UNIMPLEMENTED error.
+	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
 	// Cancel signals the provider to abort all outstanding resource operations.
 	Cancel(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error)
 	// GetPluginInfo returns generic information about this plugin, like its version.
@@ -1930,6 +2303,15 @@ func (x *resourceProviderStreamInvokeClient) Recv() (*InvokeResponse, error) {
 	return m, nil
 }
 
+func (c *resourceProviderClient) Call(ctx context.Context, in *CallRequest, opts ...grpc.CallOption) (*CallResponse, error) {
+	out := new(CallResponse)
+	err := c.cc.Invoke(ctx, "/pulumirpc.ResourceProvider/Call", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *resourceProviderClient) Check(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (*CheckResponse, error) {
 	out := new(CheckResponse)
 	err := c.cc.Invoke(ctx, "/pulumirpc.ResourceProvider/Check", in, out, opts...)
@@ -1993,6 +2375,15 @@ func (c *resourceProviderClient) Construct(ctx context.Context, in *ConstructReq
 	return out, nil
 }
 
+func (c *resourceProviderClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error) {
+	out := new(ListResponse)
+	err := c.cc.Invoke(ctx, "/pulumirpc.ResourceProvider/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *resourceProviderClient) Cancel(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
 	err := c.cc.Invoke(ctx, "/pulumirpc.ResourceProvider/Cancel", in, out, opts...)
@@ -2026,6 +2417,9 @@ type ResourceProviderServer interface {
 	// StreamInvoke dynamically executes a built-in function in the provider, which returns a stream
 	// of responses.
 	StreamInvoke(*InvokeRequest, ResourceProvider_StreamInvokeServer) error
+	// Call dynamically executes a method in the provider associated with a component resource. The resource on which
+	// the method is called is passed as a resource reference in the `__self__` argument.
+	Call(context.Context, *CallRequest) (*CallResponse, error)
 	// Check validates that the given property bag is valid for a resource of the given type and returns the inputs
 	// that should be passed to successive calls to Diff, Create, or Update for this resource. As a rule, the provider
 	// inputs returned by a call to Check should preserve the original representation of the properties as present in
@@ -2046,6 +2440,10 @@ type ResourceProviderServer interface {
 	Delete(context.Context, *DeleteRequest) (*empty.Empty, error)
 	// Construct creates a new instance of the provided component resource and returns its state.
 	Construct(context.Context, *ConstructRequest) (*ConstructResponse, error)
+	// List returns the IDs of the existing resources of the given type that match the given filters. The IDs may be
+	// used to import the resources. Providers are not required to implement List; those that do not return an
+	// UNIMPLEMENTED error.
+	List(context.Context, *ListRequest) (*ListResponse, error)
 	// Cancel signals the provider to abort all outstanding resource operations.
 	Cancel(context.Context, *empty.Empty) (*empty.Empty, error)
 	// GetPluginInfo returns generic information about this plugin, like its version.
@@ -2074,6 +2472,9 @@ func (*UnimplementedResourceProviderServer) Invoke(ctx context.Context, req *Inv
 func (*UnimplementedResourceProviderServer) StreamInvoke(req *InvokeRequest, srv ResourceProvider_StreamInvokeServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamInvoke not implemented")
 }
+func (*UnimplementedResourceProviderServer) Call(ctx context.Context, req *CallRequest) (*CallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Call not implemented")
+}
 func (*UnimplementedResourceProviderServer) Check(ctx context.Context, req *CheckRequest) (*CheckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Check not implemented")
 }
@@ -2095,6 +2496,9 @@ func (*UnimplementedResourceProviderServer) Delete(ctx context.Context, req *Del
 func (*UnimplementedResourceProviderServer) Construct(ctx context.Context, req *ConstructRequest) (*ConstructResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Construct not implemented")
 }
+func (*UnimplementedResourceProviderServer) List(ctx context.Context, req *ListRequest) (*ListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
 func (*UnimplementedResourceProviderServer) Cancel(ctx context.Context, req *empty.Empty) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Cancel not implemented")
 }
@@ -2217,6 +2621,24 @@ func (x *resourceProviderStreamInvokeServer) Send(m *InvokeResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ResourceProvider_Call_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceProviderServer).Call(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pulumirpc.ResourceProvider/Call",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceProviderServer).Call(ctx, req.(*CallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ResourceProvider_Check_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CheckRequest)
 	if err := dec(in); err != nil {
@@ -2343,6 +2765,24 @@ func _ResourceProvider_Construct_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ResourceProvider_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceProviderServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pulumirpc.ResourceProvider/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceProviderServer).List(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ResourceProvider_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(empty.Empty)
 	if err := dec(in); err != nil {
@@ -2403,6 +2843,10 @@ var _ResourceProvider_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Invoke",
 			Handler:    _ResourceProvider_Invoke_Handler,
 		},
+		{
+			MethodName: "Call",
+			Handler:    _ResourceProvider_Call_Handler,
+		},
 		{
 			MethodName: "Check",
 			Handler:    _ResourceProvider_Check_Handler,
@@ -2431,6 +2875,10 @@ var _ResourceProvider_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Construct",
 			Handler:    _ResourceProvider_Construct_Handler,
 		},
+		{
+			MethodName: "List",
+			Handler:    _ResourceProvider_List_Handler,
+		},
 		{
 			MethodName: "Cancel",
 			Handler:    _ResourceProvider_Cancel_Handler,
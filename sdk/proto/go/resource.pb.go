@@ -775,66 +775,67 @@ func init() {
 func init() { proto.RegisterFile("resource.proto", fileDescriptor_d1b72f771c35e3b8) }
 
 var fileDescriptor_d1b72f771c35e3b8 = []byte{
-	// 943 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcd, 0x6f, 0x1c, 0x35,
-	0x14, 0xcf, 0xee, 0xa6, 0x93, 0xdd, 0x97, 0x74, 0x13, 0x9c, 0x34, 0x71, 0x07, 0x14, 0xc2, 0xc0,
-	0x61, 0xe1, 0xb0, 0x69, 0x03, 0x52, 0x03, 0xe2, 0x43, 0xa2, 0x2d, 0xa8, 0x87, 0x42, 0x99, 0x20,
-	0x04, 0x48, 0x20, 0x39, 0x33, 0x2f, 0xdb, 0x21, 0xb3, 0x63, 0xd7, 0xf6, 0x44, 0xda, 0x1b, 0x1c,
-	0xb9, 0x72, 0xe6, 0xaf, 0xe1, 0x2f, 0x43, 0xb6, 0x67, 0x96, 0x9d, 0x8f, 0x4d, 0x36, 0xe5, 0xe6,
-	0xf7, 0x69, 0xfb, 0xf7, 0x7e, 0xef, 0xd9, 0x30, 0x94, 0xa8, 0x78, 0x2e, 0x23, 0x1c, 0x0b, 0xc9,
-	0x35, 0x27, 0x03, 0x91, 0xa7, 0xf9, 0x34, 0x91, 0x22, 0xf2, 0xdf, 0x9c, 0x70, 0x3e, 0x49, 0xf1,
-	0xd8, 0x1a, 0xce, 0xf3, 0x8b, 0x63, 0x9c, 0x0a, 0x3d, 0x73, 0x7e, 0xfe, 0x5b, 0x75, 0xa3, 0xd2,
-	0x32, 0x8f, 0x74, 0x61, 0x1d, 0x0a, 0xc9, 0xaf, 0x92, 0x18, 0xa5, 0x93, 0x83, 0x11, 0xec, 0x9f,
-	0xe5, 0x42, 0x70, 0xa9, 0xd5, 0x57, 0xc8, 0x74, 0x2e, 0x31, 0xc4, 0x57, 0x39, 0x2a, 0x4d, 0x86,
-	0xd0, 0x4d, 0x62, 0xda, 0x39, 0xea, 0x8c, 0x06, 0x61, 0x37, 0x89, 0x83, 0x8f, 0xe1, 0xa0, 0xe1,
-	0xa9, 0x04, 0xcf, 0x14, 0x92, 0x43, 0x80, 0x97, 0x4c, 0x15, 0x56, 0x1b, 0xd2, 0x0f, 0x17, 0x34,
-	0xc1, 0xdf, 0x3d, 0xd8, 0x0d, 0x91, 0xc5, 0x61, 0x71, 0xa3, 0x25, 0x5b, 0x10, 0x02, 0xeb, 0x7a,
-	0x26, 0x90, 0x76, 0xad, 0xc6, 0xae, 0x8d, 0x2e, 0x63, 0x53, 0xa4, 0x3d, 0xa7, 0x33, 0x6b, 0xb2,
-	0x0f, 0x9e, 0x60, 0x12, 0x33, 0x4d, 0xd7, 0xad, 0xb6, 0x90, 0xc8, 0x23, 0x00, 0x21, 0xb9, 0x40,
-	0xa9, 0x13, 0x54, 0xf4, 0xce, 0x51, 0x67, 0xb4, 0x79, 0x72, 0x30, 0x76, 0x78, 0x8c, 0x4b, 0x3c,
-	0xc6, 0x67, 0x16, 0x8f, 0x70, 0xc1, 0x95, 0x04, 0xb0, 0x15, 0xa3, 0xc0, 0x2c, 0xc6, 0x2c, 0x32,
-	0xa1, 0xde, 0x51, 0x6f, 0x34, 0x08, 0x2b, 0x3a, 0xe2, 0x43, 0xbf, 0xc4, 0x8e, 0x6e, 0xd8, 0x6d,
-	0xe7, 0x32, 0xa1, 0xb0, 0x71, 0x85, 0x52, 0x25, 0x3c, 0xa3, 0x7d, 0x6b, 0x2a, 0x45, 0xf2, 0x1e,
-	0xdc, 0x65, 0x51, 0x84, 0x42, 0x9f, 0x61, 0x24, 0x51, 0x2b, 0x3a, 0xb0, 0xe8, 0x54, 0x95, 0xe4,
-	0x14, 0x0e, 0x58, 0x1c, 0x27, 0x3a, 0xe1, 0x19, 0x4b, 0x9d, 0xf2, 0xdb, 0x5c, 0x8b, 0x5c, 0x2b,
-	0x0a, 0xf6, 0x28, 0xcb, 0xcc, 0x66, 0x67, 0x96, 0x26, 0x4c, 0xa1, 0xa2, 0x9b, 0xd6, 0xb3, 0x14,
-	0xc9, 0x08, 0xb6, 0xdd, 0x26, 0x25, 0xea, 0x8a, 0x6e, 0xd9, 0xbd, 0xeb, 0xea, 0x80, 0xc1, 0x5e,
-	0xb5, 0x3a, 0x45, 0x59, 0x77, 0xa0, 0x97, 0xcb, 0xac, 0xa8, 0x8f, 0x59, 0xd6, 0x00, 0xee, 0xae,
-	0x0c, 0x70, 0xf0, 0xd7, 0x00, 0x0e, 0x42, 0x9c, 0x24, 0x4a, 0xa3, 0xac, 0xb3, 0xa0, 0xac, 0x7a,
-	0xa7, 0xa5, 0xea, 0xdd, 0xd6, 0xaa, 0xf7, 0x2a, 0x55, 0xdf, 0x07, 0x2f, 0xca, 0x95, 0xe6, 0x53,
-	0xcb, 0x86, 0x7e, 0x58, 0x48, 0xe4, 0x18, 0x3c, 0x7e, 0xfe, 0x1b, 0x46, 0xfa, 0x26, 0x26, 0x14,
-	0x6e, 0x06, 0x4b, 0x63, 0x32, 0x11, 0x9e, 0xcd, 0x54, 0x8a, 0x0d, 0x7e, 0x6c, 0xdc, 0xc0, 0x8f,
-	0x7e, 0x8d, 0x1f, 0x02, 0xf6, 0x0a, 0x30, 0x66, 0x4f, 0x16, 0xf3, 0x0c, 0x8e, 0x7a, 0xa3, 0xcd,
-	0x93, 0x4f, 0xc7, 0xf3, 0xd6, 0x1e, 0x2f, 0x01, 0x69, 0xfc, 0xa2, 0x25, 0xfc, 0x69, 0xa6, 0xe5,
-	0x2c, 0x6c, 0xcd, 0x4c, 0x1e, 0xc0, 0x6e, 0x8c, 0x29, 0x6a, 0xfc, 0x12, 0x2f, 0xb8, 0x69, 0x55,
-	0x91, 0xb2, 0x08, 0x29, 0xd8, 0x7b, 0xb5, 0x99, 0x16, 0x39, 0xbc, 0xd9, 0xe0, 0x70, 0x32, 0xc9,
-	0xb8, 0xc4, 0xc7, 0x2f, 0x59, 0x36, 0xb1, 0x3c, 0x32, 0xd7, 0xaf, 0x2a, 0x9b, 0x4c, 0xbf, 0x7b,
-	0x4b, 0xa6, 0x0f, 0x57, 0x66, 0xfa, 0x76, 0x95, 0xe9, 0x3e, 0xf4, 0x93, 0xa9, 0x19, 0x34, 0xcf,
-	0x62, 0xba, 0xe3, 0x90, 0x2f, 0x65, 0xf2, 0x13, 0x0c, 0x1d, 0x1d, 0xbe, 0x4f, 0xa6, 0xc8, 0xcd,
-	0x36, 0x6f, 0x58, 0x32, 0x3c, 0x5c, 0x01, 0xf3, 0xc7, 0x95, 0xc0, 0xb0, 0x96, 0x88, 0x7c, 0x0e,
-	0x7e, 0x0b, 0x8e, 0x4f, 0xf0, 0x22, 0xc9, 0x30, 0xa6, 0xc4, 0xde, 0xfe, 0x1a, 0x0f, 0xf2, 0x11,
-	0xdc, 0x53, 0xc5, 0x40, 0x7d, 0xc1, 0xa4, 0x4e, 0x58, 0xfa, 0x03, 0x4b, 0x73, 0x54, 0x74, 0xd7,
-	0x86, 0xb6, 0x1b, 0x0d, 0xdb, 0x25, 0x4e, 0xb9, 0x46, 0xba, 0xe7, 0xd8, 0xee, 0xa4, 0xb6, 0x76,
-	0xbf, 0xd7, 0xda, 0xee, 0xfe, 0x07, 0xb0, 0xd7, 0xc6, 0x26, 0xd3, 0x73, 0xb9, 0xcc, 0x14, 0xed,
-	0x58, 0x74, 0xed, 0xda, 0xff, 0x11, 0x86, 0x55, 0x14, 0x6c, 0xb7, 0x49, 0x64, 0xba, 0xec, 0xd7,
-	0x42, 0x32, 0xfa, 0x5c, 0xc4, 0x46, 0xef, 0x7a, 0xb6, 0x90, 0x8c, 0xde, 0x61, 0x50, 0x76, 0xad,
-	0x93, 0xfc, 0xdf, 0x3b, 0x70, 0x7f, 0x29, 0xa9, 0xcd, 0xe8, 0xb9, 0xc4, 0x59, 0x39, 0x7a, 0x2e,
-	0x71, 0x46, 0x9e, 0xc3, 0x9d, 0x2b, 0x83, 0x40, 0x31, 0x75, 0x1e, 0xbd, 0x66, 0xcf, 0x84, 0x2e,
-	0xcb, 0x27, 0xdd, 0xd3, 0x4e, 0xf0, 0x4f, 0x0f, 0x68, 0x33, 0x76, 0xe9, 0xf0, 0x73, 0xaf, 0x55,
-	0x77, 0xfe, 0x5a, 0xfd, 0x37, 0x5f, 0x7a, 0xab, 0xcd, 0x97, 0x7d, 0xf0, 0x94, 0x66, 0xe7, 0x29,
-	0x96, 0x83, 0xca, 0x49, 0x86, 0xd9, 0x6e, 0x65, 0xde, 0x2c, 0xcb, 0xec, 0x42, 0x24, 0xaf, 0x96,
-	0xcc, 0x0d, 0xcf, 0xce, 0x8d, 0xcf, 0xae, 0xc5, 0xc0, 0xdd, 0xe3, 0xb6, 0x83, 0xe3, 0x56, 0xec,
-	0xf8, 0xe3, 0x96, 0x35, 0xfc, 0xa6, 0x5a, 0xc3, 0xd3, 0xd7, 0x3d, 0xff, 0x62, 0x11, 0x11, 0x0e,
-	0xeb, 0xb1, 0xc5, 0xc4, 0x28, 0xdf, 0x97, 0x66, 0x25, 0x1f, 0xc2, 0x06, 0x2f, 0x86, 0xce, 0x0d,
-	0x6f, 0x58, 0xe9, 0x77, 0xf2, 0xe7, 0x3a, 0x6c, 0x97, 0xf9, 0x9f, 0xf3, 0x2c, 0xd1, 0x5c, 0x92,
-	0x9f, 0x61, 0xbb, 0xf6, 0x23, 0x22, 0xef, 0x2c, 0x5c, 0xa9, 0xfd, 0x5f, 0xe5, 0x07, 0xd7, 0xb9,
-	0xb8, 0x4b, 0x07, 0x6b, 0xe4, 0x0b, 0xf0, 0x9e, 0x65, 0x57, 0xfc, 0x12, 0x09, 0x5d, 0xf0, 0x77,
-	0xaa, 0x32, 0xd3, 0xfd, 0x16, 0xcb, 0x3c, 0xc1, 0xd7, 0xb0, 0x75, 0xa6, 0x25, 0xb2, 0xe9, 0xff,
-	0x4a, 0xf3, 0xa0, 0x43, 0xbe, 0x83, 0xad, 0xc5, 0xdf, 0x01, 0x39, 0xac, 0x54, 0xad, 0xf1, 0xa9,
-	0xf3, 0xdf, 0x5e, 0x6a, 0x9f, 0x9f, 0xed, 0x17, 0xd8, 0xa9, 0xd7, 0x8c, 0x04, 0x37, 0x37, 0xb4,
-	0xff, 0xee, 0x0a, 0x84, 0x09, 0xd6, 0xc8, 0xaf, 0xcd, 0xbf, 0x46, 0xf9, 0x88, 0xbc, 0x7f, 0x4d,
-	0x86, 0x2a, 0x6d, 0xfc, 0xfd, 0x06, 0x27, 0x9e, 0x9a, 0x5f, 0x76, 0xb0, 0x76, 0xee, 0x59, 0xcd,
-	0x87, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x70, 0x3e, 0x80, 0x35, 0xa2, 0x0b, 0x00, 0x00,
+	// 958 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xdd, 0x72, 0x1b, 0x35,
+	0x14, 0x8e, 0xed, 0xd4, 0xb1, 0x4f, 0x52, 0x27, 0x28, 0xa9, 0xad, 0x2e, 0x4c, 0x08, 0x0b, 0x17,
+	0x86, 0x0b, 0xa7, 0x0d, 0xcc, 0x34, 0x65, 0xf8, 0x99, 0x21, 0x2d, 0x4c, 0x2f, 0x0a, 0x65, 0xc3,
+	0x30, 0xc0, 0x0c, 0xcc, 0x28, 0xbb, 0x27, 0xe9, 0x92, 0xf5, 0x4a, 0x95, 0xb4, 0x99, 0xf1, 0x1d,
+	0xbc, 0x02, 0xd7, 0x3c, 0x0d, 0xc3, 0x83, 0x31, 0x92, 0x56, 0xc6, 0x6b, 0xaf, 0x13, 0xa7, 0xbd,
+	0xd3, 0xf9, 0x95, 0xf4, 0x9d, 0xef, 0x1c, 0x09, 0x7a, 0x12, 0x15, 0x2f, 0x64, 0x8c, 0x23, 0x21,
+	0xb9, 0xe6, 0xa4, 0x2b, 0x8a, 0xac, 0x18, 0xa7, 0x52, 0xc4, 0xc1, 0xdb, 0x17, 0x9c, 0x5f, 0x64,
+	0x78, 0x68, 0x0d, 0x67, 0xc5, 0xf9, 0x21, 0x8e, 0x85, 0x9e, 0x38, 0xbf, 0xe0, 0x9d, 0x79, 0xa3,
+	0xd2, 0xb2, 0x88, 0x75, 0x69, 0xed, 0x09, 0xc9, 0xaf, 0xd2, 0x04, 0xa5, 0x93, 0xc3, 0x21, 0xf4,
+	0x4f, 0x0b, 0x21, 0xb8, 0xd4, 0xea, 0x6b, 0x64, 0xba, 0x90, 0x18, 0xe1, 0xab, 0x02, 0x95, 0x26,
+	0x3d, 0x68, 0xa6, 0x09, 0x6d, 0x1c, 0x34, 0x86, 0xdd, 0xa8, 0x99, 0x26, 0xe1, 0x63, 0x18, 0x2c,
+	0x78, 0x2a, 0xc1, 0x73, 0x85, 0x64, 0x1f, 0xe0, 0x25, 0x53, 0xa5, 0xd5, 0x86, 0x74, 0xa2, 0x19,
+	0x4d, 0xf8, 0x77, 0x0b, 0x76, 0x23, 0x64, 0x49, 0x54, 0xde, 0x68, 0xc9, 0x16, 0x84, 0xc0, 0xba,
+	0x9e, 0x08, 0xa4, 0x4d, 0xab, 0xb1, 0x6b, 0xa3, 0xcb, 0xd9, 0x18, 0x69, 0xcb, 0xe9, 0xcc, 0x9a,
+	0xf4, 0xa1, 0x2d, 0x98, 0xc4, 0x5c, 0xd3, 0x75, 0xab, 0x2d, 0x25, 0xf2, 0x08, 0x40, 0x48, 0x2e,
+	0x50, 0xea, 0x14, 0x15, 0xbd, 0x73, 0xd0, 0x18, 0x6e, 0x1e, 0x0d, 0x46, 0x0e, 0x8f, 0x91, 0xc7,
+	0x63, 0x74, 0x6a, 0xf1, 0x88, 0x66, 0x5c, 0x49, 0x08, 0x5b, 0x09, 0x0a, 0xcc, 0x13, 0xcc, 0x63,
+	0x13, 0xda, 0x3e, 0x68, 0x0d, 0xbb, 0x51, 0x45, 0x47, 0x02, 0xe8, 0x78, 0xec, 0xe8, 0x86, 0xdd,
+	0x76, 0x2a, 0x13, 0x0a, 0x1b, 0x57, 0x28, 0x55, 0xca, 0x73, 0xda, 0xb1, 0x26, 0x2f, 0x92, 0x0f,
+	0xe0, 0x2e, 0x8b, 0x63, 0x14, 0xfa, 0x14, 0x63, 0x89, 0x5a, 0xd1, 0xae, 0x45, 0xa7, 0xaa, 0x24,
+	0xc7, 0x30, 0x60, 0x49, 0x92, 0xea, 0x94, 0xe7, 0x2c, 0x73, 0xca, 0xef, 0x0a, 0x2d, 0x0a, 0xad,
+	0x28, 0xd8, 0xa3, 0x2c, 0x33, 0x9b, 0x9d, 0x59, 0x96, 0x32, 0x85, 0x8a, 0x6e, 0x5a, 0x4f, 0x2f,
+	0x92, 0x21, 0x6c, 0xbb, 0x4d, 0x3c, 0xea, 0x8a, 0x6e, 0xd9, 0xbd, 0xe7, 0xd5, 0x21, 0x83, 0xbd,
+	0x6a, 0x75, 0xca, 0xb2, 0xee, 0x40, 0xab, 0x90, 0x79, 0x59, 0x1f, 0xb3, 0x9c, 0x03, 0xb8, 0xb9,
+	0x32, 0xc0, 0xe1, 0x5f, 0x5d, 0x18, 0x44, 0x78, 0x91, 0x2a, 0x8d, 0x72, 0x9e, 0x05, 0xbe, 0xea,
+	0x8d, 0x9a, 0xaa, 0x37, 0x6b, 0xab, 0xde, 0xaa, 0x54, 0xbd, 0x0f, 0xed, 0xb8, 0x50, 0x9a, 0x8f,
+	0x2d, 0x1b, 0x3a, 0x51, 0x29, 0x91, 0x43, 0x68, 0xf3, 0xb3, 0xdf, 0x31, 0xd6, 0x37, 0x31, 0xa1,
+	0x74, 0x33, 0x58, 0x1a, 0x93, 0x89, 0x68, 0xdb, 0x4c, 0x5e, 0x5c, 0xe0, 0xc7, 0xc6, 0x0d, 0xfc,
+	0xe8, 0xcc, 0xf1, 0x43, 0xc0, 0x5e, 0x09, 0xc6, 0xe4, 0xc9, 0x6c, 0x9e, 0xee, 0x41, 0x6b, 0xb8,
+	0x79, 0xf4, 0xd9, 0x68, 0xda, 0xda, 0xa3, 0x25, 0x20, 0x8d, 0x5e, 0xd4, 0x84, 0x3f, 0xcd, 0xb5,
+	0x9c, 0x44, 0xb5, 0x99, 0xc9, 0x03, 0xd8, 0x4d, 0x30, 0x43, 0x8d, 0x5f, 0xe1, 0x39, 0x97, 0x18,
+	0xa1, 0xc8, 0x58, 0x8c, 0x14, 0xec, 0xbd, 0xea, 0x4c, 0xb3, 0x1c, 0xde, 0x5c, 0xe0, 0x70, 0x7a,
+	0x91, 0x73, 0x89, 0x27, 0x2f, 0x59, 0x7e, 0x61, 0x79, 0x64, 0xae, 0x5f, 0x55, 0x2e, 0x32, 0xfd,
+	0xee, 0x2d, 0x99, 0xde, 0x5b, 0x99, 0xe9, 0xdb, 0x55, 0xa6, 0x07, 0xd0, 0x49, 0xc7, 0x82, 0x4b,
+	0xfd, 0x2c, 0xa1, 0x3b, 0x0e, 0x79, 0x2f, 0x93, 0x9f, 0xa1, 0xe7, 0xe8, 0xf0, 0x43, 0x3a, 0x46,
+	0x6e, 0xb6, 0x79, 0xcb, 0x92, 0xe1, 0xe1, 0x0a, 0x98, 0x9f, 0x54, 0x02, 0xa3, 0xb9, 0x44, 0xe4,
+	0x0b, 0x08, 0x6a, 0x70, 0x7c, 0x82, 0xe7, 0x69, 0x8e, 0x09, 0x25, 0xf6, 0xf6, 0xd7, 0x78, 0x90,
+	0x4f, 0xe0, 0x9e, 0x2a, 0x07, 0xea, 0x0b, 0x26, 0x75, 0xca, 0xb2, 0x1f, 0x59, 0x56, 0xa0, 0xa2,
+	0xbb, 0x36, 0xb4, 0xde, 0x68, 0xd8, 0x2e, 0x71, 0xcc, 0x35, 0xd2, 0x3d, 0xc7, 0x76, 0x27, 0xd5,
+	0xb5, 0xfb, 0xbd, 0xda, 0x76, 0x0f, 0x3e, 0x82, 0xbd, 0x3a, 0x36, 0x99, 0x9e, 0x2b, 0x64, 0xae,
+	0x68, 0xc3, 0xa2, 0x6b, 0xd7, 0xc1, 0x4f, 0xd0, 0xab, 0xa2, 0x60, 0xbb, 0x4d, 0x22, 0xd3, 0xbe,
+	0x5f, 0x4b, 0xc9, 0xe8, 0x0b, 0x91, 0x30, 0xed, 0x7b, 0xb6, 0x94, 0x8c, 0xde, 0x61, 0xe0, 0xbb,
+	0xd6, 0x49, 0xc1, 0x1f, 0x0d, 0xb8, 0xbf, 0x94, 0xd4, 0x66, 0xf4, 0x5c, 0xe2, 0xc4, 0x8f, 0x9e,
+	0x4b, 0x9c, 0x90, 0xe7, 0x70, 0xe7, 0xca, 0x20, 0x50, 0x4e, 0x9d, 0x47, 0xaf, 0xd9, 0x33, 0x91,
+	0xcb, 0xf2, 0x69, 0xf3, 0xb8, 0x11, 0xfe, 0xd3, 0x02, 0xba, 0x18, 0xbb, 0x74, 0xf8, 0xb9, 0xd7,
+	0xaa, 0x39, 0x7d, 0xad, 0xfe, 0x9f, 0x2f, 0xad, 0xd5, 0xe6, 0x4b, 0x1f, 0xda, 0x4a, 0xb3, 0xb3,
+	0x0c, 0xfd, 0xa0, 0x72, 0x92, 0x61, 0xb6, 0x5b, 0x99, 0x37, 0xcb, 0x32, 0xbb, 0x14, 0xc9, 0xab,
+	0x25, 0x73, 0xa3, 0x6d, 0xe7, 0xc6, 0xe7, 0xd7, 0x62, 0xe0, 0xee, 0x71, 0xdb, 0xc1, 0x71, 0x2b,
+	0x76, 0xfc, 0x79, 0xcb, 0x1a, 0x7e, 0x5b, 0xad, 0xe1, 0xf1, 0xeb, 0x9e, 0x7f, 0xb6, 0x88, 0x08,
+	0xfb, 0xf3, 0xb1, 0xe5, 0xc4, 0xf0, 0xef, 0xcb, 0x62, 0x25, 0x1f, 0xc2, 0x06, 0x2f, 0x87, 0xce,
+	0x0d, 0x6f, 0x98, 0xf7, 0x3b, 0xfa, 0x77, 0x1d, 0xb6, 0x7d, 0xfe, 0xe7, 0x3c, 0x4f, 0x35, 0x97,
+	0xe4, 0x17, 0xd8, 0x9e, 0xfb, 0x11, 0x91, 0xf7, 0x66, 0xae, 0x54, 0xff, 0xaf, 0x0a, 0xc2, 0xeb,
+	0x5c, 0xdc, 0xa5, 0xc3, 0x35, 0xf2, 0x25, 0xb4, 0x9f, 0xe5, 0x57, 0xfc, 0x12, 0x09, 0x9d, 0xf1,
+	0x77, 0x2a, 0x9f, 0xe9, 0x7e, 0x8d, 0x65, 0x9a, 0xe0, 0x1b, 0xd8, 0x3a, 0xd5, 0x12, 0xd9, 0xf8,
+	0x8d, 0xd2, 0x3c, 0x68, 0x90, 0xc7, 0xb0, 0x7e, 0xc2, 0xb2, 0x8c, 0xf4, 0x67, 0xdc, 0x8c, 0xc2,
+	0x87, 0x0f, 0x16, 0xf4, 0xd3, 0x33, 0x7c, 0x0f, 0x5b, 0xb3, 0x1f, 0x0b, 0xb2, 0x5f, 0x29, 0xf8,
+	0xc2, 0x7f, 0x30, 0x78, 0x77, 0xa9, 0x7d, 0x9a, 0xf2, 0x57, 0xd8, 0x99, 0x2f, 0x37, 0x09, 0x6f,
+	0x9e, 0x05, 0xc1, 0xfb, 0x2b, 0x70, 0x2d, 0x5c, 0x23, 0xbf, 0xc1, 0x60, 0x09, 0x9b, 0xc8, 0x87,
+	0xd7, 0x64, 0xa8, 0x32, 0x2e, 0xe8, 0x2f, 0xd0, 0xe9, 0xa9, 0xf9, 0xa0, 0x87, 0x6b, 0x67, 0x6d,
+	0xab, 0xf9, 0xf8, 0xbf, 0x01, 0x00, 0x48, 0xec, 0x60, 0xa0, 0xdd, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -852,6 +853,7 @@ type ResourceMonitorClient interface {
 	SupportsFeature(ctx context.Context, in *SupportsFeatureRequest, opts ...grpc.CallOption) (*SupportsFeatureResponse, error)
 	Invoke(ctx context.Context, in *InvokeRequest, opts ...grpc.CallOption) (*InvokeResponse, error)
 	StreamInvoke(ctx context.Context, in *InvokeRequest, opts ...grpc.CallOption) (ResourceMonitor_StreamInvokeClient, error)
+	Call(ctx context.Context, in *CallRequest, opts ...grpc.CallOption) (*CallResponse, error)
 	ReadResource(ctx context.Context, in *ReadResourceRequest, opts ...grpc.CallOption) (*ReadResourceResponse, error)
 	RegisterResource(ctx context.Context, in *RegisterResourceRequest, opts ...grpc.CallOption) (*RegisterResourceResponse, error)
 	RegisterResourceOutputs(ctx context.Context, in *RegisterResourceOutputsRequest, opts ...grpc.CallOption) (*empty.Empty, error)
@@ -915,6 +917,15 @@ func (x *resourceMonitorStreamInvokeClient) Recv() (*InvokeResponse, error) {
 	return m, nil
 }
 
+func (c *resourceMonitorClient) Call(ctx context.Context, in *CallRequest, opts ...grpc.CallOption) (*CallResponse, error) {
+	out := new(CallResponse)
+	err := c.cc.Invoke(ctx, "/pulumirpc.ResourceMonitor/Call", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *resourceMonitorClient) ReadResource(ctx context.Context, in *ReadResourceRequest, opts ...grpc.CallOption) (*ReadResourceResponse, error) {
 	out := new(ReadResourceResponse)
 	err := c.cc.Invoke(ctx, "/pulumirpc.ResourceMonitor/ReadResource", in, out, opts...)
@@ -947,6 +958,7 @@ type ResourceMonitorServer interface {
 	SupportsFeature(context.Context, *SupportsFeatureRequest) (*SupportsFeatureResponse, error)
 	Invoke(context.Context, *InvokeRequest) (*InvokeResponse, error)
 	StreamInvoke(*InvokeRequest, ResourceMonitor_StreamInvokeServer) error
+	Call(context.Context, *CallRequest) (*CallResponse, error)
 	ReadResource(context.Context, *ReadResourceRequest) (*ReadResourceResponse, error)
 	RegisterResource(context.Context, *RegisterResourceRequest) (*RegisterResourceResponse, error)
 	RegisterResourceOutputs(context.Context, *RegisterResourceOutputsRequest) (*empty.Empty, error)
@@ -965,6 +977,9 @@ func (*UnimplementedResourceMonitorServer) Invoke(ctx context.Context, req *Invo
 func (*UnimplementedResourceMonitorServer) StreamInvoke(req *InvokeRequest, srv ResourceMonitor_StreamInvokeServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamInvoke not implemented")
 }
+func (*UnimplementedResourceMonitorServer) Call(ctx context.Context, req *CallRequest) (*CallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Call not implemented")
+}
 func (*UnimplementedResourceMonitorServer) ReadResource(ctx context.Context, req *ReadResourceRequest) (*ReadResourceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReadResource not implemented")
 }
@@ -1036,6 +1051,24 @@ func (x *resourceMonitorStreamInvokeServer) Send(m *InvokeResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ResourceMonitor_Call_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceMonitorServer).Call(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pulumirpc.ResourceMonitor/Call",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceMonitorServer).Call(ctx, req.(*CallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ResourceMonitor_ReadResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReadResourceRequest)
 	if err := dec(in); err != nil {
@@ -1102,6 +1135,10 @@ var _ResourceMonitor_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Invoke",
 			Handler:    _ResourceMonitor_Invoke_Handler,
 		},
+		{
+			MethodName: "Call",
+			Handler:    _ResourceMonitor_Call_Handler,
+		},
 		{
 			MethodName: "ReadResource",
 			Handler:    _ResourceMonitor_ReadResource_Handler,
@@ -13,7 +13,7 @@
 # limitations under the License.
 import asyncio
 import sys
-from typing import Any, Awaitable, Optional, TYPE_CHECKING
+from typing import Any, Awaitable, Dict, List, Optional, Set, TYPE_CHECKING
 import grpc
 
 from .. import log
@@ -26,7 +26,7 @@ from .settings import get_monitor
 from .sync_await import _sync_await
 
 if TYPE_CHECKING:
-    from .. import Inputs
+    from .. import Inputs, Output, Resource
 
 # This setting overrides a hardcoded maximum protobuf size in the python protobuf bindings. This avoids deserialization
 # exceptions on large gRPC payloads, but makes it possible to use enough memory to cause an OOM error instead [1].
@@ -136,3 +136,104 @@ def invoke(tok: str, props: 'Inputs', opts: Optional[InvokeOptions] = None, typ:
         return resp
 
     return InvokeResult(_sync_await(asyncio.ensure_future(do_rpc())))
+
+
+def call(tok: str, props: 'Inputs', res: Optional['Resource'] = None, typ: Optional[type] = None) -> 'Output[Any]':
+    """
+    call dynamically calls the method, tok, which is offered by a provider plugin. The resource on which the method
+    is called is passed in props as `__self__`, and res is that resource, if any. Unlike invoke, the inputs may
+    contain Outputs, whose dependencies are forwarded to the provider. The result is an Output that resolves when
+    the call finishes and that depends on res and on any resources the provider reports for the returned values.
+    """
+    log.debug(f"Calling function: tok={tok}")
+
+    if typ and not _types.is_output_type(typ):
+        raise TypeError("Expected typ to be decorated with @output_type")
+
+    from .. import Output  # pylint: disable=import-outside-toplevel
+    from ..resource import DependencyResource  # pylint: disable=import-outside-toplevel
+
+    resources_future: asyncio.Future[Set['Resource']] = asyncio.Future()
+    value_future: asyncio.Future[Any] = asyncio.Future()
+    known_future: asyncio.Future[bool] = asyncio.Future()
+    secret_future: asyncio.Future[bool] = asyncio.Future()
+
+    async def do_call():
+        try:
+            # The provider for the call is the one that manages the resource on which the method is called, if any.
+            provider_ref = None
+            provider = res.get_provider(tok) if res is not None else None
+            if provider is not None:
+                provider_urn = await provider.urn.future()
+                provider_id = (await provider.id.future()) or rpc.UNKNOWN
+                provider_ref = f"{provider_urn}::{provider_id}"
+                log.debug(f"Call using provider {provider_ref}")
+
+            monitor = get_monitor()
+            property_deps: Dict[str, List['Resource']] = {}
+            inputs = await rpc.serialize_properties(props, property_deps)
+
+            arg_dependencies = {}
+            for key, deps in property_deps.items():
+                urns = set()
+                for dep in deps:
+                    urn = await dep.urn.future()
+                    urns.add(urn)
+                arg_dependencies[key] = provider_pb2.CallRequest.ArgumentDependencies(urns=list(urns))
+
+            log.debug(f"Calling function prepared: tok={tok}")
+            req = provider_pb2.CallRequest(tok=tok, args=inputs, argDependencies=arg_dependencies,
+                                           provider=provider_ref)
+
+            def do_rpc_call():
+                try:
+                    return monitor.Call(req)
+                except grpc.RpcError as exn:
+                    # See the comment on invoke for the justification for disabling
+                    # this warning
+                    # pylint: disable=no-member
+                    if exn.code() == grpc.StatusCode.UNAVAILABLE:
+                        sys.exit(0)
+
+                    details = exn.details()
+                raise Exception(details)
+
+            resp = await asyncio.get_event_loop().run_in_executor(None, do_rpc_call)
+
+            log.debug(f"Calling function completed successfully: tok={tok}")
+            # If the call failed, raise an error.
+            if resp.failures:
+                raise Exception(f"call of {tok} failed: {resp.failures[0].reason} ({resp.failures[0].property})")
+
+            # Otherwise, resolve the output with the returned properties. Secretness is tracked for the result as a
+            # whole, since the individual values are not Outputs.
+            value: Dict[str, Any] = {}
+            is_secret = False
+            ret_obj = getattr(resp, 'return')
+            if ret_obj:
+                for key, prop in rpc.deserialize_properties(ret_obj).items():
+                    is_secret = is_secret or rpc.is_rpc_secret(prop)
+                    value[key] = rpc.unwrap_rpc_secret(prop)
+
+            # If typ is not None, call translate_output_properties to instantiate any output types.
+            result = rpc.translate_output_properties(value, lambda prop: prop, typ) if typ else value
+
+            deps: Set['Resource'] = set() if res is None else {res}
+            for ret_deps in resp.returnDependencies.values():
+                deps.update(DependencyResource(urn) for urn in ret_deps.urns)
+        except Exception as exn:
+            resources_future.set_exception(exn)
+            value_future.set_exception(exn)
+            known_future.set_exception(exn)
+            secret_future.set_exception(exn)
+            raise
+
+        resources_future.set_result(deps)
+        value_future.set_result(result)
+        # Unknown values in the result mark the output as unknown on their own.
+        known_future.set_result(True)
+        secret_future.set_result(is_secret)
+
+    asyncio.ensure_future(RPC_MANAGER.do_rpc("call", do_call)())
+
+    return Output(resources_future, value_future, known_future, secret_future)
@@ -94,6 +94,18 @@ class MockMonitor:
         fields = {"failures": None, "return": ret_proto}
         return provider_pb2.InvokeResponse(**fields)
 
+    def Call(self, request):
+        # Method calls are mocked in the same way as invokes: the resource on which the method is called is available
+        # to the mocks as the `__self__` argument.
+        args = rpc.deserialize_properties(request.args)
+
+        ret = self.mocks.call(request.tok, args, request.provider)
+
+        ret_proto = _sync_await(rpc.serialize_properties(ret, {}))
+
+        fields = {"failures": None, "return": ret_proto}
+        return provider_pb2.CallResponse(**fields)
+
     def ReadResource(self, request):
         state = rpc.deserialize_properties(request.properties)
 
@@ -47,6 +47,11 @@ class ResourceProviderStub(object):
         request_serializer=provider__pb2.InvokeRequest.SerializeToString,
         response_deserializer=provider__pb2.InvokeResponse.FromString,
         )
+    self.Call = channel.unary_unary(
+        '/pulumirpc.ResourceProvider/Call',
+        request_serializer=provider__pb2.CallRequest.SerializeToString,
+        response_deserializer=provider__pb2.CallResponse.FromString,
+        )
     self.Check = channel.unary_unary(
         '/pulumirpc.ResourceProvider/Check',
         request_serializer=provider__pb2.CheckRequest.SerializeToString,
@@ -82,6 +87,11 @@ class ResourceProviderStub(object):
         request_serializer=provider__pb2.ConstructRequest.SerializeToString,
         response_deserializer=provider__pb2.ConstructResponse.FromString,
         )
+    self.List = channel.unary_unary(
+        '/pulumirpc.ResourceProvider/List',
+        request_serializer=provider__pb2.ListRequest.SerializeToString,
+        response_deserializer=provider__pb2.ListResponse.FromString,
+        )
     self.Cancel = channel.unary_unary(
         '/pulumirpc.ResourceProvider/Cancel',
         request_serializer=google_dot_protobuf_dot_empty__pb2.Empty.SerializeToString,
@@ -142,6 +152,14 @@ class ResourceProviderServicer(object):
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
+  def Call(self, request, context):
+    """Call dynamically executes a method in the provider associated with a component resource. The resource on which
+    the method is called is passed as a resource reference in the `__self__` argument.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
   def Check(self, request, context):
     """Check validates that the given property bag is valid for a resource of the given type and returns the inputs
     that should be passed to successive calls to Diff, Create, or Update for this resource. As a rule, the provider
@@ -197,6 +215,15 @@ class ResourceProviderServicer(object):
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
+  def List(self, request, context):
+    """List returns the IDs of the existing resources of the given type that match the given filters. The IDs may be
+    used to import the resources. Providers are not required to implement List; those that do not return an
+    UNIMPLEMENTED error.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
   def Cancel(self, request, context):
     """Cancel signals the provider to abort all outstanding resource operations.
     """
@@ -244,6 +271,11 @@ def add_ResourceProviderServicer_to_server(servicer, server):
           request_deserializer=provider__pb2.InvokeRequest.FromString,
           response_serializer=provider__pb2.InvokeResponse.SerializeToString,
       ),
+      'Call': grpc.unary_unary_rpc_method_handler(
+          servicer.Call,
+          request_deserializer=provider__pb2.CallRequest.FromString,
+          response_serializer=provider__pb2.CallResponse.SerializeToString,
+      ),
       'Check': grpc.unary_unary_rpc_method_handler(
           servicer.Check,
           request_deserializer=provider__pb2.CheckRequest.FromString,
@@ -279,6 +311,11 @@ def add_ResourceProviderServicer_to_server(servicer, server):
           request_deserializer=provider__pb2.ConstructRequest.FromString,
           response_serializer=provider__pb2.ConstructResponse.SerializeToString,
       ),
+      'List': grpc.unary_unary_rpc_method_handler(
+          servicer.List,
+          request_deserializer=provider__pb2.ListRequest.FromString,
+          response_serializer=provider__pb2.ListResponse.SerializeToString,
+      ),
       'Cancel': grpc.unary_unary_rpc_method_handler(
           servicer.Cancel,
           request_deserializer=google_dot_protobuf_dot_empty__pb2.Empty.FromString,
@@ -19,6 +19,12 @@ from .output import Output, Input
 from .resource import CustomResource, ResourceOptions
 
 
+def _without_output_types(outputs: Optional[dict]) -> Optional[dict]:
+    if outputs is None or "__outputTypes" not in outputs:
+        return outputs
+    return {k: v for k, v in outputs.items() if k != "__outputTypes"}
+
+
 class StackReference(CustomResource):
     """
     Manages a reference to a Pulumi stack. The referenced stack's outputs are available via its "outputs" property or
@@ -60,6 +66,10 @@ class StackReference(CustomResource):
             "secret_output_names": None,
         }, opts)
 
+        # The types that a stack records for its typed outputs are not outputs themselves. Newer engines return them
+        # separately, but older ones include them in the outputs, so remove them here.
+        self.outputs = self.outputs.apply(_without_output_types)
+
     def get_output(self, name: Input[str]) -> Output[Any]:
         """
         Fetches the value of the named stack output, or None if the stack output was not found.
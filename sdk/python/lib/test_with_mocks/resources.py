@@ -24,11 +24,15 @@ def do_invoke():
     value = pulumi.runtime.invoke("test:index:MyFunction", props={"value": 41}).value
     return value["out_value"]
 
+def do_call(component):
+    return pulumi.runtime.call("pkg:index:MyComponent/getValue", {"__self__": component, "value": 41}, res=component)
+
 mycomponent = MyComponent("mycomponent", inprop="hello")
 myinstance = Instance("instance",
                       name="myvm",
                       value=pulumi.Output.secret("secret_value"))
 invoke_result = do_invoke()
+call_result = do_call(mycomponent)
 
 pulumi.export("hello", "world")
 pulumi.export("outprop", mycomponent.outprop)
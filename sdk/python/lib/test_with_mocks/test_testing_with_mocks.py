@@ -20,6 +20,10 @@ class MyMocks(pulumi.runtime.Mocks):
             return {
                 'out_value': 59,
             }
+        elif token == 'pkg:index:MyComponent/getValue':
+            return {
+                'out_value': args['value'] + 1,
+            }
         else:
             return {}
 
@@ -64,3 +68,9 @@ class TestingWithMocks(unittest.TestCase):
     @pulumi.runtime.test
     def test_invoke(self):
         return self.assertEqual(resources.invoke_result, 59)
+
+    @pulumi.runtime.test
+    def test_call(self):
+        def check_out_value(result):
+            self.assertEqual(result['out_value'], 42)
+        return resources.call_result.apply(check_out_value)